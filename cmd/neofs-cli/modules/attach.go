@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
+	"github.com/nspcc-dev/neofs-sdk-go/util/signature"
+	"github.com/spf13/cobra"
+)
+
+const (
+	addShardMetabaseFlag   = "metabase"
+	addShardBlobstorFlag   = "blobstor"
+	addShardWriteCacheFlag = "writecache"
+)
+
+var addShardCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Attach new shard to the storage node",
+	Long: "Attach new shard to the running storage node. " +
+		"Parameters which are not specified are taken from the default shard configuration section. " +
+		"Shard is not saved to the node configuration, add it there to keep it attached after the node restart.",
+	Run: addShard,
+}
+
+var detachShardCmd = &cobra.Command{
+	Use:   "detach",
+	Short: "Detach shards from the storage node",
+	Long:  "Detach shards from the running storage node. Objects stored in the shards become unavailable.",
+	Run:   detachShard,
+}
+
+func addShard(cmd *cobra.Command, _ []string) {
+	key, err := getKeyNoGenerate()
+	exitOnErr(cmd, err)
+
+	body := new(control.AddShardRequest_Body)
+
+	metabasePath, _ := cmd.Flags().GetString(addShardMetabaseFlag)
+	body.SetMetabasePath(metabasePath)
+
+	blobstorPath, _ := cmd.Flags().GetString(addShardBlobstorFlag)
+	body.SetBlobstorPath(blobstorPath)
+
+	writeCachePath, _ := cmd.Flags().GetString(addShardWriteCacheFlag)
+	body.SetWritecachePath(writeCachePath)
+
+	body.SetMode(parseShardMode(cmd, shardMode))
+
+	req := new(control.AddShardRequest)
+	req.SetBody(body)
+
+	err = controlSvc.SignMessage(key, req)
+	exitOnErr(cmd, errf("could not sign request: %w", err))
+
+	cli, err := getControlSDKClient(key)
+	exitOnErr(cmd, err)
+
+	var resp *control.AddShardResponse
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.AddShard(client, req)
+		return err
+	})
+	exitOnErr(cmd, errf("rpc error: %w", err))
+
+	sign := resp.GetSignature()
+
+	err = signature.VerifyDataWithSource(
+		resp,
+		func() ([]byte, []byte) {
+			return sign.GetKey(), sign.GetSign()
+		},
+	)
+	exitOnErr(cmd, errf("invalid response signature: %w", err))
+
+	cmd.Printf("Shard %s has been attached successfully.\n", base58.Encode(resp.GetBody().GetShard_ID()))
+}
+
+func detachShard(cmd *cobra.Command, _ []string) {
+	key, err := getKeyNoGenerate()
+	exitOnErr(cmd, err)
+
+	body := new(control.DetachShardRequest_Body)
+	body.SetShardIDList(getShardIDList(cmd))
+
+	req := new(control.DetachShardRequest)
+	req.SetBody(body)
+
+	err = controlSvc.SignMessage(key, req)
+	exitOnErr(cmd, errf("could not sign request: %w", err))
+
+	cli, err := getControlSDKClient(key)
+	exitOnErr(cmd, err)
+
+	var resp *control.DetachShardResponse
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.DetachShard(client, req)
+		return err
+	})
+	exitOnErr(cmd, errf("rpc error: %w", err))
+
+	sign := resp.GetSignature()
+
+	err = signature.VerifyDataWithSource(
+		resp,
+		func() ([]byte, []byte) {
+			return sign.GetKey(), sign.GetSign()
+		},
+	)
+	exitOnErr(cmd, errf("invalid response signature: %w", err))
+
+	cmd.Println("Shards have been detached successfully.")
+}
+
+func initControlAddShardCmd() {
+	initCommonFlagsWithoutRPC(addShardCmd)
+
+	flags := addShardCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	flags.String(addShardMetabaseFlag, "", "Path to the metabase of the new shard")
+	flags.String(addShardBlobstorFlag, "", "Path to the BLOB storage of the new shard")
+	flags.String(addShardWriteCacheFlag, "", "Path to the write-cache of the new shard (write-cache is disabled if empty)")
+	flags.StringVarP(&shardMode, shardModeFlag, "", shardModeReadWrite,
+		fmt.Sprintf("initial shard mode keyword ('%s', '%s', '%s', '%s')",
+			shardModeReadWrite,
+			shardModeReadOnly,
+			shardModeDegraded,
+			shardModeMaintenance,
+		),
+	)
+
+	_ = addShardCmd.MarkFlagRequired(addShardMetabaseFlag)
+	_ = addShardCmd.MarkFlagRequired(addShardBlobstorFlag)
+	_ = addShardCmd.MarkFlagRequired(controlRPC)
+}
+
+func initControlDetachShardCmd() {
+	initCommonFlagsWithoutRPC(detachShardCmd)
+
+	flags := detachShardCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+
+	_ = detachShardCmd.MarkFlagRequired(shardIDFlag)
+	_ = detachShardCmd.MarkFlagRequired(controlRPC)
+}
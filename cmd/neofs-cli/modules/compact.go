@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
+	"github.com/nspcc-dev/neofs-sdk-go/util/signature"
+	"github.com/spf13/cobra"
+)
+
+const compactFillPercentFlag = "fill-percent"
+
+var compactShardCmd = &cobra.Command{
+	Use:   "compact",
+	Short: "Compact blobovniczas of the shard",
+	Long: "Move objects from the sparse blobovniczas of the shard to other blobovniczas " +
+		"and remove sparse blobovniczas to reclaim disk space. Shard remains available " +
+		"for reading and writing during compaction.",
+	Run: compactShard,
+}
+
+func compactShard(cmd *cobra.Command, _ []string) {
+	key, err := getKeyNoGenerate()
+	exitOnErr(cmd, err)
+
+	body := new(control.CompactShardRequest_Body)
+
+	rawID, err := base58.Decode(shardID)
+	exitOnErr(cmd, errf("incorrect shard ID encoding: %w", err))
+	body.SetShardID(rawID)
+
+	fillPercent, _ := cmd.Flags().GetUint32(compactFillPercentFlag)
+	body.SetFillPercent(fillPercent)
+
+	req := new(control.CompactShardRequest)
+	req.SetBody(body)
+
+	err = controlSvc.SignMessage(key, req)
+	exitOnErr(cmd, errf("could not sign request: %w", err))
+
+	cli, err := getControlSDKClient(key)
+	exitOnErr(cmd, err)
+
+	var resp *control.CompactShardResponse
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.CompactShard(client, req)
+		return err
+	})
+	exitOnErr(cmd, errf("rpc error: %w", err))
+
+	sign := resp.GetSignature()
+
+	err = signature.VerifyDataWithSource(
+		resp,
+		func() ([]byte, []byte) {
+			return sign.GetKey(), sign.GetSign()
+		},
+	)
+	exitOnErr(cmd, errf("invalid response signature: %w", err))
+
+	cmd.Printf("Shard has been compacted successfully: %d blobovniczas compacted, %d objects moved, %d bytes reclaimed.\n",
+		resp.GetBody().GetCompacted(),
+		resp.GetBody().GetMoved(),
+		resp.GetBody().GetReclaimed(),
+	)
+}
+
+func initControlCompactShardCmd() {
+	initCommonFlagsWithoutRPC(compactShardCmd)
+
+	flags := compactShardCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	flags.StringVarP(&shardID, shardIDFlag, "", "", "Shard ID in base58 encoding")
+	flags.Uint32(compactFillPercentFlag, 0,
+		"Compact blobovniczas with the objects occupying less than the specified percent of the file size (default 50)")
+
+	_ = compactShardCmd.MarkFlagRequired(shardIDFlag)
+	_ = compactShardCmd.MarkFlagRequired(controlRPC)
+}
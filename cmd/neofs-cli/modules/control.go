@@ -76,8 +76,10 @@ const (
 	shardIDFlag          = "id"
 	shardClearErrorsFlag = "clear-errors"
 
-	shardModeReadOnly  = "read-only"
-	shardModeReadWrite = "read-write"
+	shardModeReadOnly    = "read-only"
+	shardModeReadWrite   = "read-write"
+	shardModeDegraded    = "degraded"
+	shardModeMaintenance = "maintenance"
 )
 
 const (
@@ -124,9 +126,11 @@ func initControlSetShardModeCmd() {
 	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
 	flags.StringVarP(&shardID, shardIDFlag, "", "", "ID of the shard in base58 encoding")
 	flags.StringVarP(&shardMode, shardModeFlag, "", "",
-		fmt.Sprintf("new shard mode keyword ('%s', '%s')",
+		fmt.Sprintf("new shard mode keyword ('%s', '%s', '%s', '%s')",
 			shardModeReadWrite,
 			shardModeReadOnly,
+			shardModeDegraded,
+			shardModeMaintenance,
 		),
 	)
 	flags.Bool(shardClearErrorsFlag, false, "Set shard error count to 0")
@@ -186,11 +190,19 @@ func init() {
 	shardsCmd.AddCommand(setShardModeCmd)
 	shardsCmd.AddCommand(dumpShardCmd)
 	shardsCmd.AddCommand(restoreShardCmd)
+	shardsCmd.AddCommand(addShardCmd)
+	shardsCmd.AddCommand(detachShardCmd)
+	shardsCmd.AddCommand(evacuateShardCmd)
+	shardsCmd.AddCommand(flushCacheCmd)
+	shardsCmd.AddCommand(cacheStatsCmd)
+	shardsCmd.AddCommand(compactShardCmd)
+	shardsCmd.AddCommand(gcStatsCmd)
 
 	controlCmd.AddCommand(
 		healthCheckCmd,
 		setNetmapStatusCmd,
 		dropObjectsCmd,
+		checkPlacementCmd,
 		snapshotCmd,
 		shardsCmd,
 	)
@@ -198,11 +210,19 @@ func init() {
 	initControlHealthCheckCmd()
 	initControlSetNetmapStatusCmd()
 	initControlDropObjectsCmd()
+	initControlCheckPlacementCmd()
 	initControlSnapshotCmd()
 	initControlShardsListCmd()
 	initControlSetShardModeCmd()
 	initControlDumpShardCmd()
 	initControlRestoreShardCmd()
+	initControlAddShardCmd()
+	initControlDetachShardCmd()
+	initControlEvacuateShardCmd()
+	initControlFlushCacheCmd()
+	initControlCacheStatsCmd()
+	initControlCompactShardCmd()
+	initControlGCStatsCmd()
 }
 
 func healthCheck(cmd *cobra.Command, _ []string) {
@@ -487,9 +507,13 @@ func prettyPrintShards(cmd *cobra.Command, ii []*control.ShardInfo) {
 
 		switch i.GetMode() {
 		case control.ShardMode_READ_WRITE:
-			mode = "read-write"
+			mode = shardModeReadWrite
 		case control.ShardMode_READ_ONLY:
-			mode = "read-only"
+			mode = shardModeReadOnly
+		case control.ShardMode_DEGRADED:
+			mode = shardModeDegraded
+		case control.ShardMode_SHARD_MAINTENANCE:
+			mode = shardModeMaintenance
 		default:
 			mode = "unknown"
 		}
@@ -517,16 +541,7 @@ func setShardMode(cmd *cobra.Command, _ []string) {
 	key, err := getKeyNoGenerate()
 	exitOnErr(cmd, err)
 
-	var mode control.ShardMode
-
-	switch shardMode {
-	default:
-		exitOnErr(cmd, fmt.Errorf("unsupported mode %s", mode))
-	case shardModeReadWrite:
-		mode = control.ShardMode_READ_WRITE
-	case shardModeReadOnly:
-		mode = control.ShardMode_READ_ONLY
-	}
+	mode := parseShardMode(cmd, shardMode)
 
 	req := new(control.SetShardModeRequest)
 
@@ -567,3 +582,19 @@ func setShardMode(cmd *cobra.Command, _ []string) {
 
 	cmd.Println("Shard mode update request successfully sent.")
 }
+
+func parseShardMode(cmd *cobra.Command, m string) control.ShardMode {
+	switch m {
+	default:
+		exitOnErr(cmd, fmt.Errorf("unsupported mode %s", m))
+		return control.ShardMode_SHARD_MODE_UNDEFINED
+	case shardModeReadWrite:
+		return control.ShardMode_READ_WRITE
+	case shardModeReadOnly:
+		return control.ShardMode_READ_ONLY
+	case shardModeDegraded:
+		return control.ShardMode_DEGRADED
+	case shardModeMaintenance:
+		return control.ShardMode_SHARD_MAINTENANCE
+	}
+}
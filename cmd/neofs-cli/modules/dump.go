@@ -12,13 +12,20 @@ import (
 const (
 	dumpFilepathFlag     = "path"
 	dumpIgnoreErrorsFlag = "no-errors"
+	dumpContainersFlag   = "cid"
+	dumpEpochFromFlag    = "from-epoch"
+	dumpEpochToFlag      = "to-epoch"
+	dumpCheckpointFlag   = "checkpoint"
+	dumpCompressFlag     = "compress"
 )
 
 var dumpShardCmd = &cobra.Command{
 	Use:   "dump",
 	Short: "Dump objects from shard",
-	Long:  "Dump objects from shard to a file",
-	Run:   dumpShard,
+	Long: "Dump objects from shard to a file. Dump can be limited to the objects of particular " +
+		"containers and creation epochs. If checkpoint of the previous dump is specified, only " +
+		"objects added to the shard after that dump are written.",
+	Run: dumpShard,
 }
 
 func dumpShard(cmd *cobra.Command, _ []string) {
@@ -37,6 +44,34 @@ func dumpShard(cmd *cobra.Command, _ []string) {
 	ignore, _ := cmd.Flags().GetBool(dumpIgnoreErrorsFlag)
 	body.SetIgnoreErrors(ignore)
 
+	strCIDs, _ := cmd.Flags().GetStringSlice(dumpContainersFlag)
+
+	rawCIDs := make([][]byte, 0, len(strCIDs))
+	for i := range strCIDs {
+		id, err := parseContainerID(strCIDs[i])
+		exitOnErr(cmd, err)
+
+		rawCIDs = append(rawCIDs, id.ToV2().GetValue())
+	}
+
+	body.SetContainerIDList(rawCIDs)
+
+	compress, _ := cmd.Flags().GetBool(dumpCompressFlag)
+	body.SetCompress(compress)
+
+	epochFrom, _ := cmd.Flags().GetUint64(dumpEpochFromFlag)
+	body.SetEpochFrom(epochFrom)
+
+	epochTo, _ := cmd.Flags().GetUint64(dumpEpochToFlag)
+	body.SetEpochTo(epochTo)
+
+	if cmd.Flags().Changed(dumpCheckpointFlag) {
+		checkpoint, _ := cmd.Flags().GetUint64(dumpCheckpointFlag)
+
+		body.SetIncremental(true)
+		body.SetCheckpoint(checkpoint)
+	}
+
 	req := new(control.DumpShardRequest)
 	req.SetBody(body)
 
@@ -63,7 +98,8 @@ func dumpShard(cmd *cobra.Command, _ []string) {
 	)
 	exitOnErr(cmd, errf("invalid response signature: %w", err))
 
-	cmd.Println("Shard has been dumped successfully.")
+	cmd.Printf("Shard has been dumped successfully, objects: %d, checkpoint: %d.\n",
+		resp.GetBody().GetCount(), resp.GetBody().GetCheckpoint())
 }
 
 func initControlDumpShardCmd() {
@@ -74,6 +110,11 @@ func initControlDumpShardCmd() {
 	flags.StringVarP(&shardID, shardIDFlag, "", "", "Shard ID in base58 encoding")
 	flags.String(dumpFilepathFlag, "", "File to write objects to")
 	flags.Bool(dumpIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+	flags.Bool(dumpCompressFlag, false, "Compress dump with zstd")
+	flags.StringSlice(dumpContainersFlag, nil, "Dump only objects of the containers with the specified IDs")
+	flags.Uint64(dumpEpochFromFlag, 0, "Dump only objects created not earlier than the specified epoch")
+	flags.Uint64(dumpEpochToFlag, 0, "Dump only objects created not later than the specified epoch (0 means no limit)")
+	flags.Uint64(dumpCheckpointFlag, 0, "Checkpoint of the previous dump, only objects added after it are dumped")
 
 	_ = dumpShardCmd.MarkFlagRequired(shardIDFlag)
 	_ = dumpShardCmd.MarkFlagRequired(dumpFilepathFlag)
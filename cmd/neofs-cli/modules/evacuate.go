@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
+	"github.com/nspcc-dev/neofs-sdk-go/util/signature"
+	"github.com/spf13/cobra"
+)
+
+const evacuateIgnoreErrorsFlag = "no-errors"
+
+var evacuateShardCmd = &cobra.Command{
+	Use:   "evacuate",
+	Short: "Evacuate objects from shard",
+	Long: "Move all objects from the shard to other shards of the node. " +
+		"Objects which can not be placed locally are replicated to other container nodes. " +
+		"Interrupted evacuation is resumed by the next call.",
+	Run: evacuateShard,
+}
+
+func evacuateShard(cmd *cobra.Command, _ []string) {
+	key, err := getKeyNoGenerate()
+	exitOnErr(cmd, err)
+
+	body := new(control.EvacuateShardRequest_Body)
+
+	rawID, err := base58.Decode(shardID)
+	exitOnErr(cmd, errf("incorrect shard ID encoding: %w", err))
+	body.SetShardID(rawID)
+
+	ignore, _ := cmd.Flags().GetBool(evacuateIgnoreErrorsFlag)
+	body.SetIgnoreErrors(ignore)
+
+	req := new(control.EvacuateShardRequest)
+	req.SetBody(body)
+
+	err = controlSvc.SignMessage(key, req)
+	exitOnErr(cmd, errf("could not sign request: %w", err))
+
+	cli, err := getControlSDKClient(key)
+	exitOnErr(cmd, err)
+
+	var resp *control.EvacuateShardResponse
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.EvacuateShard(client, req)
+		return err
+	})
+	exitOnErr(cmd, errf("rpc error: %w", err))
+
+	sign := resp.GetSignature()
+
+	err = signature.VerifyDataWithSource(
+		resp,
+		func() ([]byte, []byte) {
+			return sign.GetKey(), sign.GetSign()
+		},
+	)
+	exitOnErr(cmd, errf("invalid response signature: %w", err))
+
+	cmd.Printf("Shard has been evacuated successfully: %d objects moved, %d replicated, %d failed.\n",
+		resp.GetBody().GetCount(),
+		resp.GetBody().GetReplicated(),
+		resp.GetBody().GetFailed(),
+	)
+}
+
+func initControlEvacuateShardCmd() {
+	initCommonFlagsWithoutRPC(evacuateShardCmd)
+
+	flags := evacuateShardCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	flags.StringVarP(&shardID, shardIDFlag, "", "", "Shard ID in base58 encoding")
+	flags.Bool(evacuateIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+
+	_ = evacuateShardCmd.MarkFlagRequired(shardIDFlag)
+	_ = evacuateShardCmd.MarkFlagRequired(controlRPC)
+}
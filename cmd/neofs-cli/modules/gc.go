@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
+	"github.com/nspcc-dev/neofs-sdk-go/util/signature"
+	"github.com/spf13/cobra"
+)
+
+var gcStatsCmd = &cobra.Command{
+	Use:   "gc-stats",
+	Short: "Show garbage collector statistics of the shards",
+	Long:  "Show garbage collector statistics of the shards. Statistics of all shards are shown if no shard IDs are specified.",
+	Run:   gcStats,
+}
+
+func gcStats(cmd *cobra.Command, _ []string) {
+	key, err := getKeyNoGenerate()
+	exitOnErr(cmd, err)
+
+	body := new(control.GCStatsRequest_Body)
+	body.SetShardIDList(getShardIDList(cmd))
+
+	req := new(control.GCStatsRequest)
+	req.SetBody(body)
+
+	err = controlSvc.SignMessage(key, req)
+	exitOnErr(cmd, errf("could not sign request: %w", err))
+
+	cli, err := getControlSDKClient(key)
+	exitOnErr(cmd, err)
+
+	var resp *control.GCStatsResponse
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.GCStats(client, req)
+		return err
+	})
+	exitOnErr(cmd, errf("rpc error: %w", err))
+
+	sign := resp.GetSignature()
+
+	err = signature.VerifyDataWithSource(
+		resp,
+		func() ([]byte, []byte) {
+			return sign.GetKey(), sign.GetSign()
+		},
+	)
+	exitOnErr(cmd, errf("invalid response signature: %w", err))
+
+	for _, st := range resp.GetBody().GetStats() {
+		lastRun := "never"
+		if st.GetLastRun() != 0 {
+			lastRun = time.Unix(int64(st.GetLastRun()), 0).String()
+		}
+
+		cmd.Printf("Shard %s:\n"+
+			"Graveyard size: %d\n"+
+			"Pending garbage: %d\n"+
+			"Removed during last run: %d\n"+
+			"Removed in total: %d\n"+
+			"Skipped runs: %d\n"+
+			"Last run: %s\n",
+			base58.Encode(st.GetShard_ID()),
+			st.GetGraveyardSize(),
+			st.GetPendingGarbage(),
+			st.GetLastRemoved(),
+			st.GetTotalRemoved(),
+			st.GetSkippedRuns(),
+			lastRun,
+		)
+	}
+}
+
+func initControlGCStatsCmd() {
+	initCommonFlagsWithoutRPC(gcStatsCmd)
+
+	flags := gcStatsCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding (all shards if empty)")
+
+	_ = gcStatsCmd.MarkFlagRequired(controlRPC)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/cheggaaa/pb"
 	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
 	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-sdk-go/checksum"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
@@ -120,6 +121,11 @@ const (
 
 const searchOIDFlag = "oid"
 
+const (
+	searchLimitFlag  = "limit"
+	searchCursorFlag = "cursor"
+)
+
 const (
 	rawFlag     = "raw"
 	rawFlagDesc = "Set raw request option"
@@ -148,6 +154,7 @@ func initObjectPutCmd() {
 	flags.Bool("disable-timestamp", false, "Do not set well-known timestamp attribute")
 	flags.Uint64VarP(&putExpiredOn, putExpiresOnFlag, "e", 0, "Last epoch in the life of the object")
 	flags.Bool(noProgressFlag, false, "Do not show progress bar")
+	flags.Bool(putResumeFlag, false, "Split the object on the client side and record stored parts in the journal file next to the payload file, continue the interrupted upload if the journal exists")
 
 	flags.String(notificationFlag, "", "Object notification in the form of *epoch*:*topic*; '-' topic means using default")
 }
@@ -190,11 +197,16 @@ func initObjectSearchCmd() {
 	_ = objectSearchCmd.MarkFlagRequired("cid")
 
 	flags.StringSliceVarP(&searchFilters, "filters", "f", nil,
-		"Repeated filter expressions or files with protobuf JSON")
+		"Repeated filter expressions or files with protobuf JSON. "+
+			"Binary operations: EQ, NE, COMMON_PREFIX, GT, GE, LT, LE (numeric), unary: NOPRESENT")
 
 	flags.Bool("root", false, "Search for user objects")
 	flags.Bool("phy", false, "Search physically stored objects")
 	flags.String(searchOIDFlag, "", "Search object by identifier")
+	flags.Uint32(searchLimitFlag, 0, "Maximum number of objects to return")
+	flags.String(searchCursorFlag, "",
+		"Return objects following the given identifier in the order of identifiers. "+
+			"Pass empty value to get the first page")
 }
 
 func initObjectHeadCmd() {
@@ -457,38 +469,54 @@ func putObject(cmd *cobra.Command, _ []string) {
 
 	var prm internalclient.PutObjectPrm
 
-	sessionObjectCtxAddress := addressSDK.NewAddress()
-	sessionObjectCtxAddress.SetContainerID(cid)
-	prepareSessionPrmWithOwner(cmd, sessionObjectCtxAddress, key, ownerID, &prm)
+	resume, _ := cmd.Flags().GetBool(putResumeFlag)
+	if resume {
+		// parts of the object are signed by the key directly
+		prepareAPIClientWithKey(cmd, key, &prm)
+	} else {
+		sessionObjectCtxAddress := addressSDK.NewAddress()
+		sessionObjectCtxAddress.SetContainerID(cid)
+		prepareSessionPrmWithOwner(cmd, sessionObjectCtxAddress, key, ownerID, &prm)
+	}
 	prepareObjectPrm(cmd, &prm)
-	prm.SetHeader(obj)
 
-	var p *pb.ProgressBar
+	var (
+		p       *pb.ProgressBar
+		payload io.Reader = f
+	)
 
 	noProgress, _ := cmd.Flags().GetBool(noProgressFlag)
-	if noProgress {
-		prm.SetPayloadReader(f)
-	} else {
+	if !noProgress {
 		fi, err := f.Stat()
 		if err != nil {
 			cmd.PrintErrf("Failed to get file size, progress bar is disabled: %v\n", err)
-			prm.SetPayloadReader(f)
 		} else {
 			p = pb.New64(fi.Size())
 			p.Output = cmd.OutOrStdout()
-			prm.SetPayloadReader(p.NewProxyReader(f))
+			payload = p.NewProxyReader(f)
 			p.Start()
 		}
 	}
 
-	res, err := internalclient.PutObject(prm)
-	exitOnErr(cmd, errf("rpc error: %w", err))
+	var id *oidSDK.ID
+
+	if resume {
+		id = putObjectResumable(cmd, key, prm, obj, f, payload)
+	} else {
+		prm.SetHeader(obj)
+		prm.SetPayloadReader(payload)
+
+		res, err := internalclient.PutObject(prm)
+		exitOnErr(cmd, errf("rpc error: %w", err))
+
+		id = res.ID()
+	}
 
 	if p != nil {
 		p.Finish()
 	}
 	cmd.Printf("[%s] Object successfully stored\n", filename)
-	cmd.Printf("  ID: %s\n  CID: %s\n", res.ID(), cid)
+	cmd.Printf("  ID: %s\n  CID: %s\n", id, cid)
 }
 
 func deleteObject(cmd *cobra.Command, _ []string) {
@@ -618,6 +646,35 @@ func searchObject(cmd *cobra.Command, _ []string) {
 	prm.SetContainerID(cid)
 	prm.SetFilters(sf)
 
+	limit, _ := cmd.Flags().GetUint32(searchLimitFlag)
+	paged := cmd.Flags().Changed(searchCursorFlag)
+
+	xs := parseXHeaders()
+
+	if limit > 0 {
+		x := session.NewXHeader()
+		x.SetKey(objectcore.XHeaderSearchLimit)
+		x.SetValue(strconv.FormatUint(uint64(limit), 10))
+
+		xs = append(xs, x)
+	}
+
+	if paged {
+		cursor, _ := cmd.Flags().GetString(searchCursorFlag)
+		if cursor != "" {
+			err := oidSDK.NewID().Parse(cursor)
+			exitOnErr(cmd, errf("could not parse cursor: %w", err))
+		}
+
+		x := session.NewXHeader()
+		x.SetKey(objectcore.XHeaderSearchCursor)
+		x.SetValue(cursor)
+
+		xs = append(xs, x)
+	}
+
+	prm.SetXHeaders(xs)
+
 	res, err := internalclient.SearchObjects(prm)
 	exitOnErr(cmd, errf("rpc error: %w", err))
 
@@ -627,6 +684,10 @@ func searchObject(cmd *cobra.Command, _ []string) {
 	for _, id := range ids {
 		cmd.Println(id)
 	}
+
+	if paged && limit > 0 && len(ids) == int(limit) {
+		cmd.Printf("Next cursor: %s\n", ids[len(ids)-1])
+	}
 }
 
 func getObjectHash(cmd *cobra.Command, _ []string) {
@@ -712,6 +773,10 @@ var searchBinaryOpVocabulary = map[string]object.SearchMatchType{
 	"EQ":            object.MatchStringEqual,
 	"NE":            object.MatchStringNotEqual,
 	"COMMON_PREFIX": object.MatchCommonPrefix,
+	"GT":            objectcore.MatchNumGT,
+	"GE":            objectcore.MatchNumGE,
+	"LT":            objectcore.MatchNumLT,
+	"LE":            objectcore.MatchNumLE,
 }
 
 func parseSearchFilters(cmd *cobra.Command) (object.SearchFilters, error) {
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"github.com/nspcc-dev/neofs-sdk-go/util/signature"
+	"github.com/spf13/cobra"
+)
+
+const (
+	checkPlacementContainerFlag = "cid"
+	checkPlacementObjectsFlag   = "objects"
+)
+
+var checkPlacementCmd = &cobra.Command{
+	Use:   "check-placement",
+	Short: "Check placement of the local objects",
+	Long: "Queue out-of-turn placement check of the local objects of the container " +
+		"and of the objects with the specified addresses. Missing copies are replicated " +
+		"and redundant local copies are removed by the policer as usual. If the check " +
+		"queue of the policer is full, the command fails with the number of the queued " +
+		"objects, the command can be repeated later.",
+	Run: checkPlacement,
+}
+
+func checkPlacement(cmd *cobra.Command, _ []string) {
+	key, err := getKeyNoGenerate()
+	exitOnErr(cmd, err)
+
+	body := new(control.CheckPlacementRequest_Body)
+
+	strCID, _ := cmd.Flags().GetString(checkPlacementContainerFlag)
+	if strCID != "" {
+		id, err := parseContainerID(strCID)
+		exitOnErr(cmd, err)
+
+		body.SetContainerID(id.ToV2().GetValue())
+	}
+
+	strAddrList, _ := cmd.Flags().GetStringSlice(checkPlacementObjectsFlag)
+
+	binAddrList := make([][]byte, 0, len(strAddrList))
+
+	for i := range strAddrList {
+		a := addressSDK.NewAddress()
+
+		err := a.Parse(strAddrList[i])
+		if err != nil {
+			exitOnErr(cmd, fmt.Errorf("could not parse address #%d: %w", i, err))
+		}
+
+		binAddr, err := a.Marshal()
+		exitOnErr(cmd, errf("could not marshal the address: %w", err))
+
+		binAddrList = append(binAddrList, binAddr)
+	}
+
+	body.SetAddressList(binAddrList)
+
+	if strCID == "" && len(binAddrList) == 0 {
+		exitOnErr(cmd, fmt.Errorf("either --%s or --%s flag must be specified",
+			checkPlacementContainerFlag, checkPlacementObjectsFlag))
+	}
+
+	req := new(control.CheckPlacementRequest)
+	req.SetBody(body)
+
+	err = controlSvc.SignMessage(key, req)
+	exitOnErr(cmd, errf("could not sign request: %w", err))
+
+	cli, err := getControlSDKClient(key)
+	exitOnErr(cmd, err)
+
+	var resp *control.CheckPlacementResponse
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.CheckPlacement(client, req)
+		return err
+	})
+	exitOnErr(cmd, errf("rpc error: %w", err))
+
+	sign := resp.GetSignature()
+
+	err = signature.VerifyDataWithSource(
+		resp,
+		func() ([]byte, []byte) {
+			return sign.GetKey(), sign.GetSign()
+		},
+	)
+	exitOnErr(cmd, errf("invalid response signature: %w", err))
+
+	cmd.Printf("Objects queued for the placement check: %d\n", resp.GetBody().GetQueued())
+}
+
+func initControlCheckPlacementCmd() {
+	initCommonFlagsWithoutRPC(checkPlacementCmd)
+
+	flags := checkPlacementCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	flags.String(checkPlacementContainerFlag, "", "Container ID")
+	flags.StringSliceP(checkPlacementObjectsFlag, "o", nil, "List of object addresses in string format")
+
+	_ = checkPlacementCmd.MarkFlagRequired(controlRPC)
+}
@@ -0,0 +1,331 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/nspcc-dev/neo-go/pkg/encoding/bigint"
+	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/transformer"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/spf13/cobra"
+)
+
+const putResumeFlag = "resume"
+
+// uploadJournalSuffix is appended to the name of the uploading
+// file to get the path of its upload journal.
+const uploadJournalSuffix = ".upload"
+
+// maxObjectSizeParameter is a key of the network parameter
+// with the maximum payload size of the object.
+const maxObjectSizeParameter = "MaxObjectSize"
+
+// uploadJournal is a local record of the parts of the split object stored
+// in NeoFS during the file upload. The upload can be resumed from the last
+// recorded part if the file and the network settings have not changed.
+type uploadJournal struct {
+	path string
+
+	Container     string   `json:"container"`
+	FileSize      int64    `json:"file_size"`
+	ModTime       int64    `json:"mod_time"`
+	MaxObjectSize uint64   `json:"max_object_size"`
+	SplitID       []byte   `json:"split_id"`
+	Parts         []string `json:"parts"`
+	Checksums     []string `json:"checksums"`
+}
+
+// readUploadJournal returns nil if the journal does not exist.
+func readUploadJournal(path string) (*uploadJournal, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	j := &uploadJournal{path: path}
+
+	if err := json.Unmarshal(data, j); err != nil {
+		return nil, fmt.Errorf("decode '%s': %w", path, err)
+	}
+
+	return j, nil
+}
+
+// matches checks if the journal has been created for the upload
+// of the same file with the same network settings.
+func (j *uploadJournal) matches(cnr string, fi os.FileInfo, maxSize uint64) bool {
+	return j.Container == cnr &&
+		j.FileSize == fi.Size() &&
+		j.ModTime == fi.ModTime().UnixNano() &&
+		j.MaxObjectSize == maxSize &&
+		len(j.Checksums) == len(j.Parts)
+}
+
+func (j *uploadJournal) splitState() (transformer.SplitState, error) {
+	var state transformer.SplitState
+
+	state.SplitID = object.NewSplitIDFromV2(j.SplitID)
+	if state.SplitID == nil {
+		return state, errors.New("invalid split ID")
+	}
+
+	state.Children = make([]oidSDK.ID, len(j.Parts))
+	state.Checksums = make([][]byte, len(j.Parts))
+
+	for i := range j.Parts {
+		if err := state.Children[i].Parse(j.Parts[i]); err != nil {
+			return state, fmt.Errorf("invalid part #%d: %w", i, err)
+		}
+
+		cs, err := hex.DecodeString(j.Checksums[i])
+		if err != nil || len(cs) != sha256.Size {
+			return state, fmt.Errorf("invalid checksum of part #%d", i)
+		}
+
+		state.Checksums[i] = cs
+	}
+
+	return state, nil
+}
+
+// addPart records the stored part with its payload checksum and saves the
+// journal. The journal is replaced atomically, so it stays valid if the
+// upload is interrupted.
+func (j *uploadJournal) addPart(id *oidSDK.ID, cs []byte) error {
+	j.Parts = append(j.Parts, id.String())
+	j.Checksums = append(j.Checksums, hex.EncodeToString(cs))
+
+	data, err := json.Marshal(j)
+	if err != nil {
+		return err
+	}
+
+	tmp := j.path + ".tmp"
+
+	if err := os.WriteFile(tmp, data, 0640); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, j.path)
+}
+
+// uploadTarget is an ObjectTarget which stores the formed objects in NeoFS
+// and records the stored parts of the split object in the upload journal.
+type uploadTarget struct {
+	prm internalclient.PutObjectPrm
+
+	journal *uploadJournal
+
+	hdr *object.Object
+
+	payload bytes.Buffer
+}
+
+func (t *uploadTarget) WriteHeader(hdr *object.Object) error {
+	t.hdr = hdr
+	return nil
+}
+
+func (t *uploadTarget) Write(p []byte) (int, error) {
+	return t.payload.Write(p)
+}
+
+func (t *uploadTarget) Close() (*transformer.AccessIdentifiers, error) {
+	t.prm.SetHeader(t.hdr)
+	t.prm.SetPayloadReader(&t.payload)
+
+	if _, err := internalclient.PutObject(t.prm); err != nil {
+		return nil, err
+	}
+
+	// the last part carries the parent header, so it is stored again on
+	// resume along with the linking object and is not recorded
+	if t.hdr.SplitID() != nil && t.hdr.Parent() == nil {
+		if err := t.journal.addPart(t.hdr.ID(), t.hdr.PayloadChecksum().Sum()); err != nil {
+			return nil, fmt.Errorf("can't update upload journal: %w", err)
+		}
+	}
+
+	return new(transformer.AccessIdentifiers).WithSelfID(t.hdr.ID()), nil
+}
+
+// checkStoredParts checks that the payload of the parts from the state
+// matches the file and that the parts are still stored in the container.
+// Returns the description of the first mismatch, empty if the upload can
+// be resumed.
+func checkStoredParts(f io.ReaderAt, maxSize uint64, prm internalclient.HeadObjectPrm, cnr *cid.ID,
+	state transformer.SplitState) (string, error) {
+	for i := range state.Children {
+		h := sha256.New()
+
+		_, err := io.Copy(h, io.NewSectionReader(f, int64(uint64(i)*maxSize), int64(maxSize)))
+		if err != nil {
+			return "", fmt.Errorf("read payload of part #%d: %w", i, err)
+		}
+
+		if !bytes.Equal(h.Sum(nil), state.Checksums[i]) {
+			return fmt.Sprintf("payload of part #%d has changed", i), nil
+		}
+	}
+
+	for i := range state.Children {
+		addr := addressSDK.NewAddress()
+		addr.SetContainerID(cnr)
+		addr.SetObjectID(&state.Children[i])
+
+		prm.SetAddress(addr)
+
+		_, err := internalclient.HeadObject(prm)
+		switch {
+		case err == nil:
+		case errors.As(err, new(apistatus.ObjectNotFound)), errors.As(err, new(apistatus.ObjectAlreadyRemoved)):
+			return fmt.Sprintf("part %s is missing", &state.Children[i]), nil
+		default:
+			return "", fmt.Errorf("read header of part %s: %w", &state.Children[i], err)
+		}
+	}
+
+	return "", nil
+}
+
+type epochState uint64
+
+func (s epochState) CurrentEpoch() uint64 {
+	return uint64(s)
+}
+
+// putObjectResumable splits the object on the client side and stores the
+// parts one by one signing them with the key. Stored parts are recorded in
+// the upload journal next to the file, the upload continues from them if
+// the journal exists, the parts are still stored and their payload matches
+// the file. The journal is removed after the successful upload.
+//
+// Returns the identifier of the stored object.
+func putObjectResumable(cmd *cobra.Command, key *ecdsa.PrivateKey, prm internalclient.PutObjectPrm,
+	hdr *object.Object, f *os.File, payload io.Reader) *oidSDK.ID {
+	var (
+		netInfoPrm internalclient.NetworkInfoPrm
+		headPrm    internalclient.HeadObjectPrm
+	)
+
+	prepareAPIClientWithKey(cmd, key, &netInfoPrm, &headPrm)
+	prepareObjectPrm(cmd, &headPrm)
+	headPrm.SetMainOnlyFlag(true)
+
+	res, err := internalclient.NetworkInfo(netInfoPrm)
+	exitOnErr(cmd, errf("read network info: %w", err))
+
+	ni := res.NetworkInfo()
+
+	maxSize := maxObjectSize(ni)
+	if maxSize == 0 {
+		exitOnErr(cmd, errors.New("missing max object size network parameter"))
+	}
+
+	fi, err := f.Stat()
+	exitOnErr(cmd, errf("can't get file info: %w", err))
+
+	journalPath := f.Name() + uploadJournalSuffix
+
+	journal, err := readUploadJournal(journalPath)
+	exitOnErr(cmd, errf("can't read upload journal: %w", err))
+
+	cnr := hdr.ContainerID().String()
+
+	var state transformer.SplitState
+
+	if journal != nil && !journal.matches(cnr, fi, maxSize) {
+		cmd.PrintErrf("Upload journal '%s' does not match the file, starting over\n", journalPath)
+		journal = nil
+	}
+
+	if journal != nil {
+		state, err = journal.splitState()
+		exitOnErr(cmd, errf("invalid upload journal: %w", err))
+
+		mismatch, err := checkStoredParts(f, maxSize, headPrm, hdr.ContainerID(), state)
+		exitOnErr(cmd, errf("can't check stored parts: %w", err))
+
+		if mismatch != "" {
+			cmd.PrintErrf("Upload journal '%s' is outdated (%s), starting over\n", journalPath, mismatch)
+			journal = nil
+		} else {
+			cmd.Printf("Resuming upload, %d parts are already stored\n", len(journal.Parts))
+		}
+	}
+
+	if journal == nil {
+		journal = &uploadJournal{
+			path:          journalPath,
+			Container:     cnr,
+			FileSize:      fi.Size(),
+			ModTime:       fi.ModTime().UnixNano(),
+			MaxObjectSize: maxSize,
+			SplitID:       object.NewSplitID().ToV2(),
+		}
+
+		state, err = journal.splitState()
+		exitOnErr(cmd, errf("invalid upload journal: %w", err))
+	}
+
+	target := transformer.NewResumedPayloadSizeLimiter(maxSize, state, func() transformer.ObjectTarget {
+		return transformer.NewFormatTarget(&transformer.FormatterParams{
+			Key: key,
+			NextTarget: &uploadTarget{
+				prm:     prm,
+				journal: journal,
+			},
+			NetworkState: epochState(ni.CurrentEpoch()),
+		})
+	})
+
+	err = target.WriteHeader(hdr)
+	exitOnErr(cmd, errf("can't write object header: %w", err))
+
+	_, err = io.Copy(target, payload)
+	exitOnErr(cmd, errf("rpc error: %w", err))
+
+	ids, err := target.Close()
+	exitOnErr(cmd, errf("rpc error: %w", err))
+
+	if err := os.Remove(journalPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		cmd.PrintErrf("Failed to remove upload journal '%s': %v\n", journalPath, err)
+	}
+
+	if id := ids.ParentID(); id != nil {
+		return id
+	}
+
+	return ids.SelfID()
+}
+
+// maxObjectSize returns zero if the parameter is missing.
+func maxObjectSize(ni *netmap.NetworkInfo) (res uint64) {
+	ni.NetworkConfig().IterateParameters(func(prm *netmap.NetworkParameter) bool {
+		if string(prm.Key()) == maxObjectSizeParameter {
+			res = bigint.FromBytes(prm.Value()).Uint64()
+			return true
+		}
+
+		return false
+	})
+
+	return
+}
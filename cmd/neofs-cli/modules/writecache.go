@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
+	"github.com/nspcc-dev/neofs-sdk-go/util/signature"
+	"github.com/spf13/cobra"
+)
+
+var flushCacheCmd = &cobra.Command{
+	Use:   "flush-cache",
+	Short: "Flush objects from the write-cache to the main storage",
+	Long:  "Flush objects from the write-cache to the main storage",
+	Run:   flushCache,
+}
+
+var cacheStatsCmd = &cobra.Command{
+	Use:   "cache-stats",
+	Short: "Show write-cache statistics of the shards",
+	Long:  "Show write-cache statistics of the shards. Statistics of all shards are shown if no shard IDs are specified.",
+	Run:   cacheStats,
+}
+
+func flushCache(cmd *cobra.Command, _ []string) {
+	key, err := getKeyNoGenerate()
+	exitOnErr(cmd, err)
+
+	body := new(control.FlushWriteCacheRequest_Body)
+	body.SetShardIDList(getShardIDList(cmd))
+
+	req := new(control.FlushWriteCacheRequest)
+	req.SetBody(body)
+
+	err = controlSvc.SignMessage(key, req)
+	exitOnErr(cmd, errf("could not sign request: %w", err))
+
+	cli, err := getControlSDKClient(key)
+	exitOnErr(cmd, err)
+
+	var resp *control.FlushWriteCacheResponse
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.FlushWriteCache(client, req)
+		return err
+	})
+	exitOnErr(cmd, errf("rpc error: %w", err))
+
+	sign := resp.GetSignature()
+
+	err = signature.VerifyDataWithSource(
+		resp,
+		func() ([]byte, []byte) {
+			return sign.GetKey(), sign.GetSign()
+		},
+	)
+	exitOnErr(cmd, errf("invalid response signature: %w", err))
+
+	cmd.Println("Write-cache has been flushed successfully.")
+}
+
+func cacheStats(cmd *cobra.Command, _ []string) {
+	key, err := getKeyNoGenerate()
+	exitOnErr(cmd, err)
+
+	body := new(control.WriteCacheStatsRequest_Body)
+	body.SetShardIDList(getShardIDList(cmd))
+
+	req := new(control.WriteCacheStatsRequest)
+	req.SetBody(body)
+
+	err = controlSvc.SignMessage(key, req)
+	exitOnErr(cmd, errf("could not sign request: %w", err))
+
+	cli, err := getControlSDKClient(key)
+	exitOnErr(cmd, err)
+
+	var resp *control.WriteCacheStatsResponse
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.WriteCacheStats(client, req)
+		return err
+	})
+	exitOnErr(cmd, errf("rpc error: %w", err))
+
+	sign := resp.GetSignature()
+
+	err = signature.VerifyDataWithSource(
+		resp,
+		func() ([]byte, []byte) {
+			return sign.GetKey(), sign.GetSign()
+		},
+	)
+	exitOnErr(cmd, errf("invalid response signature: %w", err))
+
+	for _, st := range resp.GetBody().GetStats() {
+		cmd.Printf("Shard %s:\n"+
+			"In-memory objects: %d (%d bytes)\n"+
+			"Database objects: %d\n"+
+			"FSTree objects: %d\n"+
+			"Flush lag: %s\n",
+			base58.Encode(st.GetShard_ID()),
+			st.GetMemCount(), st.GetMemSize(),
+			st.GetDbCount(),
+			st.GetFsCount(),
+			time.Duration(st.GetFlushLag())*time.Millisecond,
+		)
+	}
+}
+
+// getShardIDList returns decoded list of shard IDs from the command flag.
+func getShardIDList(cmd *cobra.Command) [][]byte {
+	strIDs, _ := cmd.Flags().GetStringSlice(shardIDFlag)
+
+	rawIDs := make([][]byte, 0, len(strIDs))
+	for i := range strIDs {
+		rawID, err := base58.Decode(strIDs[i])
+		exitOnErr(cmd, errf("incorrect shard ID encoding: %w", err))
+
+		rawIDs = append(rawIDs, rawID)
+	}
+
+	return rawIDs
+}
+
+func initControlFlushCacheCmd() {
+	initCommonFlagsWithoutRPC(flushCacheCmd)
+
+	flags := flushCacheCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+
+	_ = flushCacheCmd.MarkFlagRequired(shardIDFlag)
+	_ = flushCacheCmd.MarkFlagRequired(controlRPC)
+}
+
+func initControlCacheStatsCmd() {
+	initCommonFlagsWithoutRPC(cacheStatsCmd)
+
+	flags := cacheStatsCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding (all shards if empty)")
+
+	_ = cacheStatsCmd.MarkFlagRequired(controlRPC)
+}
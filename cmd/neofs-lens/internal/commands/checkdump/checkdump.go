@@ -0,0 +1,107 @@
+package checkdump
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/dump"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagFile    = "path"
+	flagObjects = "objects"
+)
+
+var (
+	vPath    string
+	vObjects bool
+)
+
+// Command contains `check-dump` command definition.
+var Command = &cobra.Command{
+	Use:   "check-dump",
+	Short: "Shard dump verification",
+	Long: `Verify integrity of the shard dump file: record checksums, number of records and dump hash.
+Legacy dumps have no checksums, so only their structure is checked.`,
+	Run: checkDumpCmd,
+}
+
+func init() {
+	Command.Flags().StringVar(&vPath, flagFile, "", "Path to shard dump file")
+	_ = Command.MarkFlagFilename(flagFile)
+	_ = Command.MarkFlagRequired(flagFile)
+
+	Command.Flags().BoolVar(&vObjects, flagObjects, false,
+		"Decode objects and print their addresses",
+	)
+}
+
+func checkDumpCmd(cmd *cobra.Command, _ []string) {
+	f, err := os.Open(vPath)
+	common.ExitOnErr(cmd, common.Errf("could not open dump file: %w", err))
+
+	defer f.Close()
+
+	r, err := dump.NewReader(f)
+	common.ExitOnErr(cmd, common.Errf("could not read dump header: %w", err))
+
+	defer r.Close()
+
+	cmd.Printf("Version: %d\n", r.Version())
+	cmd.Printf("Compressed: %t\n", r.Compressed())
+
+	var corrupted, invalid int
+
+	for {
+		data, err := r.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			if errors.Is(err, dump.ErrChecksumMismatch) {
+				cmd.PrintErrln(err)
+				corrupted++
+				continue
+			}
+
+			// dump hash can not match if some records are corrupted
+			if errors.Is(err, dump.ErrHashMismatch) && corrupted != 0 {
+				break
+			}
+
+			common.ExitOnErr(cmd, common.Errf("invalid dump: %w", err))
+		}
+
+		if !vObjects {
+			continue
+		}
+
+		obj := object.New()
+		if err := obj.Unmarshal(data); err != nil {
+			cmd.PrintErrf("record #%d: could not decode object: %v\n", r.Count(), err)
+			invalid++
+			continue
+		}
+
+		cmd.Println(objectCore.AddressOf(obj))
+	}
+
+	cmd.Printf("Records: %d\n", r.Count())
+
+	if vObjects {
+		cmd.Printf("Invalid objects: %d\n", invalid)
+	}
+
+	if corrupted != 0 {
+		common.ExitOnErr(cmd, fmt.Errorf("invalid dump: %d corrupted records", corrupted))
+	}
+
+	cmd.Println("Dump is valid.")
+}
@@ -6,6 +6,7 @@ import (
 
 	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
@@ -92,11 +93,22 @@ func objectInspectCmd(cmd *cobra.Command, _ []string) {
 }
 
 func printObjectInfo(cmd *cobra.Command, data []byte) {
+	codec := compression.Detect(data)
+
+	dec, err := compression.NewDecompressor()
+	common.ExitOnErr(cmd, common.Errf("could not create decompressor: %w", err))
+
+	defer dec.Close()
+
+	data, err = dec.Decompress(data)
+	common.ExitOnErr(cmd, common.Errf("could not decompress object: %w", err))
+
 	obj := object.New()
-	err := obj.Unmarshal(data)
+	err = obj.Unmarshal(data)
 	common.ExitOnErr(cmd, common.Errf("can't unmarshal object: %w", err))
 
 	if vHeader {
+		cmd.Println("Compression:", codec)
 		cmd.Println("Version:", obj.Version())
 		cmd.Println("Type:", obj.Type())
 		cmd.Println("CID:", obj.ContainerID())
@@ -5,19 +5,22 @@ import (
 
 	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 	"github.com/spf13/cobra"
 )
 
 const (
-	flagFile       = "path"
-	flagWriteCache = "writecache"
+	flagFile        = "path"
+	flagWriteCache  = "writecache"
+	flagCompression = "compression"
 )
 
 var (
-	vPath       string
-	vWriteCache bool
+	vPath        string
+	vWriteCache  bool
+	vCompression bool
 )
 
 func init() {
@@ -30,6 +33,10 @@ func init() {
 	Command.Flags().BoolVar(&vWriteCache, flagWriteCache, false,
 		"Process write-cache",
 	)
+
+	Command.Flags().BoolVar(&vCompression, flagCompression, false,
+		"Print compression codec of each object (blobovnicza only)",
+	)
 }
 
 var Command = &cobra.Command{
@@ -66,6 +73,21 @@ var Command = &cobra.Command{
 
 		defer blz.Close()
 
+		if vCompression {
+			var prm blobovnicza.IteratePrm
+
+			prm.DecodeAddresses()
+			prm.SetHandler(func(elem blobovnicza.IterationElement) error {
+				_, err := io.WriteString(w, elem.Address().String()+" "+compression.Detect(elem.ObjectData())+"\n")
+				return err
+			})
+
+			_, err := blz.Iterate(prm)
+			common.ExitOnErr(cmd, common.Errf("blobovnicza iterator failure: %w", err))
+
+			return
+		}
+
 		err := blobovnicza.IterateAddresses(blz, wAddr)
 		common.ExitOnErr(cmd, common.Errf("blobovnicza iterator failure: %w", err))
 	},
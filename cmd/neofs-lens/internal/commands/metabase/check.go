@@ -0,0 +1,223 @@
+package metabase
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"github.com/spf13/cobra"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	flagPath     = "path"
+	flagBlobstor = "blobstor"
+	flagDepth    = "depth"
+	flagBlzDepth = "blobovnicza-depth"
+	flagBlzWidth = "blobovnicza-width"
+	flagFix      = "fix"
+)
+
+// defaults of the storage node configuration
+const (
+	defaultDepth    = 4
+	defaultBlzDepth = 2
+	defaultBlzWidth = 16
+)
+
+const metabasePerm = 0600
+
+var problems = []meta.CheckProblem{
+	meta.ProblemOrphanedIndex,
+	meta.ProblemMissingIndex,
+	meta.ProblemWrongBlobovnicza,
+	meta.ProblemInvalidGrave,
+}
+
+var (
+	vPath     string
+	vBlobstor string
+	vDepth    int
+	vBlzDepth uint64
+	vBlzWidth uint64
+	vFix      bool
+)
+
+var checkCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Metabase consistency check",
+	Long: `Cross-check metabase indexes against blobstor contents: report orphaned index entries,
+objects missing from the index, wrong blobovnicza references and invalid graveyard records.
+Storage is opened in read-only mode unless fix is requested. Shard must not be used by the node
+during the check.`,
+	Run: checkCmdRun,
+}
+
+func init() {
+	flags := checkCmd.Flags()
+
+	flags.StringVar(&vPath, flagPath, "", "Path to metabase")
+	_ = checkCmd.MarkFlagFilename(flagPath)
+	_ = checkCmd.MarkFlagRequired(flagPath)
+
+	flags.StringVar(&vBlobstor, flagBlobstor, "", "Path to blobstor root directory")
+	_ = checkCmd.MarkFlagDirname(flagBlobstor)
+	_ = checkCmd.MarkFlagRequired(flagBlobstor)
+
+	flags.IntVar(&vDepth, flagDepth, defaultDepth, "Blobstor shallow depth")
+	flags.Uint64Var(&vBlzDepth, flagBlzDepth, defaultBlzDepth, "Blobovnicza shallow depth")
+	flags.Uint64Var(&vBlzWidth, flagBlzWidth, defaultBlzWidth, "Blobovnicza shallow width")
+	flags.BoolVar(&vFix, flagFix, false, "Repair found inconsistencies")
+}
+
+func checkCmdRun(cmd *cobra.Command, _ []string) {
+	db := meta.New(
+		meta.WithPath(vPath),
+		meta.WithPermissions(metabasePerm),
+		meta.WithBoltDBOptions(&bbolt.Options{
+			ReadOnly: !vFix,
+		}),
+	)
+	common.ExitOnErr(cmd, common.Errf("could not open metabase: %w", db.Open()))
+
+	defer db.Close()
+
+	opts := []blobstor.Option{
+		blobstor.WithRootPath(vBlobstor),
+		blobstor.WithShallowDepth(vDepth),
+		blobstor.WithBlobovniczaShallowDepth(vBlzDepth),
+		blobstor.WithBlobovniczaShallowWidth(vBlzWidth),
+	}
+
+	if !vFix {
+		opts = append(opts, blobstor.ReadOnly())
+	}
+
+	bs := blobstor.New(opts...)
+	common.ExitOnErr(cmd, common.Errf("could not open blobstor: %w", bs.Open()))
+
+	if vFix {
+		common.ExitOnErr(cmd, common.Errf("could not initialize blobstor: %w", bs.Init()))
+	}
+
+	defer bs.Close()
+
+	prm := new(meta.CheckPrm).
+		WithFix(vFix).
+		WithStorage(func(f func(*object.Object, *blobovnicza.ID) error) error {
+			var prm blobstor.IteratePrm
+
+			prm.IgnoreErrors()
+			prm.SetIterationHandler(func(elem blobstor.IterationElement) error {
+				obj := object.New()
+				if err := obj.Unmarshal(elem.ObjectData()); err != nil {
+					cmd.PrintErrf("could not unmarshal object from %s: %v\n", location(elem.BlobovniczaID()), err)
+					return nil
+				}
+
+				return f(obj, elem.BlobovniczaID())
+			})
+
+			_, err := bs.Iterate(prm)
+
+			return err
+		}).
+		WithStorageLookup(func(addr *addressSDK.Address, blzID *blobovnicza.ID) (bool, error) {
+			return lookup(bs, addr, blzID)
+		}).
+		WithStorageRemover(func(addr *addressSDK.Address, blzID *blobovnicza.ID) error {
+			if blzID == nil {
+				var prm blobstor.DeleteBigPrm
+				prm.SetAddress(addr)
+
+				_, err := bs.DeleteBig(&prm)
+
+				return err
+			}
+
+			var prm blobstor.DeleteSmallPrm
+			prm.SetAddress(addr)
+			prm.SetBlobovniczaID(blzID)
+
+			_, err := bs.DeleteSmall(&prm)
+
+			return err
+		}).
+		WithHandler(func(issue meta.CheckIssue) {
+			status := "found"
+			if issue.Fixed {
+				status = "fixed"
+			}
+
+			cmd.Printf("%s: %s %s", status, issue.Problem, issue.Key)
+
+			if issue.Details != "" {
+				cmd.Printf(" (%s)", issue.Details)
+			}
+
+			cmd.Println()
+		})
+
+	res, err := db.Check(prm)
+	common.ExitOnErr(cmd, common.Errf("could not check metabase: %w", err))
+
+	var found, fixed uint64
+
+	for _, p := range problems {
+		cmd.Printf("%s: %d found, %d fixed\n", p, res.Found(p), res.Fixed(p))
+
+		found += res.Found(p)
+		fixed += res.Fixed(p)
+	}
+
+	if found != fixed {
+		common.ExitOnErr(cmd, fmt.Errorf("metabase is inconsistent: %d issues are not fixed", found-fixed))
+	}
+}
+
+// lookup checks if the object is stored in the blobovnicza with the given
+// identifier or in the file tree if the identifier is nil.
+func lookup(bs *blobstor.BlobStor, addr *addressSDK.Address, blzID *blobovnicza.ID) (bool, error) {
+	if blzID == nil {
+		var prm blobstor.ExistsPrm
+		prm.SetAddress(addr)
+
+		res, err := bs.Exists(&prm)
+		if err != nil {
+			return false, err
+		}
+
+		return res.Exists(), nil
+	}
+
+	var prm blobstor.GetSmallPrm
+	prm.SetAddress(addr)
+	prm.SetBlobovniczaID(blzID)
+	prm.SetHeaderOnly(true)
+
+	_, err := bs.GetSmall(&prm)
+
+	switch {
+	case err == nil:
+		return true, nil
+	case blobovnicza.IsErrNotFound(err), errors.Is(err, os.ErrNotExist):
+		// blobovnicza is missing in read-only mode
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func location(id *blobovnicza.ID) string {
+	if id == nil {
+		return "file tree"
+	}
+
+	return "blobovnicza " + id.String()
+}
@@ -0,0 +1,16 @@
+package metabase
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Command contains `metabase` command definition.
+var Command = &cobra.Command{
+	Use:   "metabase",
+	Short: "Metabase operations",
+	Long:  `Operations with the metabase of the shard.`,
+}
+
+func init() {
+	Command.AddCommand(checkCmd)
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal/commands/checkdump"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal/commands/inspect"
 	cmdlist "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal/commands/list"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal/commands/metabase"
 	"github.com/nspcc-dev/neofs-node/misc"
 	"github.com/spf13/cobra"
 )
@@ -36,6 +38,8 @@ func init() {
 	command.AddCommand(
 		cmdlist.Command,
 		inspect.Command,
+		checkdump.Command,
+		metabase.Command,
 	)
 }
 
@@ -35,6 +35,8 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/network/cache"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl"
+	"github.com/nspcc-dev/neofs-node/pkg/services/policer"
+	"github.com/nspcc-dev/neofs-node/pkg/services/repairer"
 	trustcontroller "github.com/nspcc-dev/neofs-node/pkg/services/reputation/local/controller"
 	truststorage "github.com/nspcc-dev/neofs-node/pkg/services/reputation/local/storage"
 	"github.com/nspcc-dev/neofs-node/pkg/services/util/response"
@@ -42,6 +44,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 	"github.com/nspcc-dev/neofs-node/pkg/util/state"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 	"github.com/nspcc-dev/neofs-sdk-go/owner"
 	"github.com/nspcc-dev/neofs-sdk-go/version"
 	"github.com/panjf2000/ants/v2"
@@ -182,6 +185,12 @@ type cfgObject struct {
 	pool cfgObjectRoutines
 
 	cfgLocalStorage cfgLocalStorage
+
+	remoteReplicator *remoteObjectReplicator
+
+	policer *policer.Policer
+
+	repairer *repairer.Repairer
 }
 
 type cfgNotifications struct {
@@ -193,7 +202,9 @@ type cfgNotifications struct {
 type cfgLocalStorage struct {
 	localStorage *engine.StorageEngine
 
-	shardOpts [][]shard.Option
+	shardOpts []shardOptions
+
+	shardEvents *shardEventNotifier
 }
 
 type cfgObjectRoutines struct {
@@ -339,6 +350,11 @@ func initLocalStorage(c *cfg) {
 		engine.WithLogger(c.log),
 		engine.WithShardPoolSize(engineconfig.ShardPoolSize(c.appCfg)),
 		engine.WithErrorThreshold(engineconfig.ShardErrorThreshold(c.appCfg)),
+		engine.WithShardProbeInterval(engineconfig.ShardProbeInterval(c.appCfg)),
+		engine.WithShardProbeSuccessThreshold(engineconfig.ShardProbeSuccessThreshold(c.appCfg)),
+		engine.WithUnreadableObjectHandler(c.repairObject),
+		engine.WithReadCacheCapacity(engineconfig.ReadCacheCapacity(c.appCfg)),
+		engine.WithReadCacheMaxObjectSize(engineconfig.ReadCacheMaxObjectSize(c.appCfg)),
 	}
 	if c.metricsCollector != nil {
 		engineOpts = append(engineOpts, engine.WithMetrics(c.metricsCollector))
@@ -346,16 +362,25 @@ func initLocalStorage(c *cfg) {
 
 	ls := engine.New(engineOpts...)
 
+	shardEvents := newShardEventNotifier(c.log)
+
+	addNewEpochNotificationHandler(c, func(ev event.Event) {
+		shardEvents.notify(shard.EventNewEpoch(ev.(netmap2.NewEpoch).EpochNumber()))
+	})
+
 	for _, opts := range c.cfgObject.cfgLocalStorage.shardOpts {
-		id, err := ls.AddShard(opts...)
+		id, err := ls.AddShard(opts.opts...)
 		fatalOnErr(err)
 
+		shardEvents.subscribe(id, opts.events)
+
 		c.log.Info("shard attached to engine",
 			zap.Stringer("id", id),
 		)
 	}
 
 	c.cfgObject.cfgLocalStorage.localStorage = ls
+	c.cfgObject.cfgLocalStorage.shardEvents = shardEvents
 
 	c.onShutdown(func() {
 		c.log.Info("closing components of the storage engine...")
@@ -372,63 +397,113 @@ func initLocalStorage(c *cfg) {
 }
 
 func initShardOptions(c *cfg) {
-	var opts [][]shard.Option
+	var opts []shardOptions
 
 	require := !nodeconfig.Relay(c.appCfg) // relay node does not require shards
 
 	engineconfig.IterateShards(c.appCfg, require, func(sc *shardconfig.Config) {
-		var writeCacheOpts []writecache.Option
-
-		writeCacheCfg := sc.WriteCache()
-		if writeCacheCfg.Enabled() {
-			writeCacheOpts = []writecache.Option{
-				writecache.WithPath(writeCacheCfg.Path()),
-				writecache.WithLogger(c.log),
-				writecache.WithMaxMemSize(writeCacheCfg.MemSize()),
-				writecache.WithMaxObjectSize(writeCacheCfg.MaxObjectSize()),
-				writecache.WithSmallObjectSize(writeCacheCfg.SmallObjectSize()),
-				writecache.WithFlushWorkersCount(writeCacheCfg.WorkersNumber()),
-				writecache.WithMaxCacheSize(writeCacheCfg.SizeLimit()),
-			}
+		paths := shardPaths{
+			metabase: sc.Metabase().Path(),
+			blobStor: sc.BlobStor().Path(),
+		}
+
+		if writeCacheCfg := sc.WriteCache(); writeCacheCfg.Enabled() {
+			paths.writeCache = writeCacheCfg.Path()
+		}
+
+		fatalOnErr(util.MkdirAllX(filepath.Dir(paths.metabase), sc.Metabase().Perm()))
+
+		opts = append(opts, newShardOptions(c, sc, sc.Mode(), paths))
+	})
+
+	c.cfgObject.cfgLocalStorage.shardOpts = opts
+}
+
+// shardPaths groups paths to the shard components.
+type shardPaths struct {
+	metabase, blobStor string
+
+	// write-cache is disabled if empty
+	writeCache string
+}
+
+// shardOptions groups shard options and the channel
+// of the shard GC events.
+type shardOptions struct {
+	opts []shard.Option
+
+	events chan shard.Event
+}
+
+// newShardOptions returns options of the shard with the components
+// placed by paths, other parameters are taken from sc.
+func newShardOptions(c *cfg, sc *shardconfig.Config, mode shard.Mode, paths shardPaths) shardOptions {
+	var writeCacheOpts []writecache.Option
+
+	writeCacheCfg := sc.WriteCache()
+	if paths.writeCache != "" {
+		writeCacheOpts = []writecache.Option{
+			writecache.WithPath(paths.writeCache),
+			writecache.WithLogger(c.log),
+			writecache.WithMaxMemSize(writeCacheCfg.MemSize()),
+			writecache.WithMaxObjectSize(writeCacheCfg.MaxObjectSize()),
+			writecache.WithSmallObjectSize(writeCacheCfg.SmallObjectSize()),
+			writecache.WithFlushWorkersCount(writeCacheCfg.WorkersNumber()),
+			writecache.WithMaxCacheSize(writeCacheCfg.SizeLimit()),
 		}
+	}
 
-		blobStorCfg := sc.BlobStor()
-		blobovniczaCfg := blobStorCfg.Blobovnicza()
-		metabaseCfg := sc.Metabase()
-		gcCfg := sc.GC()
+	blobStorCfg := sc.BlobStor()
+	blobovniczaCfg := blobStorCfg.Blobovnicza()
+	metabaseCfg := sc.Metabase()
+	gcCfg := sc.GC()
+
+	blobStorOpts := []blobstor.Option{
+		blobstor.WithRootPath(paths.blobStor),
+		blobstor.WithCompressObjects(blobStorCfg.Compress()),
+		blobstor.WithCompressionCodec(blobStorCfg.CompressionCodec()),
+		blobstor.WithCompressionLevel(blobStorCfg.CompressionLevel()),
+		blobstor.WithCompressionExcludeBig(blobStorCfg.CompressionExcludeBig()),
+		blobstor.WithUncompressableContentTypes(blobStorCfg.UncompressableContentTypes()),
+		blobstor.WithRootPerm(blobStorCfg.Perm()),
+		blobstor.WithShallowDepth(blobStorCfg.ShallowDepth()),
+		blobstor.WithSmallSizeLimit(blobStorCfg.SmallSizeLimit()),
+		blobstor.WithBlobovniczaSize(blobovniczaCfg.Size()),
+		blobstor.WithBlobovniczaShallowDepth(blobovniczaCfg.ShallowDepth()),
+		blobstor.WithBlobovniczaShallowWidth(blobovniczaCfg.ShallowWidth()),
+		blobstor.WithBlobovniczaOpenedCacheSize(blobovniczaCfg.OpenedCacheSize()),
+		blobstor.WithBlobovniczaRangeCacheSize(blobovniczaCfg.RangeCacheSize()),
+		blobstor.WithLogger(c.log),
+	}
+	if c.metricsCollector != nil {
+		blobStorOpts = append(blobStorOpts, blobstor.WithMetrics(c.metricsCollector))
+	}
 
-		metaPath := metabaseCfg.Path()
-		metaPerm := metabaseCfg.Perm()
-		fatalOnErr(util.MkdirAllX(filepath.Dir(metaPath), metaPerm))
+	events := make(chan shard.Event, shardEventsBufferSize)
 
-		opts = append(opts, []shard.Option{
+	opts := shardOptions{
+		opts: []shard.Option{
 			shard.WithLogger(c.log),
 			shard.WithRefillMetabase(sc.RefillMetabase()),
-			shard.WithMode(sc.Mode()),
-			shard.WithBlobStorOptions(
-				blobstor.WithRootPath(blobStorCfg.Path()),
-				blobstor.WithCompressObjects(blobStorCfg.Compress()),
-				blobstor.WithRootPerm(blobStorCfg.Perm()),
-				blobstor.WithShallowDepth(blobStorCfg.ShallowDepth()),
-				blobstor.WithSmallSizeLimit(blobStorCfg.SmallSizeLimit()),
-				blobstor.WithBlobovniczaSize(blobovniczaCfg.Size()),
-				blobstor.WithBlobovniczaShallowDepth(blobovniczaCfg.ShallowDepth()),
-				blobstor.WithBlobovniczaShallowWidth(blobovniczaCfg.ShallowWidth()),
-				blobstor.WithBlobovniczaOpenedCacheSize(blobovniczaCfg.OpenedCacheSize()),
-				blobstor.WithLogger(c.log),
-			),
+			shard.WithMode(mode),
+			shard.WithUsageHighWatermark(sc.UsageHighWatermark()),
+			shard.WithWeightUpdateInterval(sc.UsageCheckInterval()),
+			shard.WithBlobStorOptions(blobStorOpts...),
 			shard.WithMetaBaseOptions(
 				meta.WithLogger(c.log),
-				meta.WithPath(metaPath),
-				meta.WithPermissions(metaPerm),
+				meta.WithPath(paths.metabase),
+				meta.WithPermissions(metabaseCfg.Perm()),
 				meta.WithBoltDBOptions(&bbolt.Options{
 					Timeout: 100 * time.Millisecond,
 				}),
 			),
-			shard.WithWriteCache(writeCacheCfg.Enabled()),
+			shard.WithWriteCache(paths.writeCache != ""),
 			shard.WithWriteCacheOptions(writeCacheOpts...),
 			shard.WithRemoverBatchSize(gcCfg.RemoverBatchSize()),
 			shard.WithGCRemoverSleepInterval(gcCfg.RemoverSleepInterval()),
+			shard.WithGCRemoverMaxSleepInterval(gcCfg.RemoverMaxSleepInterval()),
+			shard.WithGCLoadThreshold(gcCfg.LoadThreshold()),
+			shard.WithGCQuietHours(gcCfg.QuietHours()),
 			shard.WithGCWorkerPoolInitializer(func(sz int) util.WorkerPool {
 				pool, err := ants.NewPool(sz)
 				fatalOnErr(err)
@@ -436,18 +511,16 @@ func initShardOptions(c *cfg) {
 				return pool
 			}),
 			shard.WithGCEventChannelInitializer(func() <-chan shard.Event {
-				ch := make(chan shard.Event)
-
-				addNewEpochNotificationHandler(c, func(ev event.Event) {
-					ch <- shard.EventNewEpoch(ev.(netmap2.NewEpoch).EpochNumber())
-				})
-
-				return ch
+				return events
 			}),
-		})
-	})
+		},
+		events: events,
+	}
+	if c.metricsCollector != nil {
+		opts.opts = append(opts.opts, shard.WithMetrics(c.metricsCollector))
+	}
 
-	c.cfgObject.cfgLocalStorage.shardOpts = opts
+	return opts
 }
 
 func initObjectPool(cfg *config.Config) (pool cfgObjectRoutines) {
@@ -496,6 +569,13 @@ func (c *cfg) needBootstrap() bool {
 	return c.cfgNetmap.needBootstrap
 }
 
+// repairObject passes the unreadable local object to the object repairer.
+func (c *cfg) repairObject(addr *addressSDK.Address) {
+	if c.cfgObject.repairer != nil {
+		c.cfgObject.repairer.AddObject(addr)
+	}
+}
+
 // ObjectServiceLoad implements system loader interface for policer component.
 // It is calculated as size/capacity ratio of "remote object put" worker.
 // Returns float value between 0.0 and 1.0.
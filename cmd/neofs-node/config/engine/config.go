@@ -2,6 +2,7 @@ package engineconfig
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
@@ -13,6 +14,18 @@ const (
 	// ShardPoolSizeDefault is a default value of routine pool size per-shard to
 	// process object PUT operations in storage engine.
 	ShardPoolSizeDefault = 20
+
+	// ShardProbeIntervalDefault is a default interval between
+	// probes of the shards moved to read-only mode due to errors.
+	ShardProbeIntervalDefault = time.Minute
+
+	// ShardProbeSuccessThresholdDefault is a default number of successful
+	// probes after which the shard is moved back to read-write mode.
+	ShardProbeSuccessThresholdDefault = 3
+
+	// ReadCacheMaxObjectSizeDefault is a default maximum size
+	// of the object cached in memory after reading from the shards.
+	ReadCacheMaxObjectSizeDefault = 1 << 20
 )
 
 // IterateShards iterates over subsections ["0":"N") (N - "shard_num" value)
@@ -65,3 +78,55 @@ func ShardPoolSize(c *config.Config) uint32 {
 func ShardErrorThreshold(c *config.Config) uint32 {
 	return config.Uint32Safe(c.Sub(subsection), "shard_ro_error_threshold")
 }
+
+// ShardProbeInterval returns value of "shard_probe_interval" config parameter from "storage" section.
+//
+// Returns ShardProbeIntervalDefault if the value is not a positive duration.
+func ShardProbeInterval(c *config.Config) time.Duration {
+	v := config.DurationSafe(c.Sub(subsection), "shard_probe_interval")
+	if v > 0 {
+		return v
+	}
+
+	return ShardProbeIntervalDefault
+}
+
+// ShardProbeSuccessThreshold returns value of "shard_probe_success_threshold" config parameter
+// from "storage" section.
+//
+// Returns ShardProbeSuccessThresholdDefault if the value is not a positive number.
+func ShardProbeSuccessThreshold(c *config.Config) uint32 {
+	v := config.Uint32Safe(c.Sub(subsection), "shard_probe_success_threshold")
+	if v > 0 {
+		return v
+	}
+
+	return ShardProbeSuccessThresholdDefault
+}
+
+// ReadCacheCapacity returns value of "read_cache_capacity" config parameter
+// from "storage" section.
+//
+// Returns 0 (cache is disabled) if the value is not a positive number.
+func ReadCacheCapacity(c *config.Config) uint64 {
+	return config.SizeInBytesSafe(c.Sub(subsection), "read_cache_capacity")
+}
+
+// ReadCacheMaxObjectSize returns value of "read_cache_max_object_size" config
+// parameter from "storage" section.
+//
+// Returns ReadCacheMaxObjectSizeDefault if the value is not a positive number.
+func ReadCacheMaxObjectSize(c *config.Config) uint64 {
+	v := config.SizeInBytesSafe(c.Sub(subsection), "read_cache_max_object_size")
+	if v > 0 {
+		return v
+	}
+
+	return ReadCacheMaxObjectSizeDefault
+}
+
+// DefaultShard returns "default" subsection of "storage" section of c
+// wrapped into shardconfig.Config.
+func DefaultShard(c *config.Config) *shardconfig.Config {
+	return shardconfig.From(c.Sub(subsection).Sub("default"))
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	engineconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine"
 	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
+	blobstorconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor"
+	gcconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/gc"
 	configtest "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/test"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/stretchr/testify/require"
@@ -33,7 +35,13 @@ func TestEngineSection(t *testing.T) {
 
 		require.EqualValues(t, 0, engineconfig.ShardErrorThreshold(empty))
 		require.EqualValues(t, engineconfig.ShardPoolSizeDefault, engineconfig.ShardPoolSize(empty))
+		require.Equal(t, engineconfig.ShardProbeIntervalDefault, engineconfig.ShardProbeInterval(empty))
+		require.EqualValues(t, engineconfig.ShardProbeSuccessThresholdDefault, engineconfig.ShardProbeSuccessThreshold(empty))
+		require.Zero(t, engineconfig.ReadCacheCapacity(empty))
+		require.EqualValues(t, engineconfig.ReadCacheMaxObjectSizeDefault, engineconfig.ReadCacheMaxObjectSize(empty))
 		require.EqualValues(t, shard.ModeReadWrite, shardconfig.From(empty).Mode())
+		require.EqualValues(t, 0, shardconfig.From(empty).UsageHighWatermark())
+		require.Equal(t, shardconfig.UsageCheckIntervalDefault, shardconfig.From(empty).UsageCheckInterval())
 	})
 
 	const path = "../../../../config/example/node"
@@ -43,6 +51,10 @@ func TestEngineSection(t *testing.T) {
 
 		require.EqualValues(t, 100, engineconfig.ShardErrorThreshold(c))
 		require.EqualValues(t, 15, engineconfig.ShardPoolSize(c))
+		require.Equal(t, 30*time.Second, engineconfig.ShardProbeInterval(c))
+		require.EqualValues(t, 5, engineconfig.ShardProbeSuccessThreshold(c))
+		require.EqualValues(t, 268435456, engineconfig.ReadCacheCapacity(c))
+		require.EqualValues(t, 524288, engineconfig.ReadCacheMaxObjectSize(c))
 
 		engineconfig.IterateShards(c, true, func(sc *shardconfig.Config) {
 			defer func() {
@@ -72,6 +84,9 @@ func TestEngineSection(t *testing.T) {
 				require.Equal(t, "tmp/0/blob", blob.Path())
 				require.EqualValues(t, 0644, blob.Perm())
 				require.Equal(t, true, blob.Compress())
+				require.Equal(t, "snappy", blob.CompressionCodec())
+				require.Equal(t, 0, blob.CompressionLevel())
+				require.True(t, blob.CompressionExcludeBig())
 				require.Equal(t, []string{"audio/*", "video/*"}, blob.UncompressableContentTypes())
 				require.EqualValues(t, 5, blob.ShallowDepth())
 				require.EqualValues(t, 102400, blob.SmallSizeLimit())
@@ -80,12 +95,18 @@ func TestEngineSection(t *testing.T) {
 				require.EqualValues(t, 1, blz.ShallowDepth())
 				require.EqualValues(t, 4, blz.ShallowWidth())
 				require.EqualValues(t, 50, blz.OpenedCacheSize())
+				require.EqualValues(t, 16777216, blz.RangeCacheSize())
 
 				require.EqualValues(t, 150, gc.RemoverBatchSize())
 				require.Equal(t, 2*time.Minute, gc.RemoverSleepInterval())
+				require.Equal(t, 20*time.Minute, gc.RemoverMaxSleepInterval())
+				require.EqualValues(t, 50, gc.LoadThreshold())
+				require.Equal(t, &shard.QuietHours{From: 9 * time.Hour, To: 18 * time.Hour}, gc.QuietHours())
 
 				require.Equal(t, false, sc.RefillMetabase())
 				require.Equal(t, shard.ModeReadOnly, sc.Mode())
+				require.EqualValues(t, 95, sc.UsageHighWatermark())
+				require.Equal(t, 30*time.Second, sc.UsageCheckInterval())
 			case 1:
 				require.Equal(t, true, wc.Enabled())
 
@@ -102,6 +123,8 @@ func TestEngineSection(t *testing.T) {
 				require.Equal(t, "tmp/1/blob", blob.Path())
 				require.EqualValues(t, 0644, blob.Perm())
 				require.Equal(t, false, blob.Compress())
+				require.False(t, blob.CompressionExcludeBig())
+				require.Equal(t, blobstorconfig.CompressionCodecDefault, blob.CompressionCodec())
 				require.Equal(t, []string(nil), blob.UncompressableContentTypes())
 				require.EqualValues(t, 5, blob.ShallowDepth())
 				require.EqualValues(t, 102400, blob.SmallSizeLimit())
@@ -110,12 +133,18 @@ func TestEngineSection(t *testing.T) {
 				require.EqualValues(t, 1, blz.ShallowDepth())
 				require.EqualValues(t, 4, blz.ShallowWidth())
 				require.EqualValues(t, 50, blz.OpenedCacheSize())
+				require.EqualValues(t, 16777216, blz.RangeCacheSize())
 
 				require.EqualValues(t, 200, gc.RemoverBatchSize())
 				require.Equal(t, 5*time.Minute, gc.RemoverSleepInterval())
+				require.Equal(t, gcconfig.RemoverMaxSleepIntervalDefault, gc.RemoverMaxSleepInterval())
+				require.Zero(t, gc.LoadThreshold())
+				require.Nil(t, gc.QuietHours())
 
 				require.Equal(t, true, sc.RefillMetabase())
 				require.Equal(t, shard.ModeReadWrite, sc.Mode())
+				require.EqualValues(t, 0, sc.UsageHighWatermark())
+				require.Equal(t, shardconfig.UsageCheckIntervalDefault, sc.UsageCheckInterval())
 			}
 		})
 
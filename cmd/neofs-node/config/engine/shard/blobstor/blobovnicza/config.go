@@ -91,3 +91,13 @@ func (x *Config) OpenedCacheSize() int {
 
 	return OpenedCacheSizeDefault
 }
+
+// RangeCacheSize returns value of "range_cache_size" config parameter.
+//
+// Returns 0 (cache is disabled) if value is not a positive number.
+func (x *Config) RangeCacheSize() uint64 {
+	return config.SizeInBytesSafe(
+		(*config.Config)(x),
+		"range_cache_size",
+	)
+}
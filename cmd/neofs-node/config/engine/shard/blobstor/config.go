@@ -5,6 +5,7 @@ import (
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	blobovniczaconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor/blobovnicza"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 )
 
@@ -22,6 +23,9 @@ const (
 
 	// SmallSizeLimitDefault is a default limit of small objects payload in bytes.
 	SmallSizeLimitDefault = 1 << 20
+
+	// CompressionCodecDefault is a default codec of the object compression.
+	CompressionCodecDefault = compression.Zstd
 )
 
 // From wraps config section into Config.
@@ -88,7 +92,43 @@ func (x *Config) Compress() bool {
 	)
 }
 
-// UncompressableContentTypes returns value of "compress_skip_content_types" config parameter.
+// CompressionCodec returns value of "compression_codec" config parameter.
+//
+// Returns CompressionCodecDefault if value is not a non-empty string.
+func (x *Config) CompressionCodec() string {
+	v := config.StringSafe(
+		(*config.Config)(x),
+		"compression_codec",
+	)
+
+	if v == "" {
+		return CompressionCodecDefault
+	}
+
+	return v
+}
+
+// CompressionLevel returns value of "compression_level" config parameter.
+//
+// Returns 0 if value is not a valid number.
+func (x *Config) CompressionLevel() int {
+	return int(config.IntSafe(
+		(*config.Config)(x),
+		"compression_level",
+	))
+}
+
+// CompressionExcludeBig returns value of "compression_exclude_big" config parameter.
+//
+// Returns false if value is not a valid bool.
+func (x *Config) CompressionExcludeBig() bool {
+	return config.BoolSafe(
+		(*config.Config)(x),
+		"compression_exclude_big",
+	)
+}
+
+// UncompressableContentTypes returns value of "compression_exclude_content_types" config parameter.
 //
 // Returns nil if a value is missing or is invalid.
 func (x *Config) UncompressableContentTypes() []string {
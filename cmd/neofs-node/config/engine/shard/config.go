@@ -2,6 +2,7 @@ package shardconfig
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	blobstorconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor"
@@ -15,6 +16,13 @@ import (
 // which provides access to Shard configurations.
 type Config config.Config
 
+// config defaults
+const (
+	// UsageCheckIntervalDefault is a default interval between
+	// samplings of the shard disk usage.
+	UsageCheckIntervalDefault = time.Minute
+)
+
 // From wraps config section into Config.
 func From(c *config.Config) *Config {
 	return (*Config)(c)
@@ -62,6 +70,38 @@ func (x *Config) RefillMetabase() bool {
 	)
 }
 
+// UsageHighWatermark returns value of "usage_high_watermark" config parameter.
+//
+// Returns 0 if value is not a number in [0:100] range.
+func (x *Config) UsageHighWatermark() uint32 {
+	v := config.Uint32Safe(
+		(*config.Config)(x),
+		"usage_high_watermark",
+	)
+
+	if v > 100 {
+		return 0
+	}
+
+	return v
+}
+
+// UsageCheckInterval returns value of "usage_check_interval" config parameter.
+//
+// Returns UsageCheckIntervalDefault if value is not a positive duration.
+func (x *Config) UsageCheckInterval() time.Duration {
+	v := config.DurationSafe(
+		(*config.Config)(x),
+		"usage_check_interval",
+	)
+
+	if v > 0 {
+		return v
+	}
+
+	return UsageCheckIntervalDefault
+}
+
 // Mode return value of "mode" config parameter.
 //
 // Panics if read value is not one of predefined
@@ -77,6 +117,10 @@ func (x *Config) Mode() (m shard.Mode) {
 		m = shard.ModeReadWrite
 	case "read-only":
 		m = shard.ModeReadOnly
+	case "degraded":
+		m = shard.ModeDegraded
+	case "maintenance":
+		m = shard.ModeMaintenance
 	default:
 		panic(fmt.Sprintf("unknown shard mode: %s", s))
 	}
@@ -1,9 +1,12 @@
 package gcconfig
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 )
 
 // Config is a wrapper over the config section
@@ -17,6 +20,10 @@ const (
 
 	// RemoverSleepIntervalDefault is a default sleep interval of Shard GC's remover.
 	RemoverSleepIntervalDefault = time.Minute
+
+	// RemoverMaxSleepIntervalDefault is a default upper limit of the sleep
+	// interval of Shard GC's remover backing off due to the foreground load.
+	RemoverMaxSleepIntervalDefault = 10 * time.Minute
 )
 
 // From wraps config section into Config.
@@ -57,3 +64,65 @@ func (x *Config) RemoverSleepInterval() time.Duration {
 
 	return RemoverSleepIntervalDefault
 }
+
+// RemoverMaxSleepInterval returns value of "remover_max_sleep_interval"
+// config parameter.
+//
+// Returns RemoverMaxSleepIntervalDefault if value is not a positive number.
+func (x *Config) RemoverMaxSleepInterval() time.Duration {
+	s := config.DurationSafe(
+		(*config.Config)(x),
+		"remover_max_sleep_interval",
+	)
+
+	if s > 0 {
+		return s
+	}
+
+	return RemoverMaxSleepIntervalDefault
+}
+
+// LoadThreshold returns value of "load_threshold"
+// config parameter.
+//
+// Returns 0 if the value is missing, back-off is disabled in this case.
+func (x *Config) LoadThreshold() uint32 {
+	return config.Uint32Safe(
+		(*config.Config)(x),
+		"load_threshold",
+	)
+}
+
+// QuietHours returns value of "quiet_hours" config parameter
+// in "HH:MM-HH:MM" format.
+//
+// Returns nil if the value is missing.
+// Panics if the value has invalid format.
+func (x *Config) QuietHours() *shard.QuietHours {
+	s := config.StringSafe(
+		(*config.Config)(x),
+		"quiet_hours",
+	)
+
+	if s == "" {
+		return nil
+	}
+
+	bounds := strings.Split(s, "-")
+	if len(bounds) != 2 {
+		panic(fmt.Errorf("invalid quiet hours %q: expected HH:MM-HH:MM", s))
+	}
+
+	var q shard.QuietHours
+
+	for i, p := range []*time.Duration{&q.From, &q.To} {
+		t, err := time.Parse("15:04", strings.TrimSpace(bounds[i]))
+		if err != nil {
+			panic(fmt.Errorf("invalid quiet hours %q: %w", s, err))
+		}
+
+		*p = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+
+	return &q
+}
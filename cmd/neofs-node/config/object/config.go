@@ -39,3 +39,135 @@ func (g PutConfig) PoolSizeRemote() int {
 
 	return PutPoolSizeDefault
 }
+
+// GetConfig is a wrapper over "get" config section which provides access
+// to object get pipeline configuration of object service.
+type GetConfig struct {
+	cfg *config.Config
+}
+
+const (
+	getSubsection = "get"
+
+	// GetPrefetchWindowDefault is a default number of children of the
+	// split object read in parallel during the assembly.
+	GetPrefetchWindowDefault = 4
+
+	// GetPrefetchSizeDefault is a default maximum total payload size of
+	// the children of the split object buffered during the parallel reading.
+	GetPrefetchSizeDefault = 256 << 20
+)
+
+// Get returns structure that provides access to "get" subsection of
+// "object" section.
+func Get(c *config.Config) GetConfig {
+	return GetConfig{
+		c.Sub(subsection).Sub(getSubsection),
+	}
+}
+
+// PrefetchWindow returns value of "prefetch_window" config parameter.
+//
+// Returns GetPrefetchWindowDefault if value is not positive number.
+func (g GetConfig) PrefetchWindow() int {
+	v := config.Int(g.cfg, "prefetch_window")
+	if v > 0 {
+		return int(v)
+	}
+
+	return GetPrefetchWindowDefault
+}
+
+// PrefetchSize returns value of "prefetch_size" config parameter.
+//
+// Returns GetPrefetchSizeDefault if value is not positive number.
+func (g GetConfig) PrefetchSize() uint64 {
+	v := config.SizeInBytesSafe(g.cfg, "prefetch_size")
+	if v > 0 {
+		return v
+	}
+
+	return GetPrefetchSizeDefault
+}
+
+// LimitsConfig is a wrapper over "limits" config section which provides
+// access to request rate limits of object service. Each limit is a number
+// of requests per second, zero means no limit.
+type LimitsConfig struct {
+	cfg *config.Config
+}
+
+const limitsSubsection = "limits"
+
+// Limits returns structure that provides access to "limits" subsection of
+// "object" section.
+func Limits(c *config.Config) LimitsConfig {
+	return LimitsConfig{
+		c.Sub(subsection).Sub(limitsSubsection),
+	}
+}
+
+// Get returns value of "get" config parameter.
+//
+// Returns 0 if value is not a non-negative number.
+func (l LimitsConfig) Get() uint32 {
+	return config.Uint32Safe(l.cfg, "get")
+}
+
+// Put returns value of "put" config parameter.
+//
+// Returns 0 if value is not a non-negative number.
+func (l LimitsConfig) Put() uint32 {
+	return config.Uint32Safe(l.cfg, "put")
+}
+
+// Head returns value of "head" config parameter.
+//
+// Returns 0 if value is not a non-negative number.
+func (l LimitsConfig) Head() uint32 {
+	return config.Uint32Safe(l.cfg, "head")
+}
+
+// Search returns value of "search" config parameter.
+//
+// Returns 0 if value is not a non-negative number.
+func (l LimitsConfig) Search() uint32 {
+	return config.Uint32Safe(l.cfg, "search")
+}
+
+// Delete returns value of "delete" config parameter.
+//
+// Returns 0 if value is not a non-negative number.
+func (l LimitsConfig) Delete() uint32 {
+	return config.Uint32Safe(l.cfg, "delete")
+}
+
+// Range returns value of "range" config parameter.
+//
+// Returns 0 if value is not a non-negative number.
+func (l LimitsConfig) Range() uint32 {
+	return config.Uint32Safe(l.cfg, "range")
+}
+
+// RangeHash returns value of "range_hash" config parameter.
+//
+// Returns 0 if value is not a non-negative number.
+func (l LimitsConfig) RangeHash() uint32 {
+	return config.Uint32Safe(l.cfg, "range_hash")
+}
+
+// Sender returns value of "sender" config parameter: limit of the
+// requests from a single sender public key.
+//
+// Returns 0 if value is not a non-negative number.
+func (l LimitsConfig) Sender() uint32 {
+	return config.Uint32Safe(l.cfg, "sender")
+}
+
+// Container returns value of "container" config parameter: limit of the
+// requests to a single container.
+//
+// Returns 0 if value is not a non-negative number.
+func (l LimitsConfig) Container() uint32 {
+	return config.Uint32Safe(l.cfg, "container")
+}
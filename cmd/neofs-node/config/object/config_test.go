@@ -14,12 +14,38 @@ func TestObjectSection(t *testing.T) {
 		empty := configtest.EmptyConfig()
 
 		require.Equal(t, objectconfig.PutPoolSizeDefault, objectconfig.Put(empty).PoolSizeRemote())
+		require.Equal(t, objectconfig.GetPrefetchWindowDefault, objectconfig.Get(empty).PrefetchWindow())
+		require.EqualValues(t, objectconfig.GetPrefetchSizeDefault, objectconfig.Get(empty).PrefetchSize())
+
+		limits := objectconfig.Limits(empty)
+		require.Zero(t, limits.Get())
+		require.Zero(t, limits.Put())
+		require.Zero(t, limits.Head())
+		require.Zero(t, limits.Search())
+		require.Zero(t, limits.Delete())
+		require.Zero(t, limits.Range())
+		require.Zero(t, limits.RangeHash())
+		require.Zero(t, limits.Sender())
+		require.Zero(t, limits.Container())
 	})
 
 	const path = "../../../../config/example/node"
 
 	var fileConfigTest = func(c *config.Config) {
 		require.Equal(t, 100, objectconfig.Put(c).PoolSizeRemote())
+		require.Equal(t, 8, objectconfig.Get(c).PrefetchWindow())
+		require.EqualValues(t, 64*1024*1024, objectconfig.Get(c).PrefetchSize())
+
+		limits := objectconfig.Limits(c)
+		require.EqualValues(t, 1000, limits.Get())
+		require.EqualValues(t, 200, limits.Put())
+		require.EqualValues(t, 2000, limits.Head())
+		require.EqualValues(t, 50, limits.Search())
+		require.EqualValues(t, 100, limits.Delete())
+		require.EqualValues(t, 1000, limits.Range())
+		require.EqualValues(t, 500, limits.RangeHash())
+		require.EqualValues(t, 300, limits.Sender())
+		require.EqualValues(t, 1500, limits.Container())
 	}
 
 	configtest.ForEachFileType(path, fileConfigTest)
@@ -25,3 +25,20 @@ func HeadTimeout(c *config.Config) time.Duration {
 
 	return HeadTimeoutDefault
 }
+
+// ObjectsPerSecond returns value of "objects_per_second" config parameter
+// from "policer" section.
+//
+// Returns 0 if value is not positive number. Zero value means no limit.
+func ObjectsPerSecond(c *config.Config) uint32 {
+	return config.Uint32Safe(c.Sub(subsection), "objects_per_second")
+}
+
+// RedundantCopyEpochs returns value of "redundant_copy_epochs" config parameter
+// from "policer" section.
+//
+// Returns 0 if value is not positive number. Zero value means that
+// redundant local copies are removed immediately.
+func RedundantCopyEpochs(c *config.Config) uint64 {
+	return config.UintSafe(c.Sub(subsection), "redundant_copy_epochs")
+}
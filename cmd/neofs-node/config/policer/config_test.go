@@ -15,12 +15,16 @@ func TestPolicerSection(t *testing.T) {
 		empty := configtest.EmptyConfig()
 
 		require.Equal(t, policerconfig.HeadTimeoutDefault, policerconfig.HeadTimeout(empty))
+		require.Zero(t, policerconfig.ObjectsPerSecond(empty))
+		require.Zero(t, policerconfig.RedundantCopyEpochs(empty))
 	})
 
 	const path = "../../../../config/example/node"
 
 	var fileConfigTest = func(c *config.Config) {
 		require.Equal(t, 15*time.Second, policerconfig.HeadTimeout(c))
+		require.EqualValues(t, 100, policerconfig.ObjectsPerSecond(c))
+		require.EqualValues(t, 2, policerconfig.RedundantCopyEpochs(c))
 	}
 
 	configtest.ForEachFileType(path, fileConfigTest)
@@ -11,6 +11,13 @@ const (
 
 	// PutTimeoutDefault is a default timeout of object put request in replicator.
 	PutTimeoutDefault = 5 * time.Second
+
+	// QueuePathDefault is a default path to the file of the persistent
+	// replication queue.
+	QueuePathDefault = ".neofs-replicator-queue"
+
+	// QueueCapacityDefault is a default capacity of the replication queue.
+	QueueCapacityDefault = 10000
 )
 
 // PutTimeout returns value of "put_timeout" config parameter
@@ -25,3 +32,29 @@ func PutTimeout(c *config.Config) time.Duration {
 
 	return PutTimeoutDefault
 }
+
+// QueuePath returns value of "queue_path" config parameter
+// from "replicator" section.
+//
+// Returns QueuePathDefault if value is not a non-empty string.
+func QueuePath(c *config.Config) string {
+	v := config.StringSafe(c.Sub(subsection), "queue_path")
+	if v != "" {
+		return v
+	}
+
+	return QueuePathDefault
+}
+
+// QueueCapacity returns value of "queue_capacity" config parameter
+// from "replicator" section.
+//
+// Returns QueueCapacityDefault if value is not positive number.
+func QueueCapacity(c *config.Config) uint32 {
+	v := config.Uint32Safe(c.Sub(subsection), "queue_capacity")
+	if v > 0 {
+		return v
+	}
+
+	return QueueCapacityDefault
+}
@@ -15,12 +15,16 @@ func TestReplicatorSection(t *testing.T) {
 		empty := configtest.EmptyConfig()
 
 		require.Equal(t, replicatorconfig.PutTimeoutDefault, replicatorconfig.PutTimeout(empty))
+		require.Equal(t, replicatorconfig.QueuePathDefault, replicatorconfig.QueuePath(empty))
+		require.EqualValues(t, replicatorconfig.QueueCapacityDefault, replicatorconfig.QueueCapacity(empty))
 	})
 
 	const path = "../../../../config/example/node"
 
 	var fileConfigTest = func(c *config.Config) {
 		require.Equal(t, 15*time.Second, replicatorconfig.PutTimeout(c))
+		require.Equal(t, "/replicator/queue", replicatorconfig.QueuePath(c))
+		require.EqualValues(t, 5000, replicatorconfig.QueueCapacity(c))
 	}
 
 	configtest.ForEachFileType(path, fileConfigTest)
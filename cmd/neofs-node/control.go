@@ -41,6 +41,9 @@ func initControlService(c *cfg) {
 			return err
 		}),
 		controlSvc.WithLocalStorage(c.cfgObject.cfgLocalStorage.localStorage),
+		controlSvc.WithShardAttacher(c),
+		controlSvc.WithReplicateObjectHandler(c.cfgObject.remoteReplicator.replicate),
+		controlSvc.WithPlacementChecker(c.cfgObject.policer),
 	)
 
 	lis, err := net.Listen("tcp", endpoint)
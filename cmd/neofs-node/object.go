@@ -3,13 +3,17 @@ package main
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/object"
 	objectGRPC "github.com/nspcc-dev/neofs-api-go/v2/object/grpc"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	objectconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/object"
 	policerconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/policer"
 	replicatorconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/replicator"
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
@@ -32,6 +36,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/services/policer"
+	"github.com/nspcc-dev/neofs-node/pkg/services/repairer"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
 	"github.com/nspcc-dev/neofs-node/pkg/services/reputation"
 	truststorage "github.com/nspcc-dev/neofs-node/pkg/services/reputation/local/storage"
@@ -40,6 +45,7 @@ import (
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
+	netmapSDK "github.com/nspcc-dev/neofs-sdk-go/netmap"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 	"github.com/nspcc-dev/neofs-sdk-go/owner"
@@ -110,6 +116,87 @@ func (r *localObjectInhumer) DeleteObjects(ts *addressSDK.Address, addr ...*addr
 	return err
 }
 
+// remoteObjectReplicator saves objects which can not be evacuated
+// to any local shard on the other container nodes.
+type remoteObjectReplicator struct {
+	cnrSrc container.Source
+
+	placement placement.Builder
+
+	netmapKeys netmap.AnnouncedKeys
+
+	repl *replicator.Replicator
+}
+
+var errNoRemoteReplica = errors.New("object has not been replicated to any remote node")
+
+func (r *remoteObjectReplicator) replicate(addr *addressSDK.Address, obj *objectSDK.Object) error {
+	cnr, err := r.cnrSrc.Get(addr.ContainerID())
+	if err != nil {
+		return fmt.Errorf("could not get container: %w", err)
+	}
+
+	nn, err := r.placement.BuildPlacement(addr, cnr.PlacementPolicy())
+	if err != nil {
+		return fmt.Errorf("could not build placement vector: %w", err)
+	}
+
+	var nodes netmapSDK.Nodes
+
+	for i := range nn {
+		for j := range nn[i] {
+			if !r.netmapKeys.IsLocalKey(nn[i][j].PublicKey()) {
+				nodes = append(nodes, nn[i][j])
+			}
+		}
+	}
+
+	// one remote replica is enough for the object not to be lost,
+	// missing replicas are restored by the policer; evacuation is
+	// requested explicitly, so it is not postponed by the back-off
+	task := new(replicator.Task).
+		WithObjectAddress(addr).
+		WithObject(obj).
+		WithNodes(nodes).
+		WithCopiesNumber(1).
+		WithIgnoreBackoff(true)
+
+	r.repl.HandleTask(context.Background(), task)
+
+	if task.CopiesNumber() != 0 {
+		return errNoRemoteReplica
+	}
+
+	return nil
+}
+
+// remoteObjectSource reads copies of the local objects
+// from the container nodes for the object repairer.
+type remoteObjectSource struct {
+	svc *getsvc.Service
+}
+
+func (s *remoteObjectSource) Get(ctx context.Context, addr *addressSDK.Address) (*objectSDK.Object, error) {
+	// only the nearest nodes are requested
+	common := new(util.CommonPrm).WithLocalOnly(false)
+	common.SetTTL(1)
+
+	w := getsvc.NewSimpleObjectWriter()
+
+	var prm getsvc.Prm
+	prm.SetCommonParameters(common)
+	prm.WithAddress(addr)
+	prm.WithRawFlag(true)
+	prm.SetObjectWriter(w)
+
+	err := s.svc.Get(ctx, prm)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.Object(), nil
+}
+
 type delNetInfo struct {
 	netmap.State
 	tsLifetime uint64
@@ -164,6 +251,65 @@ func (f *innerRingFetcherWithoutNotary) InnerRingKeys() ([][]byte, error) {
 	return result, nil
 }
 
+// systemSenders recognizes the keys of the inner ring nodes and
+// of the storage nodes of the container.
+type systemSenders struct {
+	irFetcher v2.InnerRingFetcher
+
+	cnrSrc container.Source
+
+	nmSrc netmap.Source
+}
+
+func (s *systemSenders) IsSystemSender(binCID, key []byte) bool {
+	irKeys, err := s.irFetcher.InnerRingKeys()
+	if err == nil {
+		for i := range irKeys {
+			if bytes.Equal(irKeys[i], key) {
+				return true
+			}
+		}
+	}
+
+	if len(binCID) == 0 {
+		return false
+	}
+
+	v2CID := new(refs.ContainerID)
+	v2CID.SetValue(binCID)
+
+	cnr, err := s.cnrSrc.Get(cid.NewFromV2(v2CID))
+	if err != nil {
+		return false
+	}
+
+	// previous network map is checked too, since
+	// the objects are migrated in-between epoch change
+	for _, getNetMap := range [...]func(netmap.Source) (*netmapSDK.Netmap, error){
+		netmap.GetLatestNetworkMap,
+		netmap.GetPreviousNetworkMap,
+	} {
+		nm, err := getNetMap(s.nmSrc)
+		if err != nil {
+			continue
+		}
+
+		nodes, err := nm.GetContainerNodes(cnr.PlacementPolicy(), binCID)
+		if err != nil {
+			continue
+		}
+
+		flat := nodes.Flatten()
+		for i := range flat {
+			if bytes.Equal(flat[i].PublicKey(), key) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 type coreClientConstructor reputationClientConstructor
 
 func (x *coreClientConstructor) Get(info coreclient.NodeInfo) (coreclient.MultiAddressClient, error) {
@@ -206,20 +352,48 @@ func initObjectService(c *cfg) {
 		log:     c.log,
 	}
 
-	repl := replicator.New(
+	replOpts := []replicator.Option{
 		replicator.WithLogger(c.log),
 		replicator.WithPutTimeout(
 			replicatorconfig.PutTimeout(c.appCfg),
 		),
+		replicator.WithQueuePath(
+			replicatorconfig.QueuePath(c.appCfg),
+		),
+		replicator.WithQueueCapacity(
+			replicatorconfig.QueueCapacity(c.appCfg),
+		),
 		replicator.WithLocalStorage(ls),
 		replicator.WithRemoteSender(
 			putsvc.NewRemoteSender(keyStorage, coreConstructor),
 		),
-	)
+		replicator.WithPool(c.cfgObject.pool.replication),
+	}
+
+	if c.metricsCollector != nil {
+		replOpts = append(replOpts, replicator.WithMetrics(c.metricsCollector))
+	}
+
+	repl := replicator.New(replOpts...)
+
+	fatalOnErr(repl.Open())
+
+	c.onShutdown(func() {
+		if err := repl.Close(); err != nil {
+			c.log.Warn("could not close replication queue", zap.String("error", err.Error()))
+		}
+	})
 
 	c.workers = append(c.workers, repl)
 
-	pol := policer.New(
+	c.cfgObject.remoteReplicator = &remoteObjectReplicator{
+		cnrSrc:     c.cfgObject.cnrSource,
+		placement:  placement.NewNetworkMapSourceBuilder(c.cfgObject.netMapSource),
+		netmapKeys: c,
+		repl:       repl,
+	}
+
+	polOpts := []policer.Option{
 		policer.WithLogger(c.log),
 		policer.WithLocalStorage(ls),
 		policer.WithContainerSource(c.cfgObject.cnrSource),
@@ -245,7 +419,22 @@ func initObjectService(c *cfg) {
 		policer.WithMaxCapacity(c.cfgObject.pool.putRemoteCapacity),
 		policer.WithPool(c.cfgObject.pool.replication),
 		policer.WithNodeLoader(c),
-	)
+		policer.WithObjectsPerSecond(
+			policerconfig.ObjectsPerSecond(c.appCfg),
+		),
+		policer.WithNetworkState(c.cfgNetmap.state),
+		policer.WithRedundantCopyEpochs(
+			policerconfig.RedundantCopyEpochs(c.appCfg),
+		),
+	}
+
+	if c.metricsCollector != nil {
+		polOpts = append(polOpts, policer.WithMetrics(c.metricsCollector))
+	}
+
+	pol := policer.New(polOpts...)
+
+	c.cfgObject.policer = pol
 
 	traverseGen := util.NewTraverserGenerator(c.cfgObject.netMapSource, c.cfgObject.cnrSource, c)
 
@@ -316,8 +505,20 @@ func initObjectService(c *cfg) {
 		),
 		getsvc.WithNetMapSource(c.cfgNetmap.wrapper),
 		getsvc.WithKeyStorage(keyStorage),
+		getsvc.WithAssemblyPrefetchWindow(objectconfig.Get(c.appCfg).PrefetchWindow()),
+		getsvc.WithAssemblyPrefetchSize(objectconfig.Get(c.appCfg).PrefetchSize()),
+	)
+
+	rep := repairer.New(
+		repairer.WithLogger(c.log),
+		repairer.WithLocalStorage(ls),
+		repairer.WithObjectSource(&remoteObjectSource{svc: sGet}),
 	)
 
+	c.cfgObject.repairer = rep
+
+	c.workers = append(c.workers, rep)
+
 	sGetV2 := getsvcV2.NewService(
 		getsvcV2.WithInternalService(sGet),
 		getsvcV2.WithKeyStorage(keyStorage),
@@ -342,7 +543,7 @@ func initObjectService(c *cfg) {
 	)
 
 	// build service pipeline
-	// grpc | <metrics> | signature | response | acl | split
+	// grpc | <metrics> | signature | response | limit | acl | split
 
 	splitSvc := objectService.NewTransportSplitter(
 		c.cfgGRPC.maxChunkSize,
@@ -373,8 +574,36 @@ func initObjectService(c *cfg) {
 		),
 	)
 
-	respSvc := objectService.NewResponseService(
+	limitsCfg := objectconfig.Limits(c.appCfg)
+
+	var limitMetrics objectService.LimitRegister
+	if c.metricsCollector != nil {
+		limitMetrics = c.metricsCollector
+	}
+
+	limitSvc := objectService.NewLimitService(
 		aclSvc,
+		objectService.Limits{
+			Get:       float64(limitsCfg.Get()),
+			Put:       float64(limitsCfg.Put()),
+			Head:      float64(limitsCfg.Head()),
+			Search:    float64(limitsCfg.Search()),
+			Delete:    float64(limitsCfg.Delete()),
+			Range:     float64(limitsCfg.Range()),
+			RangeHash: float64(limitsCfg.RangeHash()),
+			Sender:    float64(limitsCfg.Sender()),
+			Container: float64(limitsCfg.Container()),
+		},
+		&systemSenders{
+			irFetcher: irFetcher,
+			cnrSrc:    c.cfgObject.cnrSource,
+			nmSrc:     c.cfgObject.netMapSource,
+		},
+		limitMetrics,
+	)
+
+	respSvc := objectService.NewResponseService(
+		limitSvc,
 		c.respSvc,
 	)
 
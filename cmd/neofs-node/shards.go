@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	engineconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"go.uber.org/zap"
+)
+
+// shardEventsBufferSize is a capacity of the channel
+// of the shard GC events.
+const shardEventsBufferSize = 16
+
+// shardEventNotifier delivers GC events to the shards
+// of the storage engine. Shards can be subscribed and
+// unsubscribed at runtime.
+type shardEventNotifier struct {
+	log *zap.Logger
+
+	mtx sync.RWMutex
+
+	subs map[string]chan shard.Event
+}
+
+func newShardEventNotifier(log *zap.Logger) *shardEventNotifier {
+	return &shardEventNotifier{
+		log:  log,
+		subs: make(map[string]chan shard.Event),
+	}
+}
+
+// subscribe registers the channel of the shard GC events.
+func (n *shardEventNotifier) subscribe(id *shard.ID, ch chan shard.Event) {
+	n.mtx.Lock()
+	n.subs[id.String()] = ch
+	n.mtx.Unlock()
+}
+
+// unsubscribe removes the channel of the shard GC events and closes it.
+func (n *shardEventNotifier) unsubscribe(id *shard.ID) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	if ch, ok := n.subs[id.String()]; ok {
+		close(ch)
+		delete(n.subs, id.String())
+	}
+}
+
+// notify sends the event to all subscribed shards. Send does not block:
+// if the shard does not process the events in time and its channel is
+// full, the oldest pending event is dropped in favor of the new one, so
+// the shard always receives the latest epoch. Channels are closed under
+// the write lock only, so the send never happens on the closed channel.
+func (n *shardEventNotifier) notify(ev shard.Event) {
+	n.mtx.RLock()
+	defer n.mtx.RUnlock()
+
+	for id, ch := range n.subs {
+		select {
+		case ch <- ev:
+			continue
+		default:
+		}
+
+		select {
+		case <-ch:
+			n.log.Warn("shard does not process events in time, the oldest pending event is dropped",
+				zap.String("id", id),
+			)
+		default:
+		}
+
+		select {
+		case ch <- ev:
+		default:
+			n.log.Warn("shard event channel is full, event is dropped",
+				zap.String("id", id),
+			)
+		}
+	}
+}
+
+// AttachShard creates the shard with the provided paths and attaches it to the
+// storage engine. Parameters which are not specified in the request are taken
+// from the default shard configuration section.
+//
+// Attached shard is not saved to the node configuration, so it must be added
+// there to be attached after the node restart.
+func (c *cfg) AttachShard(mode shard.Mode, metabasePath, blobstorPath, writeCachePath string) (*shard.ID, error) {
+	sc := engineconfig.DefaultShard(c.appCfg)
+
+	err := util.MkdirAllX(filepath.Dir(metabasePath), sc.Metabase().Perm())
+	if err != nil {
+		return nil, fmt.Errorf("could not create metabase directory: %w", err)
+	}
+
+	opts := newShardOptions(c, sc, mode, shardPaths{
+		metabase:   metabasePath,
+		blobStor:   blobstorPath,
+		writeCache: writeCachePath,
+	})
+
+	id, err := c.cfgObject.cfgLocalStorage.localStorage.AttachShard(opts.opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cfgObject.cfgLocalStorage.shardEvents.subscribe(id, opts.events)
+
+	c.log.Info("shard attached to engine",
+		zap.Stringer("id", id),
+	)
+
+	c.log.Warn("shard attached at runtime is not saved to the configuration, "+
+		"it will not be attached after restart unless added there",
+		zap.Stringer("id", id),
+		zap.String("metabase", metabasePath),
+		zap.String("blobstor", blobstorPath),
+		zap.String("writecache", writeCachePath),
+	)
+
+	return id, nil
+}
+
+// DetachShards detaches shards from the storage engine.
+func (c *cfg) DetachShards(ids []*shard.ID) error {
+	err := c.cfgObject.cfgLocalStorage.localStorage.DetachShards(ids)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		c.cfgObject.cfgLocalStorage.shardEvents.unsubscribe(id)
+
+		c.log.Info("shard detached from engine",
+			zap.Stringer("id", id),
+		)
+	}
+
+	return nil
+}
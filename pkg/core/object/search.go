@@ -0,0 +1,49 @@
+package object
+
+import (
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// Numeric search match types. Filter and header values are compared
+// as decimal integers, objects with non-numeric values do not match.
+const (
+	// MatchNumGT matches objects with value greater than the filter one.
+	MatchNumGT object.SearchMatchType = object.MatchCommonPrefix + 1 + iota
+	// MatchNumGE matches objects with value greater than or equal to the filter one.
+	MatchNumGE
+	// MatchNumLT matches objects with value less than the filter one.
+	MatchNumLT
+	// MatchNumLE matches objects with value less than or equal to the filter one.
+	MatchNumLE
+)
+
+// Search pagination X-headers.
+const (
+	// XHeaderSearchLimit is the X-header key of the maximum number of
+	// the objects returned by the search. Value is a decimal integer.
+	XHeaderSearchLimit = "__NEOFS__SEARCH_LIMIT"
+	// XHeaderSearchCursor is the X-header key of the identifier of the
+	// last object of the previous page. If the header is present, objects
+	// are returned in the order of their identifiers. Empty value requests
+	// the first page.
+	XHeaderSearchCursor = "__NEOFS__SEARCH_CURSOR"
+)
+
+// IsNumericMatch checks if m is a numeric search match type.
+func IsNumericMatch(m object.SearchMatchType) bool {
+	return m >= MatchNumGT && m <= MatchNumLE
+}
+
+// SearchFiltersFromV2 converts search filters from NeoFS API V2 format.
+// Unlike object.NewSearchFiltersFromV2, match types which are not known
+// to the SDK (e.g. numeric ones) are kept as is.
+func SearchFiltersFromV2(fs []v2object.SearchFilter) object.SearchFilters {
+	res := make(object.SearchFilters, 0, len(fs))
+
+	for i := range fs {
+		res.AddFilter(fs[i].GetKey(), fs[i].GetValue(), object.SearchMatchType(fs[i].GetMatchType()))
+	}
+
+	return res
+}
@@ -0,0 +1,254 @@
+package blobstor
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+)
+
+// Reasons of compression skip reported to MetricRegister.
+const (
+	skipReasonEntropy  = "entropy"
+	skipReasonCategory = "category"
+	skipReasonRatio    = "ratio"
+)
+
+const (
+	// entropySampleChunks is the number of evenly spaced chunks
+	// of data which are sampled to estimate its entropy.
+	entropySampleChunks = 4
+
+	// entropySampleChunkSize is the size of each sampled chunk.
+	entropySampleChunkSize = 1 << 10
+
+	// entropyThreshold is the entropy of the data sample (in bits per byte)
+	// above which the data is considered incompressible. Sample of 4KiB
+	// of random data has entropy about 7.95.
+	entropyThreshold = 7.8
+
+	// categoryStatsCapacity is the maximum number of categories
+	// which compression statistics is remembered for.
+	categoryStatsCapacity = 4096
+
+	// categoryMinAttempts is the number of compression attempts
+	// after which the category can be considered incompressible.
+	categoryMinAttempts = 16
+
+	// categoryRatioThreshold is the compressed-to-original size ratio
+	// above which the category is considered incompressible.
+	categoryRatioThreshold = 0.95
+
+	// categoryProbeInterval defines how often objects of incompressible
+	// category are still compressed to keep its statistics up to date.
+	categoryProbeInterval = 64
+)
+
+// categoryStats groups compression statistics of the object category.
+type categoryStats struct {
+	attempts   uint64
+	original   uint64
+	compressed uint64
+
+	skipped uint64
+}
+
+// incompressible returns true if objects of the category
+// are not worth to be compressed.
+func (s *categoryStats) incompressible() bool {
+	return s.attempts >= categoryMinAttempts &&
+		float64(s.compressed) > float64(s.original)*categoryRatioThreshold
+}
+
+// compressionStats accumulates compression ratios of object categories
+// (containers and content types) in order to skip compression of
+// categories which do not compress.
+type compressionStats struct {
+	mtx sync.Mutex
+
+	categories *simplelru.LRU
+}
+
+func newCompressionStats() *compressionStats {
+	cache, _ := simplelru.NewLRU(categoryStatsCapacity, nil) // error only on non-positive size
+
+	return &compressionStats{
+		categories: cache,
+	}
+}
+
+// shouldCompress decides by the statistics of the first category which has
+// enough compression attempts, so categories must be ordered by precedence.
+// Returns false if that category is known to be incompressible. Every
+// categoryProbeInterval-th call for such category returns true to refresh
+// the statistics.
+func (s *compressionStats) shouldCompress(categories []string) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for i := range categories {
+		v, ok := s.categories.Get(categories[i])
+		if !ok {
+			continue
+		}
+
+		st := v.(*categoryStats)
+		if st.attempts < categoryMinAttempts {
+			continue
+		}
+
+		if !st.incompressible() {
+			return true
+		}
+
+		st.skipped++
+
+		return st.skipped%categoryProbeInterval == 0
+	}
+
+	return true
+}
+
+// update registers the result of data compression for the categories.
+func (s *compressionStats) update(categories []string, original, compressed int) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for i := range categories {
+		var st *categoryStats
+
+		if v, ok := s.categories.Get(categories[i]); ok {
+			st = v.(*categoryStats)
+		} else {
+			st = new(categoryStats)
+			s.categories.Add(categories[i], st)
+		}
+
+		st.attempts++
+		st.original += uint64(original)
+		st.compressed += uint64(compressed)
+	}
+}
+
+// ContentType returns MIME Content-Type of the object
+// or empty string if the attribute is not set.
+func ContentType(obj *objectSDK.Object) string {
+	for _, attr := range obj.Attributes() {
+		if attr.Key() == objectSDK.AttributeContentType {
+			return attr.Value()
+		}
+	}
+
+	return ""
+}
+
+// compressionCategories returns the list of categories the object belongs to
+// in the order of precedence: content type is more specific than container,
+// so compressible content is compressed even in the incompressible container.
+// Empty contentType means that the content type is unknown.
+func compressionCategories(addr *addressSDK.Address, contentType string) []string {
+	var res []string
+
+	if contentType != "" {
+		res = append(res, "content-type:"+contentType)
+	}
+
+	if cid := addr.ContainerID(); cid != nil {
+		res = append(res, "container:"+cid.String())
+	}
+
+	return res
+}
+
+// highEntropy estimates the entropy of the data by several evenly spaced
+// samples and returns true if it is too high for compression to help.
+func highEntropy(data []byte) bool {
+	const sampleSize = entropySampleChunks * entropySampleChunkSize
+
+	var (
+		freq [256]uint64
+		n    uint64
+	)
+
+	if len(data) <= sampleSize {
+		for _, b := range data {
+			freq[b]++
+		}
+
+		n = uint64(len(data))
+	} else {
+		step := (len(data) - entropySampleChunkSize) / (entropySampleChunks - 1)
+
+		for i := 0; i < entropySampleChunks; i++ {
+			for _, b := range data[i*step : i*step+entropySampleChunkSize] {
+				freq[b]++
+			}
+		}
+
+		n = sampleSize
+	}
+
+	// too small samples have low entropy regardless of the data
+	if n < entropySampleChunkSize {
+		return false
+	}
+
+	var entropy float64
+
+	for _, f := range freq {
+		if f == 0 {
+			continue
+		}
+
+		p := float64(f) / float64(n)
+		entropy -= p * math.Log2(p)
+	}
+
+	return entropy > entropyThreshold
+}
+
+// compress returns compressed data if compression is worth it.
+// Otherwise, the data is returned as is. Compression results are
+// accounted in the statistics of the categories.
+//
+// Statistics of the categories are checked by NeedsCompression.
+func (b *BlobStor) compress(data []byte, categories []string) []byte {
+	if !b.compressionEnabled {
+		return data
+	}
+
+	if highEntropy(data) {
+		b.compressionStats.update(categories, len(data), len(data))
+		b.incCompressionSkipped(skipReasonEntropy)
+		return data
+	}
+
+	start := time.Now()
+	compressed := b.compressor(data)
+
+	if b.metrics != nil {
+		b.metrics.AddCompressionDuration(time.Since(start))
+	}
+
+	b.compressionStats.update(categories, len(data), len(compressed))
+
+	if len(compressed) >= len(data) {
+		b.incCompressionSkipped(skipReasonRatio)
+		return data
+	}
+
+	if b.metrics != nil {
+		b.metrics.AddCompressionSavedBytes(uint64(len(data) - len(compressed)))
+	}
+
+	return compressed
+}
+
+func (b *BlobStor) incCompressionSkipped(reason string) {
+	if b.metrics != nil {
+		b.metrics.IncCompressionSkipped(reason)
+	}
+}
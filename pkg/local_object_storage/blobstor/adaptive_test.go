@@ -0,0 +1,87 @@
+package blobstor
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHighEntropy(t *testing.T) {
+	random := make([]byte, 64<<10)
+	_, _ = rand.Read(random)
+
+	require.True(t, highEntropy(random))
+	require.False(t, highEntropy(random[:100]), "small samples must not be rejected")
+	require.False(t, highEntropy(make([]byte, 64<<10)))
+	require.False(t, highEntropy(bytes.Repeat([]byte("neofs object payload "), 1000)))
+}
+
+func TestCompressionStats(t *testing.T) {
+	s := newCompressionStats()
+	categories := []string{"container:1"}
+
+	for i := 0; i < categoryMinAttempts-1; i++ {
+		require.True(t, s.shouldCompress(categories))
+		s.update(categories, 100, 100)
+	}
+
+	require.True(t, s.shouldCompress(categories))
+	s.update(categories, 100, 100)
+
+	skipped := 0
+	for i := 0; i < categoryProbeInterval; i++ {
+		if !s.shouldCompress(categories) {
+			skipped++
+		}
+	}
+
+	require.Equal(t, categoryProbeInterval-1, skipped, "incompressible category must be probed periodically")
+
+	require.True(t, s.shouldCompress([]string{"container:2"}))
+	require.False(t, s.shouldCompress([]string{"container:2", "container:1"}))
+
+	t.Run("precedence", func(t *testing.T) {
+		s := newCompressionStats()
+
+		text := []string{"content-type:text/plain", "container:1"}
+		video := []string{"content-type:video/mp4", "container:1"}
+
+		for i := 0; i < categoryMinAttempts; i++ {
+			for j := 0; j < 4; j++ {
+				s.update(video, 100, 100)
+			}
+
+			s.update(text, 100, 90)
+		}
+
+		// container is incompressible on the whole
+		require.False(t, s.shouldCompress([]string{"container:1"}))
+
+		// but content type takes precedence
+		require.True(t, s.shouldCompress(text))
+		require.False(t, s.shouldCompress(video))
+
+		// unknown content type falls back to the container
+		require.False(t, s.shouldCompress([]string{"content-type:image/png", "container:1"}))
+	})
+}
+
+func TestBlobStor_compress(t *testing.T) {
+	b := New(WithCompressObjects(true))
+	require.NoError(t, b.blobovniczas.initCompression())
+
+	random := make([]byte, 64<<10)
+	_, _ = rand.Read(random)
+
+	require.Equal(t, random, b.compress(random, nil))
+
+	data := bytes.Repeat([]byte("neofs object payload "), 1000)
+	compressed := b.compress(data, nil)
+	require.Less(t, len(compressed), len(data))
+
+	decompressed, err := b.decompressor(compressed)
+	require.NoError(t, err)
+	require.Equal(t, data, decompressed)
+}
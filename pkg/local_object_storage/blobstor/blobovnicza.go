@@ -1,9 +1,9 @@
 package blobstor
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strconv"
 	"sync"
@@ -79,7 +79,21 @@ type blobovniczas struct {
 	activeMtx sync.RWMutex
 	active    map[string]blobovniczaWithIndex
 
+	// blobovniczas being compacted, they are not cached in LRU
+	// and can not be activated. Protected by activeMtx.
+	compacting map[string]*blobovnicza.Blobovnicza
+	// blobovniczas which compaction has been interrupted, it is resumed
+	// on the next compaction. They can not be activated. Protected by activeMtx.
+	interrupted map[string]struct{}
+	// blobovniczas removed after compaction. Protected by activeMtx.
+	// It is not persisted: removed blobovniczas are created empty
+	// on initialization and are used as usual after restart.
+	removed map[string]struct{}
+
 	onClose []func()
+
+	// decoded payloads for the range requests, nil if disabled
+	payloads *payloadCache
 }
 
 type blobovniczaWithIndex struct {
@@ -94,6 +108,9 @@ func newBlobovniczaTree(c *cfg) (blz *blobovniczas) {
 	cache, err := simplelru.NewLRU(c.openedCacheSize, func(key interface{}, value interface{}) {
 		if _, ok := blz.active[filepath.Dir(key.(string))]; ok {
 			return
+		} else if _, ok := blz.compacting[key.(string)]; ok {
+			// blobovnicza is closed after compaction
+			return
 		} else if err := value.(*blobovnicza.Blobovnicza).Close(); err != nil {
 			c.log.Error("could not close Blobovnicza",
 				zap.String("id", key.(string)),
@@ -119,6 +136,12 @@ func newBlobovniczaTree(c *cfg) (blz *blobovniczas) {
 		cfg:    c,
 		opened: cache,
 		active: make(map[string]blobovniczaWithIndex, cp),
+
+		compacting:  make(map[string]*blobovnicza.Blobovnicza),
+		interrupted: make(map[string]struct{}),
+		removed:     make(map[string]struct{}),
+
+		payloads: newPayloadCache(c.rangeCacheSize),
 	}
 }
 
@@ -206,16 +229,18 @@ func (b *blobovniczas) put(addr *addressSDK.Address, data []byte) (*blobovnicza.
 // If blobocvnicza ID is specified, only this blobovnicza is processed.
 // Otherwise, all blobovniczas are processed descending weight.
 func (b *blobovniczas) get(prm *GetSmallPrm) (res *GetSmallRes, err error) {
-	bPrm := new(blobovnicza.GetPrm)
-	bPrm.SetAddress(prm.addr)
-
 	if prm.blobovniczaID != nil {
 		blz, err := b.openBlobovnicza(prm.blobovniczaID.String())
-		if err != nil {
-			return nil, err
+		if err == nil {
+			res, err = b.getObject(blz, prm)
+		}
+
+		if err == nil || !b.isRemoved(prm.blobovniczaID.String()) {
+			return res, err
 		}
 
-		return b.getObject(blz, bPrm)
+		// blobovnicza has been removed after compaction,
+		// the object is moved to another one
 	}
 
 	activeCache := make(map[string]struct{})
@@ -225,7 +250,7 @@ func (b *blobovniczas) get(prm *GetSmallPrm) (res *GetSmallRes, err error) {
 
 		_, ok := activeCache[dirPath]
 
-		res, err = b.getObjectFromLevel(bPrm, p, !ok)
+		res, err = b.getObjectFromLevel(prm, p, !ok)
 		if err != nil {
 			if !blobovnicza.IsErrNotFound(err) {
 				b.log.Debug("could not get object from level",
@@ -261,11 +286,16 @@ func (b *blobovniczas) delete(prm *DeleteSmallPrm) (res *DeleteSmallRes, err err
 
 	if prm.blobovniczaID != nil {
 		blz, err := b.openBlobovnicza(prm.blobovniczaID.String())
-		if err != nil {
-			return nil, err
+		if err == nil {
+			res, err = b.deleteObject(blz, bPrm, prm)
+		}
+
+		if err == nil || !b.isRemoved(prm.blobovniczaID.String()) {
+			return res, err
 		}
 
-		return b.deleteObject(blz, bPrm, prm)
+		// blobovnicza has been removed after compaction,
+		// the object is moved to another one
 	}
 
 	activeCache := make(map[string]struct{})
@@ -311,13 +341,32 @@ func (b *blobovniczas) delete(prm *DeleteSmallPrm) (res *DeleteSmallRes, err err
 // If blobocvnicza ID is specified, only this blobovnicza is processed.
 // Otherwise, all blobovniczas are processed descending weight.
 func (b *blobovniczas) getRange(prm *GetRangeSmallPrm) (res *GetRangeSmallRes, err error) {
-	if prm.blobovniczaID != nil {
-		blz, err := b.openBlobovnicza(prm.blobovniczaID.String())
+	if payload, ok := b.payloads.get(prm.addr); ok {
+		data, err := payloadRange(payload, prm.rng)
 		if err != nil {
 			return nil, err
 		}
 
-		return b.getObjectRange(blz, prm)
+		return &GetRangeSmallRes{
+			rangeData: rangeData{
+				// cached payload must not be modified
+				data: append([]byte(nil), data...),
+			},
+		}, nil
+	}
+
+	if prm.blobovniczaID != nil {
+		blz, err := b.openBlobovnicza(prm.blobovniczaID.String())
+		if err == nil {
+			res, err = b.getObjectRange(blz, prm)
+		}
+
+		if err == nil || errors.Is(err, object.ErrRangeOutOfBounds) || !b.isRemoved(prm.blobovniczaID.String()) {
+			return res, err
+		}
+
+		// blobovnicza has been removed after compaction,
+		// the object is moved to another one
 	}
 
 	activeCache := make(map[string]struct{})
@@ -402,10 +451,10 @@ func (b *blobovniczas) deleteObjectFromLevel(prm *blobovnicza.DeletePrm, blzPath
 	// then object is possibly placed in closed blobovnicza
 
 	// check if it makes sense to try to open the blob
-	// (blobovniczas "after" the active one are empty anyway,
-	// and it's pointless to open them).
-	if u64FromHexString(filepath.Base(blzPath)) > active.ind {
-		log.Debug("index is too big")
+	// (blobovniczas removed after compaction are empty
+	// anyway, and it's pointless to open them).
+	if b.isRemoved(blzPath) {
+		log.Debug("blobovnicza does not exist")
 		var errNotFound apistatus.ObjectNotFound
 
 		return nil, errNotFound
@@ -423,7 +472,7 @@ func (b *blobovniczas) deleteObjectFromLevel(prm *blobovnicza.DeletePrm, blzPath
 // tries to read object from particular blobovnicza.
 //
 // returns error if object could not be read from any blobovnicza of the same level.
-func (b *blobovniczas) getObjectFromLevel(prm *blobovnicza.GetPrm, blzPath string, tryActive bool) (*GetSmallRes, error) {
+func (b *blobovniczas) getObjectFromLevel(prm *GetSmallPrm, blzPath string, tryActive bool) (*GetSmallRes, error) {
 	lvlPath := filepath.Dir(blzPath)
 
 	log := b.log.With(
@@ -466,10 +515,10 @@ func (b *blobovniczas) getObjectFromLevel(prm *blobovnicza.GetPrm, blzPath strin
 	// then object is possibly placed in closed blobovnicza
 
 	// check if it makes sense to try to open the blob
-	// (blobovniczas "after" the active one are empty anyway,
-	// and it's pointless to open them).
-	if u64FromHexString(filepath.Base(blzPath)) > active.ind {
-		log.Debug("index is too big")
+	// (blobovniczas removed after compaction are empty
+	// anyway, and it's pointless to open them).
+	if b.isRemoved(blzPath) {
+		log.Debug("blobovnicza does not exist")
 		var errNotFound apistatus.ObjectNotFound
 
 		return nil, errNotFound
@@ -540,11 +589,10 @@ func (b *blobovniczas) getRangeFromLevel(prm *GetRangeSmallPrm, blzPath string,
 	// then object is possibly placed in closed blobovnicza
 
 	// check if it makes sense to try to open the blob
-	// (blobovniczas "after" the active one are empty anyway,
-	// and it's pointless to open them).
-	if u64FromHexString(filepath.Base(blzPath)) > active.ind {
-		log.Debug("index is too big")
-
+	// (blobovniczas removed after compaction are empty
+	// anyway, and it's pointless to open them).
+	if b.isRemoved(blzPath) {
+		log.Debug("blobovnicza does not exist")
 		var errNotFound apistatus.ObjectNotFound
 
 		return nil, errNotFound
@@ -566,6 +614,8 @@ func (b *blobovniczas) deleteObject(blz *blobovnicza.Blobovnicza, prm *blobovnic
 		return nil, err
 	}
 
+	b.payloads.remove(dp.addr)
+
 	storagelog.Write(b.log,
 		storagelog.AddressField(dp.addr),
 		storagelog.OpField("blobovniczas DELETE"),
@@ -576,8 +626,11 @@ func (b *blobovniczas) deleteObject(blz *blobovnicza.Blobovnicza, prm *blobovnic
 }
 
 // reads object from blobovnicza and returns GetSmallRes.
-func (b *blobovniczas) getObject(blz *blobovnicza.Blobovnicza, prm *blobovnicza.GetPrm) (*GetSmallRes, error) {
-	res, err := blz.Get(prm)
+func (b *blobovniczas) getObject(blz *blobovnicza.Blobovnicza, prm *GetSmallPrm) (*GetSmallRes, error) {
+	gPrm := new(blobovnicza.GetPrm)
+	gPrm.SetAddress(prm.addr)
+
+	res, err := blz.Get(gPrm)
 	if err != nil {
 		return nil, err
 	}
@@ -588,10 +641,9 @@ func (b *blobovniczas) getObject(blz *blobovnicza.Blobovnicza, prm *blobovnicza.
 		return nil, fmt.Errorf("could not decompress object data: %w", err)
 	}
 
-	// unmarshal the object
-	obj := objectSDK.New()
-	if err := obj.Unmarshal(data); err != nil {
-		return nil, fmt.Errorf("could not unmarshal the object: %w", err)
+	obj, err := unmarshalObject(data, prm.headerOnly)
+	if err != nil {
+		return nil, err
 	}
 
 	return &GetSmallRes{
@@ -621,23 +673,25 @@ func (b *blobovniczas) getObjectRange(blz *blobovnicza.Blobovnicza, prm *GetRang
 		return nil, fmt.Errorf("could not decompress object data: %w", err)
 	}
 
-	// unmarshal the object
-	obj := objectSDK.New()
-	if err := obj.Unmarshal(data); err != nil {
-		return nil, fmt.Errorf("could not unmarshal the object: %w", err)
+	// only the payload is needed, so the object is not unmarshaled
+	payload, err := objectPayload(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not read object payload: %w", err)
 	}
 
-	from := prm.rng.GetOffset()
-	to := from + prm.rng.GetLength()
-	payload := obj.Payload()
+	// uncompressed data refers to the memory of the database
+	payload = append([]byte(nil), payload...)
+
+	b.payloads.add(prm.addr, payload)
 
-	if uint64(len(payload)) < to {
-		return nil, object.ErrRangeOutOfBounds
+	data, err = payloadRange(payload, prm.rng)
+	if err != nil {
+		return nil, err
 	}
 
 	return &GetRangeSmallRes{
 		rangeData: rangeData{
-			data: payload[from:to],
+			data: data,
 		},
 	}, nil
 }
@@ -754,23 +808,17 @@ func (b *blobovniczas) updateAndGet(p string, old *uint64) (blobovniczaWithIndex
 	active, ok := b.active[p]
 	b.activeMtx.RUnlock()
 
-	if ok {
-		if old != nil {
-			if active.ind == b.blzShallowWidth-1 {
-				return active, errors.New("no more blobovniczas")
-			} else if active.ind != *old {
-				// sort of CAS in order to control concurrent
-				// updateActive calls
-				return active, nil
-			}
-		} else {
-			return active, nil
-		}
-
-		active.ind++
+	if ok && (old == nil || active.ind != *old) {
+		// sort of CAS in order to control concurrent
+		// updateActive calls
+		return active, nil
 	}
 
 	var err error
+	if active.ind, err = b.nextIndex(p, active.ind, !ok); err != nil {
+		return active, err
+	}
+
 	if active.blz, err = b.openBlobovnicza(filepath.Join(p, u64ToHexString(active.ind))); err != nil {
 		return active, err
 	}
@@ -796,46 +844,51 @@ func (b *blobovniczas) updateAndGet(p string, old *uint64) (blobovniczaWithIndex
 	return active, nil
 }
 
+// returns index of the blobovnicza of p-level (dir) to activate after
+// the one with cur index. If first is true, cur index is also checked.
+//
+// Blobovniczas being compacted are skipped. Indices of the blobovniczas
+// removed after compaction are reused after the last index is reached.
+func (b *blobovniczas) nextIndex(p string, cur uint64, first bool) (uint64, error) {
+	b.activeMtx.Lock()
+	defer b.activeMtx.Unlock()
+
+	start := cur + 1
+	if first {
+		start = cur
+	}
+
+	for i := start; i < b.blzShallowWidth; i++ {
+		blzPath := filepath.Join(p, u64ToHexString(i))
+		if b.canActivate(blzPath) {
+			delete(b.removed, blzPath)
+			return i, nil
+		}
+	}
+
+	for i := uint64(0); i < start && i < b.blzShallowWidth; i++ {
+		blzPath := filepath.Join(p, u64ToHexString(i))
+		if _, removed := b.removed[blzPath]; removed && b.canActivate(blzPath) {
+			delete(b.removed, blzPath)
+			return i, nil
+		}
+	}
+
+	return 0, errors.New("no more blobovniczas")
+}
+
 // initializes blobovnicza tree.
 //
 // Should be called exactly once.
 func (b *blobovniczas) init() error {
 	b.log.Debug("initializing Blobovnicza's")
 
-	enc, zstdC, err := zstdCompressor()
-	if err != nil {
-		return fmt.Errorf("could not create zstd compressor: %v", err)
+	if err := b.initCompression(); err != nil {
+		return err
 	}
-	b.onClose = append(b.onClose, func() {
-		if err := enc.Close(); err != nil {
-			b.log.Debug("can't close zstd compressor", zap.String("err", err.Error()))
-		}
-	})
 
-	dec, zstdD, err := zstdDecompressor()
-	if err != nil {
-		return fmt.Errorf("could not create zstd decompressor: %v", err)
-	}
-	b.onClose = append(b.onClose, dec.Close)
-
-	// Compression is always done based on config settings.
-	if b.compressionEnabled {
-		b.compressor = zstdC
-	} else {
-		b.compressor = noOpCompressor
-	}
-
-	// However we should be able to read any object
-	// we have previously written.
-	b.decompressor = func(data []byte) ([]byte, error) {
-		// Fallback to reading decompressed objects.
-		// For normal objects data is always bigger than 4 bytes, the first check is here
-		// because function interface is rather generic (Go compiler inserts bound
-		// checks anyway).
-		if len(data) < 4 || !bytes.Equal(data[:4], zstdFrameMagic) {
-			return noOpDecompressor(data)
-		}
-		return zstdD(data)
+	if err := b.loadCompactionJournals(); err != nil {
+		return err
 	}
 
 	return b.iterateBlobovniczas(false, func(p string, blz *blobovnicza.Blobovnicza) error {
@@ -888,6 +941,9 @@ func (b *blobovniczas) close() error {
 		b.onClose[i]()
 	}
 
+	// hooks are registered again on the next initialization
+	b.onClose = nil
+
 	return nil
 }
 
@@ -914,8 +970,31 @@ func (b *blobovniczas) openBlobovnicza(p string) (*blobovnicza.Blobovnicza, erro
 		return v.(*blobovnicza.Blobovnicza), nil
 	}
 
-	blz := blobovnicza.New(append(b.blzOpts,
-		blobovnicza.WithPath(filepath.Join(b.blzRootPath, p)),
+	b.activeMtx.RLock()
+	blz, compacting := b.compacting[p]
+	_, removed := b.removed[p]
+	b.activeMtx.RUnlock()
+
+	if compacting {
+		return blz, nil
+	} else if removed {
+		// do not create an empty file in place of the removed one
+		var errNotFound apistatus.ObjectNotFound
+
+		return nil, errNotFound
+	}
+
+	path := filepath.Join(b.blzRootPath, p)
+
+	if b.readOnly {
+		// BoltDB creates missing file even in read-only mode
+		if _, err := os.Stat(path); err != nil {
+			return nil, fmt.Errorf("could not open blobovnicza %s: %w", p, err)
+		}
+	}
+
+	blz = blobovnicza.New(append(b.blzOpts,
+		blobovnicza.WithPath(path),
 	)...)
 
 	if err := blz.Open(); err != nil {
@@ -933,6 +1012,28 @@ func (b *blobovniczas) openBlobovnicza(p string) (*blobovnicza.Blobovnicza, erro
 	return blz, nil
 }
 
+// checks if the blobovnicza with path p can be activated.
+//
+// activeMtx must be taken.
+func (b *blobovniczas) canActivate(p string) bool {
+	if _, ok := b.compacting[p]; ok {
+		return false
+	}
+
+	_, ok := b.interrupted[p]
+
+	return !ok
+}
+
+// checks if the blobovnicza with path p has been removed after compaction.
+func (b *blobovniczas) isRemoved(p string) bool {
+	b.activeMtx.RLock()
+	_, ok := b.removed[p]
+	b.activeMtx.RUnlock()
+
+	return ok
+}
+
 // returns hash of the object address.
 func addressHash(addr *addressSDK.Address, path string) uint64 {
 	var a string
@@ -948,13 +1049,3 @@ func addressHash(addr *addressSDK.Address, path string) uint64 {
 func u64ToHexString(ind uint64) string {
 	return strconv.FormatUint(ind, 16)
 }
-
-// converts uint64 hex string to uint64.
-func u64FromHexString(str string) uint64 {
-	v, err := strconv.ParseUint(str, 16, 64)
-	if err != nil {
-		panic(fmt.Sprintf("blobovnicza name is not an index %s", str))
-	}
-
-	return v
-}
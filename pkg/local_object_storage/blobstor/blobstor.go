@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 	"go.uber.org/zap"
@@ -28,8 +29,16 @@ type cfg struct {
 
 	compressionEnabled bool
 
+	compressionCodec string
+
+	compressionLevel int
+
 	uncompressableContentTypes []string
 
+	compressionExcludeBig bool
+
+	compressionStats *compressionStats
+
 	compressor func([]byte) []byte
 
 	decompressor func([]byte) ([]byte, error)
@@ -40,11 +49,17 @@ type cfg struct {
 
 	openedCacheSize int
 
+	rangeCacheSize uint64
+
 	blzShallowDepth, blzShallowWidth uint64
 
 	blzRootPath string
 
 	blzOpts []blobovnicza.Option
+
+	readOnly bool
+
+	metrics MetricRegister
 }
 
 const (
@@ -69,11 +84,13 @@ func defaultCfg() *cfg {
 				RootPath:    "./",
 			},
 		},
-		smallSizeLimit:  defaultSmallSizeLimit,
-		log:             zap.L(),
-		openedCacheSize: defaultOpenedCacheSize,
-		blzShallowDepth: defaultBlzShallowDepth,
-		blzShallowWidth: defaultBlzShallowWidth,
+		compressionCodec: compression.Zstd,
+		compressionStats: newCompressionStats(),
+		smallSizeLimit:   defaultSmallSizeLimit,
+		log:              zap.L(),
+		openedCacheSize:  defaultOpenedCacheSize,
+		blzShallowDepth:  defaultBlzShallowDepth,
+		blzShallowWidth:  defaultBlzShallowWidth,
 	}
 }
 
@@ -108,17 +125,43 @@ func WithShallowDepth(depth int) Option {
 // WithCompressObjects returns option to toggle
 // compression of the stored objects.
 //
-// If true, Zstandard algorithm is used for data compression.
-//
-// If compressor (decompressor) creation failed,
-// the uncompressed option will be used, and the error
-// is recorded in the provided log.
+// If true, the codec set by WithCompressionCodec (Zstandard
+// by default) is used for data compression.
 func WithCompressObjects(comp bool) Option {
 	return func(c *cfg) {
 		c.compressionEnabled = comp
 	}
 }
 
+// WithCompressionCodec returns option to set the name of the codec
+// used for compression of the stored objects. Must be one of
+// compression.Names().
+//
+// Objects compressed by any supported codec are readable
+// regardless of this option.
+func WithCompressionCodec(name string) Option {
+	return func(c *cfg) {
+		c.compressionCodec = name
+	}
+}
+
+// WithCompressionLevel returns option to set the compression level
+// of the codec. Zero means default level of the codec.
+func WithCompressionLevel(level int) Option {
+	return func(c *cfg) {
+		c.compressionLevel = level
+	}
+}
+
+// WithCompressionExcludeBig returns option to save "big" objects
+// (see WithSmallSizeLimit) uncompressed, so payload ranges are read
+// from them without reading the whole object.
+func WithCompressionExcludeBig(exclude bool) Option {
+	return func(c *cfg) {
+		c.compressionExcludeBig = exclude
+	}
+}
+
 // WithUncompressableContentTypes returns option to disable decompression
 // for specific content types as seen by object.AttributeContentType attribute.
 func WithUncompressableContentTypes(values []string) Option {
@@ -186,6 +229,15 @@ func WithBlobovniczaOpenedCacheSize(sz int) Option {
 	}
 }
 
+// WithBlobovniczaRangeCacheSize returns option to specify maximum
+// total size of the decoded payloads of small objects cached to serve
+// payload range requests. Zero disables the cache.
+func WithBlobovniczaRangeCacheSize(sz uint64) Option {
+	return func(c *cfg) {
+		c.rangeCacheSize = sz
+	}
+}
+
 // WithBlobovniczaSize returns option to specify maximum volume
 // of each blobovnicza.
 func WithBlobovniczaSize(sz uint64) Option {
@@ -193,3 +245,21 @@ func WithBlobovniczaSize(sz uint64) Option {
 		c.blzOpts = append(c.blzOpts, blobovnicza.WithFullSizeLimit(sz))
 	}
 }
+
+// ReadOnly returns option to open BlobStor in read-only mode.
+// Blobovniczas are opened read-only, missing ones are not created.
+//
+// BlobStor must not be initialized in read-only mode.
+func ReadOnly() Option {
+	return func(c *cfg) {
+		c.readOnly = true
+		c.blzOpts = append(c.blzOpts, blobovnicza.ReadOnly())
+	}
+}
+
+// WithMetrics returns option to specify BlobStor's metric register.
+func WithMetrics(m MetricRegister) Option {
+	return func(c *cfg) {
+		c.metrics = m
+	}
+}
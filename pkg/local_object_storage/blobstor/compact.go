@@ -0,0 +1,427 @@
+package blobstor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.uber.org/zap"
+)
+
+// DefaultCompactFillPercent is the default fill percent below which
+// blobovnicza is compacted.
+const DefaultCompactFillPercent = 50
+
+var errNilCompactHandler = errors.New("compaction handler is not set")
+
+// MovedObject groups the information about the object
+// moved to another blobovnicza during compaction.
+type MovedObject struct {
+	// Address of the object.
+	Address *addressSDK.Address
+	// Identifier of the blobovnicza the object is moved to.
+	BlobovniczaID *blobovnicza.ID
+}
+
+// CompactHandler is called after all objects of the blobovnicza with
+// identifier from are copied to other blobovniczas and before the
+// blobovnicza is removed. It must atomically update the references to
+// the moved objects, and return addresses of the objects which are not
+// referenced from the blobovnicza anymore (e.g. removed during compaction).
+// Copies of such objects are removed from the new location.
+type CompactHandler func(from *blobovnicza.ID, moved []MovedObject) ([]*addressSDK.Address, error)
+
+// CompactPrm groups the parameters of Compact operation.
+type CompactPrm struct {
+	fillPercent uint32
+
+	handler CompactHandler
+
+	guard func() (func(), error)
+}
+
+// CompactRes groups resulting values of Compact operation.
+type CompactRes struct {
+	compacted uint64
+	moved     uint64
+	reclaimed uint64
+}
+
+// SetFillPercent sets the percent of the blobovnicza file size occupied
+// by the stored objects below which blobovnicza is compacted.
+// DefaultCompactFillPercent is used if the value is zero, values
+// greater than 100 are treated as 100.
+func (p *CompactPrm) SetFillPercent(v uint32) {
+	p.fillPercent = v
+}
+
+// SetHandler sets the handler of the objects moved during compaction.
+func (p *CompactPrm) SetHandler(h CompactHandler) {
+	p.handler = h
+}
+
+// SetGuard sets the function which is called before the compaction of every
+// blobovnicza. Blobovnicza is compacted only if the function returns nil
+// error, the returned release function is called after that. Compaction is
+// aborted on error.
+//
+// It allows the caller not to hold its locks for the whole compaction.
+func (p *CompactPrm) SetGuard(f func() (release func(), err error)) {
+	p.guard = f
+}
+
+// Compacted returns the number of compacted blobovniczas.
+func (r *CompactRes) Compacted() uint64 {
+	return r.compacted
+}
+
+// Moved returns the number of objects moved to other blobovniczas.
+func (r *CompactRes) Moved() uint64 {
+	return r.moved
+}
+
+// Reclaimed returns the number of bytes freed on the disk.
+func (r *CompactRes) Reclaimed() uint64 {
+	return r.reclaimed
+}
+
+// Compact rewrites objects from the sparse blobovniczas to the active ones
+// and removes sparse blobovniczas. Active blobovniczas are never compacted.
+// Indices of the removed blobovniczas are reused when all other blobovniczas
+// of the same level are filled.
+//
+// Objects remain readable during compaction: until the handler is called,
+// they are read from the compacted blobovnicza, after that from the new one.
+//
+// Copied objects are recorded in the compaction journal next to the
+// blobovnicza, so the interrupted compaction is resumed by the next call
+// without copying them again.
+//
+// Returns any error encountered that did not allow to completely compact
+// the storage.
+func (b *BlobStor) Compact(prm *CompactPrm) (*CompactRes, error) {
+	if prm.handler == nil {
+		return nil, errNilCompactHandler
+	}
+
+	return b.blobovniczas.compact(prm)
+}
+
+// compacts sparse blobovniczas of the tree.
+func (b *blobovniczas) compact(prm *CompactPrm) (*CompactRes, error) {
+	fillPercent := prm.fillPercent
+	if fillPercent == 0 {
+		fillPercent = DefaultCompactFillPercent
+	} else if fillPercent > 100 {
+		fillPercent = 100
+	}
+
+	res := new(CompactRes)
+
+	err := b.iterateLeaves(func(p string) (bool, error) {
+		if b.isRemoved(p) {
+			return false, nil
+		}
+
+		if prm.guard != nil {
+			release, err := prm.guard()
+			if err != nil {
+				return false, err
+			}
+			defer release()
+		}
+
+		return false, b.compactLeaf(p, fillPercent, prm.handler, res)
+	})
+
+	return res, err
+}
+
+// compacts the blobovnicza with path p if it is sparse or its
+// compaction has been interrupted, and updates res.
+func (b *blobovniczas) compactLeaf(p string, fillPercent uint32, h CompactHandler, res *CompactRes) error {
+	resume := b.isInterrupted(p)
+
+	blz, ok := b.startCompaction(p)
+	if !ok {
+		return nil
+	}
+
+	fileSize, liveSize, err := b.blobovniczaSizes(p, blz)
+	if err != nil || !resume && liveSize*100 >= fileSize*uint64(fillPercent) {
+		b.cancelCompaction(p, blz, resume)
+		return err
+	}
+
+	moved, err := b.compactBlobovnicza(p, blz, h)
+	if err != nil {
+		// copied objects are kept, they are reused when compaction is resumed
+		b.cancelCompaction(p, blz, true)
+		return fmt.Errorf("could not compact blobovnicza %s: %w", p, err)
+	}
+
+	if err := b.finishCompaction(p, blz); err != nil {
+		return fmt.Errorf("could not remove compacted blobovnicza %s: %w", p, err)
+	}
+
+	b.log.Info("blobovnicza compacted",
+		zap.String("path", p),
+		zap.Uint64("file size", fileSize),
+		zap.Uint64("objects size", liveSize),
+		zap.Int("moved", moved),
+		zap.Bool("resumed", resume),
+	)
+
+	res.compacted++
+	res.moved += uint64(moved)
+	if fileSize > liveSize {
+		res.reclaimed += fileSize - liveSize
+	}
+
+	return nil
+}
+
+// returns size of the blobovnicza file and total size of the stored objects.
+func (b *blobovniczas) blobovniczaSizes(p string, blz *blobovnicza.Blobovnicza) (uint64, uint64, error) {
+	fi, err := os.Stat(filepath.Join(b.blzRootPath, p))
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not stat blobovnicza %s: %w", p, err)
+	}
+
+	var liveSize uint64
+
+	err = blobovnicza.IterateObjects(blz, func(data []byte) error {
+		liveSize += uint64(len(data))
+		return nil
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not iterate over blobovnicza %s: %w", p, err)
+	}
+
+	return uint64(fi.Size()), liveSize, nil
+}
+
+// moves all objects from the blobovnicza to the active ones and passes
+// them to the handler. Returns the number of moved objects.
+//
+// Objects recorded in the compaction journal by the previous attempts
+// are not copied again.
+func (b *blobovniczas) compactBlobovnicza(p string, blz *blobovnicza.Blobovnicza, h CompactHandler) (int, error) {
+	j, err := b.openCompactJournal(p)
+	if err != nil {
+		return 0, fmt.Errorf("could not open compaction journal: %w", err)
+	}
+	defer j.close()
+
+	copied := make(map[string]struct{}, len(j.moved))
+	for i := range j.moved {
+		copied[j.moved[i].Address.String()] = struct{}{}
+	}
+
+	var addrs []*addressSDK.Address
+
+	// objects are not moved right in the iteration since
+	// putting them could lead to closing of the blobovnicza
+	err = blobovnicza.IterateAddresses(blz, func(addr *addressSDK.Address) error {
+		if _, ok := copied[addr.String()]; ok {
+			return nil
+		}
+
+		// address instance is reused by the iterator
+		a := addressSDK.NewAddress()
+		if err := a.Parse(addr.String()); err != nil {
+			return err
+		}
+
+		addrs = append(addrs, a)
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not list objects: %w", err)
+	}
+
+	// copies which objects have been removed since the previous
+	// attempt are passed too, the handler reports them as stale
+	moved := append(make([]MovedObject, 0, len(j.moved)+len(addrs)), j.moved...)
+
+	for i := range addrs {
+		getPrm := new(blobovnicza.GetPrm)
+		getPrm.SetAddress(addrs[i])
+
+		res, err := blz.Get(getPrm)
+		if err != nil {
+			if blobovnicza.IsErrNotFound(err) {
+				// removed during compaction
+				continue
+			}
+
+			return 0, fmt.Errorf("could not read object %s: %w", addrs[i], err)
+		}
+
+		id, err := b.put(addrs[i], res.Object())
+		if err != nil {
+			return 0, fmt.Errorf("could not put object %s: %w", addrs[i], err)
+		}
+
+		obj := MovedObject{
+			Address:       addrs[i],
+			BlobovniczaID: id,
+		}
+
+		if err := j.add(obj); err != nil {
+			b.dropMoved([]MovedObject{obj})
+			return 0, fmt.Errorf("could not write compaction journal: %w", err)
+		}
+
+		moved = append(moved, obj)
+	}
+
+	if err := j.sync(); err != nil {
+		return 0, fmt.Errorf("could not write compaction journal: %w", err)
+	}
+
+	stale, err := h(blobovnicza.NewIDFromBytes([]byte(p)), moved)
+	if err != nil {
+		return 0, fmt.Errorf("could not update references to the moved objects: %w", err)
+	}
+
+	if len(stale) != 0 {
+		staleMap := make(map[string]struct{}, len(stale))
+		for i := range stale {
+			staleMap[stale[i].String()] = struct{}{}
+		}
+
+		actual := moved[:0]
+		staleObjs := make([]MovedObject, 0, len(stale))
+
+		for i := range moved {
+			if _, ok := staleMap[moved[i].Address.String()]; ok {
+				staleObjs = append(staleObjs, moved[i])
+			} else {
+				actual = append(actual, moved[i])
+			}
+		}
+
+		b.dropMoved(staleObjs)
+
+		moved = actual
+	}
+
+	if err := j.finish(); err != nil {
+		return 0, fmt.Errorf("could not write compaction journal: %w", err)
+	}
+
+	return len(moved), nil
+}
+
+// removes copies of the moved objects from their new location.
+func (b *blobovniczas) dropMoved(moved []MovedObject) {
+	for i := range moved {
+		prm := new(DeleteSmallPrm)
+		prm.SetAddress(moved[i].Address)
+		prm.SetBlobovniczaID(moved[i].BlobovniczaID)
+
+		if _, err := b.delete(prm); err != nil {
+			b.log.Warn("could not remove copy of the object moved during compaction",
+				zap.Stringer("address", moved[i].Address),
+				zap.Stringer("blobovnicza ID", moved[i].BlobovniczaID),
+				zap.String("error", err.Error()),
+			)
+		}
+	}
+}
+
+// marks the blobovnicza with path p as being compacted, so it can not be
+// activated or closed on LRU eviction. Returns false if the blobovnicza is
+// active or is already being compacted.
+func (b *blobovniczas) startCompaction(p string) (*blobovnicza.Blobovnicza, bool) {
+	blz, err := b.openBlobovnicza(p)
+	if err != nil {
+		b.log.Debug("could not open blobovnicza for compaction",
+			zap.String("path", p),
+			zap.String("error", err.Error()),
+		)
+
+		return nil, false
+	}
+
+	b.activeMtx.Lock()
+	defer b.activeMtx.Unlock()
+
+	if active, ok := b.active[filepath.Dir(p)]; ok && active.blz == blz {
+		return nil, false
+	}
+
+	if _, ok := b.compacting[p]; ok {
+		return nil, false
+	}
+
+	b.lruMtx.Lock()
+	defer b.lruMtx.Unlock()
+
+	// blobovnicza could be evicted and closed after opening
+	if v, ok := b.opened.Peek(p); !ok || v.(*blobovnicza.Blobovnicza) != blz {
+		return nil, false
+	}
+
+	b.compacting[p] = blz
+	b.opened.Remove(p)
+
+	return blz, true
+}
+
+// returns the blobovnicza which has not been compacted to LRU cache.
+// If interrupted is true, blobovnicza can not be activated until
+// the compaction is resumed.
+func (b *blobovniczas) cancelCompaction(p string, blz *blobovnicza.Blobovnicza, interrupted bool) {
+	b.activeMtx.Lock()
+	b.lruMtx.Lock()
+
+	delete(b.compacting, p)
+	if interrupted {
+		b.interrupted[p] = struct{}{}
+	}
+	b.opened.Add(p, blz)
+
+	b.lruMtx.Unlock()
+	b.activeMtx.Unlock()
+}
+
+// closes and removes the compacted blobovnicza.
+func (b *blobovniczas) finishCompaction(p string, blz *blobovnicza.Blobovnicza) error {
+	// exclude opening of the blobovnicza until the file is removed
+	b.openMtx.Lock()
+	defer b.openMtx.Unlock()
+
+	b.activeMtx.Lock()
+	delete(b.compacting, p)
+	delete(b.interrupted, p)
+	b.removed[p] = struct{}{}
+	b.activeMtx.Unlock()
+
+	if err := blz.Close(); err != nil {
+		b.log.Debug("could not close compacted blobovnicza",
+			zap.String("path", p),
+			zap.String("error", err.Error()),
+		)
+	}
+
+	if err := os.Remove(filepath.Join(b.blzRootPath, p)); err != nil {
+		return err
+	}
+
+	return removeCompactJournal(b.journalPath(p))
+}
+
+// checks if the compaction of the blobovnicza with path p has been interrupted.
+func (b *blobovniczas) isInterrupted(p string) bool {
+	b.activeMtx.RLock()
+	_, ok := b.interrupted[p]
+	b.activeMtx.RUnlock()
+
+	return ok
+}
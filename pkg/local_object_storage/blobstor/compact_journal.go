@@ -0,0 +1,188 @@
+package blobstor
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.uber.org/zap"
+)
+
+// compactJournalSuffix is appended to the path of the blobovnicza
+// to get the path of its compaction journal.
+const compactJournalSuffix = ".compact"
+
+// compactJournalDone is the last line of the compaction journal. It is
+// written after the references to the moved objects are updated.
+const compactJournalDone = "done"
+
+// compactJournal records the objects copied from the compacted blobovnicza.
+// Each line of the journal contains the address of the object and the
+// identifier of the blobovnicza it is copied to.
+type compactJournal struct {
+	f *os.File
+
+	// objects recorded by the previous compaction attempts
+	moved []MovedObject
+
+	// true if the references to the moved objects are updated
+	done bool
+}
+
+// returns path of the compaction journal of the blobovnicza with path p.
+func (b *blobovniczas) journalPath(p string) string {
+	return filepath.Join(b.blzRootPath, p) + compactJournalSuffix
+}
+
+// opens the compaction journal of the blobovnicza with path p creating it
+// if missing. Records of the previous attempts are read, the incomplete last
+// record of the interrupted write is discarded.
+func (b *blobovniczas) openCompactJournal(p string) (*compactJournal, error) {
+	jPath := b.journalPath(p)
+
+	data, err := ioutil.ReadFile(jPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	j := new(compactJournal)
+
+	var valid int
+
+	for !j.done {
+		ln := bytes.IndexByte(data[valid:], '\n')
+		if ln < 0 {
+			break
+		}
+
+		line := data[valid : valid+ln]
+
+		if string(line) == compactJournalDone {
+			j.done = true
+		} else {
+			obj, ok := parseCompactRecord(line)
+			if !ok {
+				break
+			}
+
+			j.moved = append(j.moved, obj)
+		}
+
+		valid += ln + 1
+	}
+
+	j.f, err = os.OpenFile(jPath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640)
+	if err != nil {
+		return nil, err
+	}
+
+	if valid != len(data) {
+		if err := j.f.Truncate(int64(valid)); err != nil {
+			_ = j.f.Close()
+			return nil, err
+		}
+	}
+
+	return j, nil
+}
+
+func parseCompactRecord(line []byte) (MovedObject, bool) {
+	sep := bytes.IndexByte(line, ' ')
+	if sep <= 0 || sep == len(line)-1 {
+		return MovedObject{}, false
+	}
+
+	addr := addressSDK.NewAddress()
+	if err := addr.Parse(string(line[:sep])); err != nil {
+		return MovedObject{}, false
+	}
+
+	return MovedObject{
+		Address:       addr,
+		BlobovniczaID: blobovnicza.NewIDFromBytes(append([]byte{}, line[sep+1:]...)),
+	}, true
+}
+
+// records the object copied from the compacted blobovnicza.
+func (j *compactJournal) add(obj MovedObject) error {
+	_, err := fmt.Fprintf(j.f, "%s %s\n", obj.Address, obj.BlobovniczaID)
+	return err
+}
+
+// flushes the records to the disk.
+func (j *compactJournal) sync() error {
+	return j.f.Sync()
+}
+
+// marks the references to the moved objects as updated.
+func (j *compactJournal) finish() error {
+	if _, err := j.f.WriteString(compactJournalDone + "\n"); err != nil {
+		return err
+	}
+
+	return j.f.Sync()
+}
+
+func (j *compactJournal) close() {
+	_ = j.f.Close()
+}
+
+// removes the compaction journal with path p if it exists.
+func removeCompactJournal(p string) error {
+	if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// loads the state of the interrupted compactions from the journals.
+// Blobovniczas which references have been already updated are removed,
+// the other ones are compacted again by the next compaction.
+func (b *blobovniczas) loadCompactionJournals() error {
+	return b.iterateLeaves(func(p string) (bool, error) {
+		jPath := b.journalPath(p)
+
+		if _, err := os.Stat(jPath); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return false, nil
+			}
+
+			return false, fmt.Errorf("could not stat compaction journal %s: %w", jPath, err)
+		}
+
+		j, err := b.openCompactJournal(p)
+		if err != nil {
+			return false, fmt.Errorf("could not read compaction journal %s: %w", jPath, err)
+		}
+
+		j.close()
+
+		if j.done {
+			err := os.Remove(filepath.Join(b.blzRootPath, p))
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return false, fmt.Errorf("could not remove compacted blobovnicza %s: %w", p, err)
+			}
+
+			b.log.Info("compacted blobovnicza removed", zap.String("path", p))
+
+			return false, removeCompactJournal(jPath)
+		}
+
+		b.activeMtx.Lock()
+		b.interrupted[p] = struct{}{}
+		b.activeMtx.Unlock()
+
+		b.log.Info("blobovnicza compaction has been interrupted, it will be resumed by the next compaction",
+			zap.String("path", p),
+			zap.Int("copied", len(j.moved)),
+		)
+
+		return false, nil
+	})
+}
@@ -0,0 +1,146 @@
+package blobstor
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompactResume(t *testing.T) {
+	const objCount = 60
+
+	dir := t.TempDir()
+
+	newBlobStor := func(t *testing.T) *BlobStor {
+		bs := New(WithRootPath(dir),
+			WithSmallSizeLimit(2048),
+			WithBlobovniczaShallowDepth(2),
+			WithBlobovniczaShallowWidth(4),
+			WithBlobovniczaSize(8*1024))
+		require.NoError(t, bs.Open())
+		require.NoError(t, bs.Init())
+		return bs
+	}
+
+	bs := newBlobStor(t)
+
+	ids := make(map[string]*blobovnicza.ID)
+
+	var kept []*objectSDK.Object
+	for i := 0; i < objCount; i++ {
+		obj := testObject(1024)
+
+		prm := new(PutPrm)
+		prm.SetObject(obj)
+
+		res, err := bs.Put(prm)
+		require.NoError(t, err)
+
+		addr := object.AddressOf(obj)
+
+		if i%5 == 0 {
+			kept = append(kept, obj)
+			ids[addr.String()] = res.BlobovniczaID()
+			continue
+		}
+
+		// remove most of the objects to make blobovniczas sparse
+		delPrm := new(DeleteSmallPrm)
+		delPrm.SetAddress(addr)
+		delPrm.SetBlobovniczaID(res.BlobovniczaID())
+
+		_, err = bs.DeleteSmall(delPrm)
+		require.NoError(t, err)
+	}
+
+	// moved objects by the compacted blobovnicza
+	moved := make(map[string][]MovedObject)
+
+	handler := func(fail bool) CompactHandler {
+		return func(from *blobovnicza.ID, objs []MovedObject) ([]*addressSDK.Address, error) {
+			moved[from.String()] = append([]MovedObject{}, objs...)
+			if fail {
+				return nil, errors.New("interrupted")
+			}
+
+			for i := range objs {
+				ids[objs[i].Address.String()] = objs[i].BlobovniczaID
+			}
+
+			return nil, nil
+		}
+	}
+
+	prm := new(CompactPrm)
+	prm.SetFillPercent(100)
+	prm.SetHandler(handler(true))
+
+	_, err := bs.Compact(prm)
+	require.Error(t, err)
+	require.Len(t, moved, 1)
+
+	var interrupted string
+	var copied []MovedObject
+	for p, objs := range moved {
+		interrupted, copied = p, objs
+	}
+
+	require.FileExists(t, filepath.Join(dir, blobovniczaDir, interrupted+compactJournalSuffix))
+
+	// objects are still read from the interrupted blobovnicza
+	for i := range kept {
+		getPrm := new(GetSmallPrm)
+		getPrm.SetAddress(object.AddressOf(kept[i]))
+		getPrm.SetBlobovniczaID(ids[object.AddressOf(kept[i]).String()])
+
+		res, err := bs.GetSmall(getPrm)
+		require.NoError(t, err)
+		require.Equal(t, kept[i], res.Object())
+	}
+
+	require.NoError(t, bs.Close())
+
+	bs = newBlobStor(t)
+	defer bs.Close()
+
+	moved = make(map[string][]MovedObject)
+
+	prm.SetFillPercent(1)
+	prm.SetHandler(handler(false))
+
+	res, err := bs.Compact(prm)
+	require.NoError(t, err)
+	require.NotZero(t, res.Compacted())
+
+	// copies made before the restart are reused
+	require.Equal(t, movedStrings(copied), movedStrings(moved[interrupted]))
+
+	_, err = os.Stat(filepath.Join(dir, blobovniczaDir, interrupted+compactJournalSuffix))
+	require.True(t, errors.Is(err, os.ErrNotExist), "got: %v", err)
+
+	for i := range kept {
+		getPrm := new(GetSmallPrm)
+		getPrm.SetAddress(object.AddressOf(kept[i]))
+		getPrm.SetBlobovniczaID(ids[object.AddressOf(kept[i]).String()])
+
+		res, err := bs.GetSmall(getPrm)
+		require.NoError(t, err)
+		require.Equal(t, kept[i], res.Object())
+	}
+}
+
+func movedStrings(objs []MovedObject) []string {
+	res := make([]string, len(objs))
+	for i := range objs {
+		res[i] = objs[i].Address.String() + " " + objs[i].BlobovniczaID.String()
+	}
+
+	return res
+}
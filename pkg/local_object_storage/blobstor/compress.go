@@ -1,39 +1,54 @@
 package blobstor
 
 import (
-	"github.com/klauspost/compress/zstd"
-)
+	"fmt"
 
-// zstdFrameMagic contains first 4 bytes of any compressed object
-// https://github.com/klauspost/compress/blob/master/zstd/framedec.go#L58 .
-var zstdFrameMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	"go.uber.org/zap"
+)
 
 func noOpCompressor(data []byte) []byte {
 	return data
 }
 
-func noOpDecompressor(data []byte) ([]byte, error) {
-	return data, nil
-}
+// initCompression sets compressor according to the configured codec
+// and decompressor which is able to read data of any supported codec.
+func (b *blobovniczas) initCompression() error {
+	// Compression is always done based on config settings.
+	if b.compressionEnabled {
+		codec, err := compression.New(b.compressionCodec, b.compressionLevel)
+		if err != nil {
+			return fmt.Errorf("could not create %s compressor: %w", b.compressionCodec, err)
+		}
+
+		b.onClose = append(b.onClose, func() {
+			if err := codec.Close(); err != nil {
+				b.log.Debug("can't close compressor",
+					zap.String("codec", codec.Name()),
+					zap.String("err", err.Error()),
+				)
+			}
+		})
+
+		b.compressor = codec.Compress
+	} else {
+		b.compressor = noOpCompressor
+	}
 
-func zstdCompressor() (*zstd.Encoder, func([]byte) []byte, error) {
-	enc, err := zstd.NewWriter(nil)
+	// However we should be able to read any object
+	// we have previously written.
+	dec, err := compression.NewDecompressor()
 	if err != nil {
-		return nil, nil, err
+		return fmt.Errorf("could not create decompressor: %w", err)
 	}
 
-	return enc, func(data []byte) []byte {
-		return enc.EncodeAll(data, make([]byte, 0, len(data)))
-	}, nil
-}
+	b.onClose = append(b.onClose, func() {
+		if err := dec.Close(); err != nil {
+			b.log.Debug("can't close decompressor", zap.String("err", err.Error()))
+		}
+	})
 
-func zstdDecompressor() (*zstd.Decoder, func([]byte) ([]byte, error), error) {
-	dec, err := zstd.NewReader(nil)
-	if err != nil {
-		return nil, nil, err
-	}
+	b.decompressor = dec.Decompress
 
-	return dec, func(data []byte) ([]byte, error) {
-		return dec.DecodeAll(data, nil)
-	}, nil
+	return nil
 }
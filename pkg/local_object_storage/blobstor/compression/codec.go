@@ -0,0 +1,172 @@
+package compression
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Codec represents algorithm of the object data compression.
+type Codec interface {
+	// Name returns name of the codec as it is used in configuration.
+	Name() string
+
+	// Compress returns compressed representation of data.
+	Compress(data []byte) []byte
+
+	// Decompress restores data compressed by Compress.
+	Decompress(data []byte) ([]byte, error)
+
+	// Close releases all internal resources of the codec.
+	Close() error
+}
+
+// Names of the supported codecs.
+//
+// LZ4 is not supported: there is no maintained LZ4 implementation among
+// the dependencies, and the on-disk format must not rely on a custom one.
+const (
+	// None is a name of the "codec" which stores data as is.
+	None = "none"
+
+	// Zstd is a name of the Zstandard codec.
+	Zstd = "zstd"
+
+	// Snappy is a name of the Snappy codec.
+	Snappy = "snappy"
+)
+
+// zstdFrameMagic contains first 4 bytes of any zstd compressed object
+// https://github.com/klauspost/compress/blob/master/zstd/framedec.go#L58 .
+var zstdFrameMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+// headerMagic is a prefix of the data compressed by codecs whose
+// format is not self-describing. It is followed by the codec identifier.
+//
+// The first byte is not a valid protobuf tag (wire type 6), so the
+// header can't be confused with an uncompressed object.
+var headerMagic = []byte{0x4e, 0x45, 0x4f}
+
+const headerSize = 4
+
+type codecInfo struct {
+	// identifier which is written to the header,
+	// zero for codecs with self-describing format
+	id byte
+
+	constructor func(level int) (Codec, error)
+}
+
+var registry = map[string]codecInfo{
+	Zstd: {
+		constructor: newZstd,
+	},
+	Snappy: {
+		id:          0x01,
+		constructor: newSnappy,
+	},
+}
+
+// ErrUnknownCodec is returned when the codec is not registered.
+var ErrUnknownCodec = errors.New("unknown compression codec")
+
+// New creates Codec with the given name and compression level.
+//
+// Level meaning depends on the codec, zero means default level.
+// Codecs which don't support levels ignore it.
+//
+// Returns ErrUnknownCodec if codec with the given name is not registered.
+func New(name string, level int) (Codec, error) {
+	info, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCodec, name)
+	}
+
+	return info.constructor(level)
+}
+
+// Names returns sorted list of the supported codec names.
+func Names() []string {
+	names := make([]string, 0, len(registry))
+
+	for name := range registry {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Detect returns name of the codec data was compressed with.
+//
+// Returns None if data is not compressed.
+func Detect(data []byte) string {
+	if bytes.HasPrefix(data, zstdFrameMagic) {
+		return Zstd
+	}
+
+	if len(data) >= headerSize && bytes.HasPrefix(data, headerMagic) {
+		for name, info := range registry {
+			if info.id != 0 && info.id == data[len(headerMagic)] {
+				return name
+			}
+		}
+	}
+
+	return None
+}
+
+// appendHeader writes header of the codec with the given id to dst.
+func appendHeader(dst []byte, id byte) []byte {
+	return append(append(dst, headerMagic...), id)
+}
+
+// Decompressor decompresses data compressed by any supported codec.
+// Uncompressed data is returned as is.
+type Decompressor struct {
+	codecs map[string]Codec
+}
+
+// NewDecompressor creates and returns Decompressor for all supported codecs.
+func NewDecompressor() (*Decompressor, error) {
+	d := &Decompressor{
+		codecs: make(map[string]Codec, len(registry)),
+	}
+
+	for name, info := range registry {
+		c, err := info.constructor(0)
+		if err != nil {
+			_ = d.Close()
+			return nil, fmt.Errorf("could not create %s codec: %w", name, err)
+		}
+
+		d.codecs[name] = c
+	}
+
+	return d, nil
+}
+
+// Decompress detects codec of the data and decompresses it.
+func (d *Decompressor) Decompress(data []byte) ([]byte, error) {
+	name := Detect(data)
+	if name == None {
+		return data, nil
+	}
+
+	return d.codecs[name].Decompress(data)
+}
+
+// Close releases resources of all codecs.
+func (d *Decompressor) Close() error {
+	var firstErr error
+
+	for _, c := range d.codecs {
+		if err := c.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
@@ -0,0 +1,104 @@
+package compression
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodecs(t *testing.T) {
+	random := make([]byte, 4096)
+	_, _ = rand.Read(random)
+
+	inputs := [][]byte{
+		[]byte("small"),
+		bytes.Repeat([]byte("compressible data "), 1024),
+		random,
+	}
+
+	dec, err := NewDecompressor()
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, dec.Close()) })
+
+	for _, name := range Names() {
+		for _, level := range []int{0, 1, 9} {
+			c, err := New(name, level)
+			require.NoError(t, err)
+			require.Equal(t, name, c.Name())
+
+			for _, data := range inputs {
+				compressed := c.Compress(data)
+				require.Equal(t, name, Detect(compressed))
+
+				res, err := c.Decompress(compressed)
+				require.NoError(t, err)
+				require.Equal(t, len(data), len(res))
+				require.True(t, bytes.Equal(data, res))
+
+				res, err = dec.Decompress(compressed)
+				require.NoError(t, err)
+				require.True(t, bytes.Equal(data, res))
+			}
+
+			require.NoError(t, c.Close())
+		}
+	}
+
+	t.Run("uncompressed", func(t *testing.T) {
+		data := []byte{0x0a, 0x01, 0x02}
+
+		require.Equal(t, None, Detect(data))
+
+		res, err := dec.Decompress(data)
+		require.NoError(t, err)
+		require.Equal(t, data, res)
+	})
+
+	t.Run("unknown codec", func(t *testing.T) {
+		_, err := New("unknown", 0)
+		require.ErrorIs(t, err, ErrUnknownCodec)
+	})
+}
+
+func TestDecompressReference(t *testing.T) {
+	dec, err := NewDecompressor()
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, dec.Close()) })
+
+	const zstdInput = "NeoFS object payload compressed by the reference zstd CLI. " +
+		"NeoFS object payload compressed by the reference zstd CLI."
+
+	vectors := []struct {
+		codec string
+		data  string // hex
+		res   string
+	}{
+		{
+			// produced by `zstd -19` v1.5.6
+			codec: Zstd,
+			data: "28b52ffd2475ed0100c2030d11a02f0668e5f5ddd737c8dddeb3bdff5e03c560906055e9f513" +
+				"fcfcfaf459b54556a7f453be98ac4e74f648d7f486a28d882f0101006c7f2a2892bf752d",
+			res: zstdInput,
+		},
+		{
+			// codec header and the Snappy block assembled according to the format
+			// description: length 16, literal "abcd", copy of 12 bytes at offset 4
+			codec: Snappy,
+			data:  "4e454f01" + "10" + "0c61626364" + "2e0400",
+			res:   "abcdabcdabcdabcd",
+		},
+	}
+
+	for _, v := range vectors {
+		data, err := hex.DecodeString(v.data)
+		require.NoError(t, err)
+		require.Equal(t, v.codec, Detect(data))
+
+		res, err := dec.Decompress(data)
+		require.NoError(t, err, v.codec)
+		require.Equal(t, v.res, string(res), v.codec)
+	}
+}
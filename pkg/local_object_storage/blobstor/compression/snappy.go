@@ -0,0 +1,39 @@
+package compression
+
+import (
+	"errors"
+
+	"github.com/klauspost/compress/snappy"
+)
+
+type snappyCodec struct{}
+
+// newSnappy creates Snappy codec. Level is ignored.
+func newSnappy(int) (Codec, error) {
+	return snappyCodec{}, nil
+}
+
+func (snappyCodec) Name() string {
+	return Snappy
+}
+
+func (snappyCodec) Compress(data []byte) []byte {
+	buf := make([]byte, headerSize+snappy.MaxEncodedLen(len(data)))
+	appendHeader(buf[:0], registry[Snappy].id)
+
+	enc := snappy.Encode(buf[headerSize:], data)
+
+	return buf[:headerSize+len(enc)]
+}
+
+func (snappyCodec) Decompress(data []byte) ([]byte, error) {
+	if len(data) < headerSize {
+		return nil, errors.New("snappy: missing header")
+	}
+
+	return snappy.Decode(nil, data[headerSize:])
+}
+
+func (snappyCodec) Close() error {
+	return nil
+}
@@ -0,0 +1,53 @@
+package compression
+
+import (
+	"github.com/klauspost/compress/zstd"
+)
+
+type zstdCodec struct {
+	enc *zstd.Encoder
+	dec *zstd.Decoder
+}
+
+// newZstd creates Zstandard codec. Level is interpreted
+// as a standard zstd compression level (1-22).
+func newZstd(level int) (Codec, error) {
+	var opts []zstd.EOption
+
+	if level > 0 {
+		opts = append(opts, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+	}
+
+	enc, err := zstd.NewWriter(nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	dec, err := zstd.NewReader(nil)
+	if err != nil {
+		_ = enc.Close()
+		return nil, err
+	}
+
+	return &zstdCodec{
+		enc: enc,
+		dec: dec,
+	}, nil
+}
+
+func (c *zstdCodec) Name() string {
+	return Zstd
+}
+
+func (c *zstdCodec) Compress(data []byte) []byte {
+	return c.enc.EncodeAll(data, make([]byte, 0, len(data)))
+}
+
+func (c *zstdCodec) Decompress(data []byte) ([]byte, error) {
+	return c.dec.DecodeAll(data, nil)
+}
+
+func (c *zstdCodec) Close() error {
+	c.dec.Close()
+	return c.enc.Close()
+}
@@ -4,6 +4,12 @@ package blobstor
 func (b *BlobStor) Open() error {
 	b.log.Debug("opening...")
 
+	if b.readOnly {
+		// Init is not called in read-only mode,
+		// but the stored objects are decompressed
+		return b.blobovniczas.initCompression()
+	}
+
 	return nil
 }
 
@@ -205,6 +205,18 @@ func (t *FSTree) Get(addr *addressSDK.Address) ([]byte, error) {
 	return os.ReadFile(p)
 }
 
+// Open opens the file with object contents for reading.
+//
+// Returns ErrFileNotFound if object is missing.
+func (t *FSTree) Open(addr *addressSDK.Address) (*os.File, error) {
+	f, err := os.Open(t.treePath(addr))
+	if os.IsNotExist(err) {
+		return nil, ErrFileNotFound
+	}
+
+	return f, err
+}
+
 // NumberOfObjects walks the file tree rooted at FSTree's root
 // and returns number of stored objects.
 func (t *FSTree) NumberOfObjects() (uint64, error) {
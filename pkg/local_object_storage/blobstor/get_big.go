@@ -6,12 +6,12 @@ import (
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
-	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 )
 
 // GetBigPrm groups the parameters of GetBig operation.
 type GetBigPrm struct {
 	address
+	rwHeaderOnly
 }
 
 // GetBigRes groups resulting values of GetBig operation.
@@ -44,10 +44,9 @@ func (b *BlobStor) GetBig(prm *GetBigPrm) (*GetBigRes, error) {
 		return nil, fmt.Errorf("could not decompress object data: %w", err)
 	}
 
-	// unmarshal the object
-	obj := objectSDK.New()
-	if err := obj.Unmarshal(data); err != nil {
-		return nil, fmt.Errorf("could not unmarshal the object: %w", err)
+	obj, err := unmarshalObject(data, prm.headerOnly)
+	if err != nil {
+		return nil, err
 	}
 
 	return &GetBigRes{
@@ -3,8 +3,9 @@ package blobstor
 import (
 	"errors"
 	"fmt"
+	"io"
 
-	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
@@ -22,6 +23,8 @@ type GetRangeBigRes struct {
 }
 
 // GetRangeBig reads data of object payload range from shallow dir of BLOB storage.
+// Only the requested range is read from the uncompressed objects, objects
+// compressed by the previous versions are read entirely.
 //
 // Returns any error encountered that
 // did not allow to completely read the object payload range.
@@ -29,8 +32,7 @@ type GetRangeBigRes struct {
 // Returns ErrRangeOutOfBounds if requested object range is out of bounds.
 // Returns an error of type apistatus.ObjectNotFound if object is missing.
 func (b *BlobStor) GetRangeBig(prm *GetRangeBigPrm) (*GetRangeBigRes, error) {
-	// get compressed object data
-	data, err := b.fsTree.Get(prm.addr)
+	f, err := b.fsTree.Open(prm.addr)
 	if err != nil {
 		if errors.Is(err, fstree.ErrFileNotFound) {
 			var errNotFound apistatus.ObjectNotFound
@@ -38,30 +40,62 @@ func (b *BlobStor) GetRangeBig(prm *GetRangeBigPrm) (*GetRangeBigRes, error) {
 			return nil, errNotFound
 		}
 
-		return nil, fmt.Errorf("could not read object from fs tree: %w", err)
+		return nil, fmt.Errorf("could not open object file in fs tree: %w", err)
 	}
 
-	data, err = b.decompressor(data)
+	defer f.Close()
+
+	fi, err := f.Stat()
 	if err != nil {
-		return nil, fmt.Errorf("could not decompress object data: %w", err)
+		return nil, fmt.Errorf("could not stat object file in fs tree: %w", err)
 	}
 
-	// unmarshal the object
-	obj := objectSDK.New()
-	if err := obj.Unmarshal(data); err != nil {
-		return nil, fmt.Errorf("could not unmarshal the object: %w", err)
+	var prefix [4]byte
+
+	n, err := f.ReadAt(prefix[:], 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("could not read object from fs tree: %w", err)
 	}
 
-	payload := obj.Payload()
-	ln, off := prm.rng.GetLength(), prm.rng.GetOffset()
+	var data []byte
 
-	if pLen := uint64(len(payload)); pLen < ln+off {
-		return nil, object.ErrRangeOutOfBounds
+	if compression.Detect(prefix[:n]) == compression.None {
+		data, err = readPayloadRange(f, fi.Size(), prm.rng)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		data, err = b.getRangeCompressed(f, prm.rng)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &GetRangeBigRes{
 		rangeData: rangeData{
-			data: payload[off : off+ln],
+			data: data,
 		},
 	}, nil
 }
+
+// getRangeCompressed reads the whole compressed object from the file
+// and cuts the payload range.
+func (b *BlobStor) getRangeCompressed(f io.Reader, rng *objectSDK.Range) ([]byte, error) {
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("could not read object from fs tree: %w", err)
+	}
+
+	data, err = b.decompressor(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress object data: %w", err)
+	}
+
+	// only the payload is needed, so the object is not unmarshaled
+	payload, err := objectPayload(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not read object payload: %w", err)
+	}
+
+	return payloadRange(payload, rng)
+}
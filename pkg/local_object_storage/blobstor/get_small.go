@@ -4,6 +4,7 @@ package blobstor
 type GetSmallPrm struct {
 	address
 	rwBlobovniczaID
+	rwHeaderOnly
 }
 
 // GetSmallRes groups resulting values of GetSmall operation.
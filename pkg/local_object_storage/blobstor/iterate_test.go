@@ -72,7 +72,7 @@ func TestIterateObjects(t *testing.T) {
 	}
 
 	for _, v := range mObjs {
-		_, err := blobStor.PutRaw(v.addr, v.data, true)
+		_, err := blobStor.PutRaw(v.addr, v.data, true, "")
 		require.NoError(t, err)
 	}
 
@@ -125,7 +125,7 @@ func TestIterate_IgnoreErrors(t *testing.T) {
 		objData, err := obj.Marshal()
 		require.NoError(t, err)
 
-		_, err = bs.PutRaw(addrs[i], objData, true)
+		_, err = bs.PutRaw(addrs[i], objData, true, "")
 		require.NoError(t, err)
 	}
 
@@ -139,7 +139,7 @@ func TestIterate_IgnoreErrors(t *testing.T) {
 		rawData[i] ^= 0xFF
 	}
 	// Will be put uncompressed but fetched as compressed because of magic.
-	_, err = bs.PutRaw(objecttest.Address(), rawData, false)
+	_, err = bs.PutRaw(objecttest.Address(), rawData, false, "")
 	require.NoError(t, err)
 	require.NoError(t, bs.fsTree.Put(objecttest.Address(), rawData))
 
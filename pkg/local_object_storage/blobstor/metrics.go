@@ -0,0 +1,17 @@
+package blobstor
+
+import (
+	"time"
+)
+
+// MetricRegister represents BlobStor's metric register.
+type MetricRegister interface {
+	// AddCompressionSavedBytes registers the number of bytes
+	// saved by the object compression.
+	AddCompressionSavedBytes(n uint64)
+	// AddCompressionDuration registers the time spent on the object compression.
+	AddCompressionDuration(d time.Duration)
+	// IncCompressionSkipped registers the object saved without compression
+	// for the given reason.
+	IncCompressionSkipped(reason string)
+}
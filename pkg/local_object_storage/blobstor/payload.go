@@ -0,0 +1,195 @@
+package blobstor
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// number of the payload field in the protobuf message of the object.
+const payloadFieldNum = 4
+
+// objectPayload returns payload of the object from its binary representation
+// without decoding the rest of the object. Returned slice refers to data.
+func objectPayload(data []byte) ([]byte, error) {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, fmt.Errorf("invalid field tag: %w", protowire.ParseError(n))
+		}
+
+		data = data[n:]
+
+		if num == payloadFieldNum && typ == protowire.BytesType {
+			payload, n := protowire.ConsumeBytes(data)
+			if n < 0 {
+				return nil, fmt.Errorf("invalid payload field: %w", protowire.ParseError(n))
+			}
+
+			return payload, nil
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, data)
+		if n < 0 {
+			return nil, fmt.Errorf("invalid field #%d: %w", num, protowire.ParseError(n))
+		}
+
+		data = data[n:]
+	}
+
+	// empty payload is not encoded
+	return nil, nil
+}
+
+// unmarshalObject decodes the object from its binary representation.
+// If headerOnly is set, the payload is not decoded and the returned
+// object has an empty payload.
+func unmarshalObject(data []byte, headerOnly bool) (*objectSDK.Object, error) {
+	if headerOnly {
+		var err error
+
+		data, err = cutPayloadField(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	obj := objectSDK.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("could not unmarshal the object: %w", err)
+	}
+
+	return obj, nil
+}
+
+// cutPayloadField returns binary representation of the object
+// without the payload field.
+func cutPayloadField(data []byte) ([]byte, error) {
+	var res []byte
+
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, fmt.Errorf("invalid field tag: %w", protowire.ParseError(n))
+		}
+
+		m := protowire.ConsumeFieldValue(num, typ, data[n:])
+		if m < 0 {
+			return nil, fmt.Errorf("invalid field #%d: %w", num, protowire.ParseError(m))
+		}
+
+		if num != payloadFieldNum {
+			res = append(res, data[:n+m]...)
+		}
+
+		data = data[n+m:]
+	}
+
+	return res, nil
+}
+
+// payloadRange cuts the range from the payload.
+//
+// Returns ErrRangeOutOfBounds if requested range is out of bounds.
+func payloadRange(payload []byte, rng *objectSDK.Range) ([]byte, error) {
+	from := rng.GetOffset()
+	to := from + rng.GetLength()
+
+	if to < from || uint64(len(payload)) < to {
+		return nil, object.ErrRangeOutOfBounds
+	}
+
+	return payload[from:to], nil
+}
+
+// readPayloadRange reads the payload range of the uncompressed object
+// from its binary representation of the given size. Only the fields
+// preceding the payload and the requested range are read.
+//
+// Returns ErrRangeOutOfBounds if requested range is out of bounds.
+func readPayloadRange(r io.ReaderAt, size int64, rng *objectSDK.Range) ([]byte, error) {
+	br := bufio.NewReader(io.NewSectionReader(r, 0, size))
+
+	var off int64
+
+	for off < size {
+		tag, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field tag: %w", err)
+		}
+
+		off += int64(protowire.SizeVarint(tag))
+
+		num, typ := protowire.DecodeTag(tag)
+
+		var ln uint64
+
+		switch typ {
+		case protowire.VarintType:
+			v, err := binary.ReadUvarint(br)
+			if err != nil {
+				return nil, fmt.Errorf("invalid field #%d: %w", num, err)
+			}
+
+			off += int64(protowire.SizeVarint(v))
+
+			continue
+		case protowire.Fixed32Type:
+			ln = 4
+		case protowire.Fixed64Type:
+			ln = 8
+		case protowire.BytesType:
+			ln, err = binary.ReadUvarint(br)
+			if err != nil {
+				return nil, fmt.Errorf("invalid field #%d: %w", num, err)
+			}
+
+			off += int64(protowire.SizeVarint(ln))
+		default:
+			return nil, fmt.Errorf("invalid wire type %d of field #%d", typ, num)
+		}
+
+		if ln > uint64(size-off) {
+			return nil, fmt.Errorf("invalid field #%d: %w", num, io.ErrUnexpectedEOF)
+		}
+
+		if num == payloadFieldNum && typ == protowire.BytesType {
+			return readRange(r, off, ln, rng)
+		}
+
+		if _, err := br.Discard(int(ln)); err != nil {
+			return nil, fmt.Errorf("invalid field #%d: %w", num, err)
+		}
+
+		off += int64(ln)
+	}
+
+	// empty payload is not encoded
+	return payloadRange(nil, rng)
+}
+
+// readRange reads the range of the payload of the given length
+// located at the offset.
+//
+// Returns ErrRangeOutOfBounds if requested range is out of bounds.
+func readRange(r io.ReaderAt, off int64, ln uint64, rng *objectSDK.Range) ([]byte, error) {
+	from := rng.GetOffset()
+	to := from + rng.GetLength()
+
+	if to < from || ln < to {
+		return nil, object.ErrRangeOutOfBounds
+	}
+
+	data := make([]byte, to-from)
+
+	if _, err := r.ReadAt(data, off+int64(from)); err != nil {
+		return nil, fmt.Errorf("could not read payload range: %w", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,69 @@
+package blobstor
+
+import (
+	"sync"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/internal/sizecache"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+)
+
+// payloadCache is an LRU cache of the decoded payloads of the small
+// objects. Total size of the cached payloads is limited. Methods
+// of nil cache do nothing.
+type payloadCache struct {
+	mtx sync.Mutex
+
+	payloads *sizecache.Cache
+}
+
+// newPayloadCache returns nil if capacity is zero.
+func newPayloadCache(capacity uint64) *payloadCache {
+	if capacity == 0 {
+		return nil
+	}
+
+	return &payloadCache{
+		payloads: sizecache.New(capacity),
+	}
+}
+
+// get returns the cached payload of the object. The payload
+// must not be modified.
+func (c *payloadCache) get(addr *addressSDK.Address) ([]byte, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	v, ok := c.payloads.Get(addr.String())
+	if !ok {
+		return nil, false
+	}
+
+	return v.([]byte), true
+}
+
+// add caches the payload of the object. The payload must
+// not be modified after the call.
+func (c *payloadCache) add(addr *addressSDK.Address, payload []byte) {
+	if c == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	c.payloads.Add(addr.String(), payload, uint64(len(payload)))
+	c.mtx.Unlock()
+}
+
+// remove drops the payload of the object from the cache.
+func (c *payloadCache) remove(addr *addressSDK.Address) {
+	if c == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	c.payloads.Remove(addr.String())
+	c.mtx.Unlock()
+}
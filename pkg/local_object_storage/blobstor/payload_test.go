@@ -0,0 +1,286 @@
+package blobstor
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectPayload(t *testing.T) {
+	obj := objecttest.Object()
+	obj.SetPayload([]byte("payload"))
+
+	data, err := obj.Marshal()
+	require.NoError(t, err)
+
+	payload, err := objectPayload(data)
+	require.NoError(t, err)
+	require.Equal(t, obj.Payload(), payload)
+
+	obj.SetPayload(nil)
+
+	data, err = obj.Marshal()
+	require.NoError(t, err)
+
+	payload, err = objectPayload(data)
+	require.NoError(t, err)
+	require.Empty(t, payload)
+
+	_, err = objectPayload([]byte{0xFF})
+	require.Error(t, err)
+}
+
+func TestUnmarshalObjectHeader(t *testing.T) {
+	obj := objecttest.Object()
+	obj.SetPayload([]byte("payload"))
+
+	data, err := obj.Marshal()
+	require.NoError(t, err)
+
+	hdr, err := obj.CutPayload().Marshal()
+	require.NoError(t, err)
+
+	res, err := unmarshalObject(data, true)
+	require.NoError(t, err)
+	require.Empty(t, res.Payload())
+
+	resData, err := res.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, hdr, resData)
+
+	res, err = unmarshalObject(data, false)
+	require.NoError(t, err)
+	require.Equal(t, obj.Payload(), res.Payload())
+}
+
+func TestPayloadRange(t *testing.T) {
+	payload := []byte("payload")
+
+	rng := objectSDK.NewRange()
+	rng.SetOffset(3)
+	rng.SetLength(4)
+
+	data, err := payloadRange(payload, rng)
+	require.NoError(t, err)
+	require.Equal(t, []byte("load"), data)
+
+	rng.SetLength(5)
+
+	_, err = payloadRange(payload, rng)
+	require.True(t, errors.Is(err, object.ErrRangeOutOfBounds))
+}
+
+func TestReadPayloadRange(t *testing.T) {
+	obj := objecttest.Object()
+	obj.SetPayload([]byte("payload"))
+
+	data, err := obj.Marshal()
+	require.NoError(t, err)
+
+	rng := objectSDK.NewRange()
+	rng.SetOffset(3)
+	rng.SetLength(4)
+
+	res, err := readPayloadRange(bytes.NewReader(data), int64(len(data)), rng)
+	require.NoError(t, err)
+	require.Equal(t, []byte("load"), res)
+
+	rng.SetLength(5)
+
+	_, err = readPayloadRange(bytes.NewReader(data), int64(len(data)), rng)
+	require.True(t, errors.Is(err, object.ErrRangeOutOfBounds))
+
+	// truncated object
+	rng.SetLength(4)
+
+	_, err = readPayloadRange(bytes.NewReader(data), int64(len(data)-1), rng)
+	require.Error(t, err)
+
+	obj.SetPayload(nil)
+
+	data, err = obj.Marshal()
+	require.NoError(t, err)
+
+	rng.SetOffset(0)
+	rng.SetLength(0)
+
+	res, err = readPayloadRange(bytes.NewReader(data), int64(len(data)), rng)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	rng.SetLength(1)
+
+	_, err = readPayloadRange(bytes.NewReader(data), int64(len(data)), rng)
+	require.True(t, errors.Is(err, object.ErrRangeOutOfBounds))
+}
+
+func TestBlobStor_GetRangeBig(t *testing.T) {
+	const smallSizeLimit = 512
+
+	newObject := func() *objectSDK.Object {
+		obj := testObject(smallSizeLimit * 2)
+
+		payload := obj.Payload()
+		for i := range payload {
+			payload[i] = byte(i % 10) // compressible data
+		}
+
+		obj.SetPayload(payload)
+
+		return obj
+	}
+
+	rng := objectSDK.NewRange()
+	rng.SetOffset(100)
+	rng.SetLength(200)
+
+	for _, excludeBig := range []bool{false, true} {
+		bs := New(WithCompressObjects(true),
+			WithCompressionExcludeBig(excludeBig),
+			WithRootPath(t.TempDir()),
+			WithSmallSizeLimit(smallSizeLimit),
+			WithBlobovniczaShallowWidth(1))
+		require.NoError(t, bs.Open())
+		require.NoError(t, bs.Init())
+
+		checkRange := func(t *testing.T, obj *objectSDK.Object) {
+			prm := new(GetRangeBigPrm)
+			prm.SetAddress(object.AddressOf(obj))
+			prm.SetRange(rng)
+
+			res, err := bs.GetRangeBig(prm)
+			require.NoError(t, err)
+			require.Equal(t, obj.Payload()[100:300], res.RangeData())
+		}
+
+		obj := newObject()
+
+		putPrm := new(PutPrm)
+		putPrm.SetObject(obj)
+
+		_, err := bs.Put(putPrm)
+		require.NoError(t, err)
+
+		// big objects are compressed unless excluded explicitly
+		data, err := bs.fsTree.Get(object.AddressOf(obj))
+		require.NoError(t, err)
+		require.Equal(t, excludeBig, compression.Detect(data) == compression.None, excludeBig)
+
+		checkRange(t, obj)
+
+		// uncompressed objects are read regardless of the settings
+		obj = newObject()
+
+		data, err = obj.Marshal()
+		require.NoError(t, err)
+
+		require.NoError(t, bs.fsTree.Put(object.AddressOf(obj), data))
+
+		checkRange(t, obj)
+
+		require.NoError(t, bs.Close())
+	}
+}
+
+func TestPayloadCache(t *testing.T) {
+	require.Nil(t, newPayloadCache(0))
+
+	c := newPayloadCache(10)
+
+	a1, a2, a3 := testAddress(), testAddress(), testAddress()
+
+	c.add(a1, make([]byte, 4))
+	c.add(a2, make([]byte, 4))
+	require.EqualValues(t, 8, c.payloads.Size())
+
+	// the least recently used payload is evicted
+	_, ok := c.get(a1)
+	require.True(t, ok)
+
+	c.add(a3, make([]byte, 4))
+	require.EqualValues(t, 8, c.payloads.Size())
+
+	_, ok = c.get(a2)
+	require.False(t, ok)
+
+	// replacement
+	c.add(a3, make([]byte, 2))
+	require.EqualValues(t, 6, c.payloads.Size())
+
+	// too big payload is not cached
+	c.add(a2, make([]byte, 11))
+	require.EqualValues(t, 6, c.payloads.Size())
+
+	c.remove(a1)
+	require.EqualValues(t, 2, c.payloads.Size())
+
+	_, ok = c.get(a1)
+	require.False(t, ok)
+}
+
+func TestBlobovniczas_GetRangeCache(t *testing.T) {
+	p := "./test_blz_range_cache"
+
+	c := defaultCfg()
+
+	for _, opt := range []Option{
+		WithLogger(test.NewLogger(false)),
+		WithRootPath(p),
+		WithBlobovniczaShallowWidth(1),
+		WithBlobovniczaShallowDepth(1),
+		WithBlobovniczaRangeCacheSize(1 << 10),
+	} {
+		opt(c)
+	}
+
+	b := newBlobovniczaTree(c)
+
+	defer os.RemoveAll(p)
+
+	require.NoError(t, b.init())
+
+	obj := testObject(100)
+	addr := object.AddressOf(obj)
+
+	data, err := obj.Marshal()
+	require.NoError(t, err)
+
+	_, err = b.put(addr, data)
+	require.NoError(t, err)
+
+	rng := objectSDK.NewRange()
+	rng.SetLength(10)
+
+	prm := new(GetRangeSmallPrm)
+	prm.SetAddress(addr)
+	prm.SetRange(rng)
+
+	res, err := b.getRange(prm)
+	require.NoError(t, err)
+	require.Equal(t, obj.Payload()[:10], res.RangeData())
+
+	cached, ok := b.payloads.get(addr)
+	require.True(t, ok)
+	require.Equal(t, obj.Payload(), cached)
+
+	res, err = b.getRange(prm)
+	require.NoError(t, err)
+	require.Equal(t, obj.Payload()[:10], res.RangeData())
+
+	dPrm := new(DeleteSmallPrm)
+	dPrm.SetAddress(addr)
+
+	_, err = b.delete(dPrm)
+	require.NoError(t, err)
+
+	_, ok = b.payloads.get(addr)
+	require.False(t, ok)
+}
@@ -35,16 +35,31 @@ func (b *BlobStor) Put(prm *PutPrm) (*PutRes, error) {
 		return nil, fmt.Errorf("could not marshal the object: %w", err)
 	}
 
-	return b.PutRaw(object.AddressOf(prm.obj), data, b.NeedsCompression(prm.obj))
+	addr := object.AddressOf(prm.obj)
+
+	return b.putRaw(addr, data, b.NeedsCompression(prm.obj), compressionCategories(addr, ContentType(prm.obj)))
 }
 
 // NeedsCompression returns true if object should be compressed.
-// For object to be compressed 2 conditions must hold:
+// For object to be compressed 3 conditions must hold:
 // 1. Compression is enabled in settings.
 // 2. Object MIME Content-Type is allowed for compression.
+// 3. Objects of the same container and content type are known to compress.
+//
+// Even if NeedsCompression returns true, the object can be saved
+// uncompressed if its data turns out to be incompressible.
 func (b *BlobStor) NeedsCompression(obj *objectSDK.Object) bool {
-	if !b.compressionEnabled || len(b.uncompressableContentTypes) == 0 {
-		return b.compressionEnabled
+	if !b.compressionEnabled {
+		return false
+	}
+
+	if !b.compressionStats.shouldCompress(compressionCategories(object.AddressOf(obj), ContentType(obj))) {
+		b.incCompressionSkipped(skipReasonCategory)
+		return false
+	}
+
+	if len(b.uncompressableContentTypes) == 0 {
+		return true
 	}
 
 	for _, attr := range obj.Attributes() {
@@ -70,11 +85,22 @@ func (b *BlobStor) NeedsCompression(obj *objectSDK.Object) bool {
 }
 
 // PutRaw saves already marshaled object in BLOB storage.
-func (b *BlobStor) PutRaw(addr *addressSDK.Address, data []byte, compress bool) (*PutRes, error) {
+//
+// If compress is true, data is compressed unless it turns
+// out to be incompressible. Compression results are accounted
+// in the statistics of the object container and contentType
+// (see ContentType), empty contentType is ignored.
+func (b *BlobStor) PutRaw(addr *addressSDK.Address, data []byte, compress bool, contentType string) (*PutRes, error) {
+	return b.putRaw(addr, data, compress, compressionCategories(addr, contentType))
+}
+
+func (b *BlobStor) putRaw(addr *addressSDK.Address, data []byte, compress bool, categories []string) (*PutRes, error) {
 	big := b.isBig(data)
 
-	if compress {
-		data = b.compressor(data)
+	// payload ranges of the uncompressed big objects
+	// are read without reading the whole object
+	if compress && !(big && b.compressionExcludeBig) {
+		data = b.compress(data, categories)
 	}
 
 	if big {
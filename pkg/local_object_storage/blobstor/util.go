@@ -77,3 +77,13 @@ type rangeData struct {
 func (d rangeData) RangeData() []byte {
 	return d.data
 }
+
+type rwHeaderOnly struct {
+	headerOnly bool
+}
+
+// SetHeaderOnly sets the flag to read the object header only.
+// The payload is not decoded and the read object has no payload.
+func (h *rwHeaderOnly) SetHeaderOnly(v bool) {
+	h.headerOnly = v
+}
@@ -0,0 +1,18 @@
+package engine
+
+import "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+
+// CompactShard compacts blobovniczas of the shard with provided identifier.
+//
+// Returns an error if shard is not in read-write mode.
+func (e *StorageEngine) CompactShard(id *shard.ID, prm *shard.CompactPrm) (*shard.CompactRes, error) {
+	e.mtx.RLock()
+	sh, ok := e.shards[id.String()]
+	e.mtx.RUnlock()
+
+	if !ok {
+		return nil, errShardNotFound
+	}
+
+	return sh.Compact(prm)
+}
@@ -36,6 +36,8 @@ func (e *StorageEngine) Init() error {
 		}
 	}
 
+	e.startProbeLoop()
+
 	return nil
 }
 
@@ -55,6 +57,8 @@ func (e *StorageEngine) close(releasePools bool) error {
 	defer e.mtx.RUnlock()
 
 	if releasePools {
+		e.stopProbeLoop()
+
 		for _, p := range e.shardPools {
 			p.Release()
 		}
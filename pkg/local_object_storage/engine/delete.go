@@ -54,6 +54,8 @@ func (e *StorageEngine) delete(prm *DeletePrm) (*DeleteRes, error) {
 		err apistatus.ObjectLocked
 	}
 
+	defer e.readCache.remove(prm.addr...)
+
 	for i := range prm.addr {
 		e.iterateOverSortedShards(prm.addr[i], func(_ int, sh hashedShard) (stop bool) {
 			resExists, err := sh.Exists(existsPrm.WithAddress(prm.addr[i]))
@@ -1,7 +1,9 @@
 package engine
 
 import (
+	"errors"
 	"sync"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
@@ -20,11 +22,31 @@ type StorageEngine struct {
 
 	shardPools map[string]util.WorkerPool
 
+	readCache *readCache
+
 	blockExec struct {
 		mtx sync.RWMutex
 
 		err error
 	}
+
+	evacuations struct {
+		mtx sync.Mutex
+
+		// shards being evacuated at the moment
+		m map[string]struct{}
+	}
+
+	probes struct {
+		mtx sync.Mutex
+
+		// number of successful probes in a row
+		// of the shards in read-only mode
+		m map[string]uint32
+
+		stop     chan struct{}
+		stopOnce sync.Once
+	}
 }
 
 type shardWrapper struct {
@@ -33,13 +55,23 @@ type shardWrapper struct {
 }
 
 // reportShardError checks that amount of errors doesn't exceed configured threshold.
-// If it does, shard is set to read-only mode.
+// If it does, shard in read-write mode is set to read-only mode and is passed
+// to the shard health prober which restores read-write mode after the shard
+// recovers.
+//
+// Errors caused by the shard's degraded or maintenance mode are not counted.
 func (e *StorageEngine) reportShardError(
 	sh hashedShard,
 	msg string,
 	err error,
 	fields ...zap.Field) {
+	if errors.Is(err, shard.ErrDegradedMode) || errors.Is(err, shard.ErrMaintenanceMode) {
+		return
+	}
+
 	errCount := sh.errorCount.Inc()
+	e.reportShardErrorCount(sh.ID().String(), errCount)
+
 	e.log.Warn(msg, append([]zap.Field{
 		zap.Stringer("shard_id", sh.ID()),
 		zap.Uint32("error count", errCount),
@@ -50,6 +82,10 @@ func (e *StorageEngine) reportShardError(
 		return
 	}
 
+	if sh.GetMode() != shard.ModeReadWrite {
+		return
+	}
+
 	err = sh.SetMode(shard.ModeReadOnly)
 	if err != nil {
 		e.log.Error("failed to move shard in read-only mode",
@@ -59,6 +95,8 @@ func (e *StorageEngine) reportShardError(
 		e.log.Info("shard is moved in read-only due to error threshold",
 			zap.Stringer("shard_id", sh.ID()),
 			zap.Uint32("error count", errCount))
+
+		e.scheduleProbe(sh.ID().String())
 	}
 }
 
@@ -73,13 +111,33 @@ type cfg struct {
 	metrics MetricRegister
 
 	shardPoolSize uint32
+
+	probeInterval time.Duration
+
+	probeSuccessThreshold uint32
+
+	unreadableHandler UnreadableObjectHandler
+
+	readCacheCapacity, readCacheMaxObjectSize uint64
 }
 
+const (
+	defaultProbeInterval         = time.Minute
+	defaultProbeSuccessThreshold = 3
+
+	defaultReadCacheMaxObjectSize = 1 << 20 // 1MB
+)
+
 func defaultCfg() *cfg {
 	return &cfg{
 		log: zap.L(),
 
 		shardPoolSize: 20,
+
+		probeInterval:         defaultProbeInterval,
+		probeSuccessThreshold: defaultProbeSuccessThreshold,
+
+		readCacheMaxObjectSize: defaultReadCacheMaxObjectSize,
 	}
 }
 
@@ -91,12 +149,19 @@ func New(opts ...Option) *StorageEngine {
 		opts[i](c)
 	}
 
-	return &StorageEngine{
+	e := &StorageEngine{
 		cfg:        c,
 		mtx:        new(sync.RWMutex),
 		shards:     make(map[string]shardWrapper),
 		shardPools: make(map[string]util.WorkerPool),
+		readCache:  newReadCache(c.readCacheCapacity, c.readCacheMaxObjectSize, c.metrics),
 	}
+
+	e.evacuations.m = make(map[string]struct{})
+	e.probes.m = make(map[string]uint32)
+	e.probes.stop = make(chan struct{})
+
+	return e
 }
 
 // WithLogger returns option to set StorageEngine's logger.
@@ -126,3 +191,51 @@ func WithErrorThreshold(sz uint32) Option {
 		c.errorsThreshold = sz
 	}
 }
+
+// WithShardProbeInterval returns an option to specify the interval between
+// probes of the shards moved to read-only mode due to the error threshold.
+// Non-positive value disables the probes.
+func WithShardProbeInterval(d time.Duration) Option {
+	return func(c *cfg) {
+		c.probeInterval = d
+	}
+}
+
+// WithShardProbeSuccessThreshold returns an option to specify the number
+// of successful probes in a row after which the shard is moved back
+// to read-write mode.
+func WithShardProbeSuccessThreshold(n uint32) Option {
+	return func(c *cfg) {
+		c.probeSuccessThreshold = n
+	}
+}
+
+// WithUnreadableObjectHandler returns an option to set the handler of
+// the objects which are present in some shard, but can not be read
+// from any shard by Get, GetRange or Head. Handler is called on each
+// failed read, so it should not block.
+func WithUnreadableObjectHandler(h UnreadableObjectHandler) Option {
+	return func(c *cfg) {
+		c.unreadableHandler = h
+	}
+}
+
+// WithReadCacheCapacity returns an option to specify the maximum total size
+// of the objects cached in memory after reading from the shards. Zero
+// disables the cache.
+//
+// Objects returned by Get and Head are shared with the cache and must not
+// be modified.
+func WithReadCacheCapacity(sz uint64) Option {
+	return func(c *cfg) {
+		c.readCacheCapacity = sz
+	}
+}
+
+// WithReadCacheMaxObjectSize returns an option to specify the maximum size
+// of the object cached after reading from the shards.
+func WithReadCacheMaxObjectSize(sz uint64) Option {
+	return func(c *cfg) {
+		c.readCacheMaxObjectSize = sz
+	}
+}
@@ -20,7 +20,7 @@ import (
 
 const errSmallSize = 256
 
-func newEngineWithErrorThreshold(t *testing.T, dir string, errThreshold uint32) (*StorageEngine, string, [2]*shard.ID) {
+func newEngineWithErrorThreshold(t *testing.T, dir string, errThreshold uint32, opts ...Option) (*StorageEngine, string, [2]*shard.ID) {
 	if dir == "" {
 		var err error
 
@@ -29,10 +29,10 @@ func newEngineWithErrorThreshold(t *testing.T, dir string, errThreshold uint32)
 		t.Cleanup(func() { _ = os.RemoveAll(dir) })
 	}
 
-	e := New(
+	e := New(append([]Option{
 		WithLogger(zaptest.NewLogger(t)),
 		WithShardPoolSize(1),
-		WithErrorThreshold(errThreshold))
+		WithErrorThreshold(errThreshold)}, opts...)...)
 
 	var ids [2]*shard.ID
 	var err error
@@ -209,3 +209,70 @@ func corruptSubDir(t *testing.T, dir string) {
 		}
 	}
 }
+
+func TestShardProbe(t *testing.T) {
+	const (
+		errThreshold     = 2
+		successThreshold = 3
+	)
+
+	// probe loop is disabled, probes are run explicitly
+	e, _, id := newEngineWithErrorThreshold(t, "", errThreshold,
+		WithShardProbeInterval(0),
+		WithShardProbeSuccessThreshold(successThreshold))
+	t.Cleanup(func() { _ = e.Close() })
+
+	e.mtx.RLock()
+	sh := hashedShard(e.shards[id[0].String()])
+	e.mtx.RUnlock()
+
+	t.Run("restore after error threshold", func(t *testing.T) {
+		for i := 0; i < errThreshold; i++ {
+			e.reportShardError(sh, "test error", errors.New("test error"))
+		}
+
+		require.Equal(t, shard.ModeReadOnly, sh.GetMode())
+
+		for i := 0; i < successThreshold-1; i++ {
+			e.probeShards()
+		}
+
+		checkShardState(t, e, id[0], errThreshold, shard.ModeReadOnly)
+
+		e.probeShards()
+
+		checkShardState(t, e, id[0], 0, shard.ModeReadWrite)
+		checkShardState(t, e, id[1], 0, shard.ModeReadWrite)
+	})
+
+	t.Run("mode set explicitly", func(t *testing.T) {
+		for i := 0; i < errThreshold; i++ {
+			e.reportShardError(sh, "test error", errors.New("test error"))
+		}
+
+		require.NoError(t, e.SetShardMode(id[0], shard.ModeReadOnly, false))
+
+		for i := 0; i < successThreshold; i++ {
+			e.probeShards()
+		}
+
+		checkShardState(t, e, id[0], errThreshold, shard.ModeReadOnly)
+	})
+
+	t.Run("evacuated shard", func(t *testing.T) {
+		require.NoError(t, e.SetShardMode(id[0], shard.ModeReadWrite, true))
+
+		for i := 0; i < errThreshold; i++ {
+			e.reportShardError(sh, "test error", errors.New("test error"))
+		}
+
+		_, err := e.Evacuate(new(EvacuateShardPrm).WithShardID(id[0]))
+		require.NoError(t, err)
+
+		for i := 0; i < successThreshold; i++ {
+			e.probeShards()
+		}
+
+		checkShardState(t, e, id[0], errThreshold, shard.ModeReadOnly)
+	})
+}
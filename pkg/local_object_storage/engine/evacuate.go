@@ -0,0 +1,340 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.uber.org/zap"
+)
+
+// evacuationBatchSize is the number of objects listed
+// from the evacuated shard at once.
+const evacuationBatchSize = 1000
+
+var (
+	errMustHaveTwoShards     = errors.New("must have at least 1 spare shard")
+	errEvacuationInProgress  = errors.New("shard evacuation is already in progress")
+	errEvacuationNoPlacement = errors.New("could not put object to any other shard")
+)
+
+// EvacuateShardPrm groups the parameters of Evacuate operation.
+type EvacuateShardPrm struct {
+	ctx          context.Context
+	shardID      *shard.ID
+	ignoreErrors bool
+	handler      func(*addressSDK.Address, *objectSDK.Object) error
+}
+
+// EvacuateShardRes groups resulting values of Evacuate operation.
+type EvacuateShardRes struct {
+	count      uint64
+	replicated uint64
+	failed     uint64
+}
+
+// WithShardID is an Evacuate option to set the identifier of the evacuated shard.
+//
+// Option is required.
+func (p *EvacuateShardPrm) WithShardID(id *shard.ID) *EvacuateShardPrm {
+	if p != nil {
+		p.shardID = id
+	}
+
+	return p
+}
+
+// WithContext is an Evacuate option to set the context of the evacuation.
+// Evacuation is interrupted when the context is done, progress of the
+// interrupted evacuation is saved, so it can be resumed later.
+//
+// Option is optional: evacuation is not interrupted by default.
+func (p *EvacuateShardPrm) WithContext(ctx context.Context) *EvacuateShardPrm {
+	if p != nil {
+		p.ctx = ctx
+	}
+
+	return p
+}
+
+// WithIgnoreErrors is an Evacuate option to skip objects
+// which can not be read or evacuated.
+func (p *EvacuateShardPrm) WithIgnoreErrors(ignore bool) *EvacuateShardPrm {
+	if p != nil {
+		p.ignoreErrors = ignore
+	}
+
+	return p
+}
+
+// WithFaultHandler is an Evacuate option to set the handler of objects
+// which can not be put to any other shard. Handler is expected to save
+// the object outside the local storage (e.g. on other container nodes).
+func (p *EvacuateShardPrm) WithFaultHandler(f func(*addressSDK.Address, *objectSDK.Object) error) *EvacuateShardPrm {
+	if p != nil {
+		p.handler = f
+	}
+
+	return p
+}
+
+// Count returns the number of objects moved to other shards
+// since the beginning of the evacuation.
+func (r *EvacuateShardRes) Count() uint64 {
+	return r.count
+}
+
+// Replicated returns the number of objects passed to the fault
+// handler since the beginning of the evacuation.
+func (r *EvacuateShardRes) Replicated() uint64 {
+	return r.replicated
+}
+
+// Failed returns the number of objects skipped due to errors
+// since the beginning of the evacuation.
+func (r *EvacuateShardRes) Failed() uint64 {
+	return r.failed
+}
+
+// Evacuate moves all objects from the shard to other shards of the storage
+// engine. Shard is switched to "read-only" mode if it is in "read-write" one.
+// Target shards are chosen according to the object placement as in Put.
+// Objects which can not be put to any other shard are passed to the fault
+// handler, if any.
+//
+// Evacuation is resumable: if the previous call was interrupted, the next
+// call for the same shard continues from the last evacuated batch. Counters
+// of the result are accumulated since the beginning of the evacuation.
+// Progress is saved in the metabase of the shard, so evacuation is resumed
+// after the node restart too.
+//
+// Returns an error if executions are blocked (see BlockExecution) or the
+// context is done (see WithContext).
+func (e *StorageEngine) Evacuate(prm *EvacuateShardPrm) (res *EvacuateShardRes, err error) {
+	err = e.execIfNotBlocked(func() error {
+		res, err = e.evacuate(prm)
+		return err
+	})
+
+	return
+}
+
+func (e *StorageEngine) evacuate(prm *EvacuateShardPrm) (*EvacuateShardRes, error) {
+	strID := prm.shardID.String()
+
+	e.mtx.RLock()
+	sh, ok := e.shards[strID]
+	if !ok {
+		e.mtx.RUnlock()
+		return nil, errShardNotFound
+	}
+
+	if len(e.shards) < 2 && prm.handler == nil {
+		e.mtx.RUnlock()
+		return nil, errMustHaveTwoShards
+	}
+	e.mtx.RUnlock()
+
+	if err := e.startEvacuation(strID); err != nil {
+		return nil, err
+	}
+
+	defer e.stopEvacuation(strID)
+
+	ctx := prm.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// mode is set by the evacuation, so it must not be
+	// changed by the shard health prober
+	e.dropProbe(strID)
+
+	if sh.GetMode() == shard.ModeReadWrite {
+		if err := sh.SetMode(shard.ModeReadOnly); err != nil {
+			return nil, fmt.Errorf("could not set shard to read-only mode: %w", err)
+		}
+	}
+
+	progress, err := sh.EvacuationProgress()
+	if err != nil {
+		return nil, fmt.Errorf("could not read evacuation progress: %w", err)
+	} else if progress == nil {
+		progress = new(shard.EvacuationProgress)
+	}
+
+	log := e.log.With(zap.String("shard_id", strID))
+
+	log.Info("started shard evacuation",
+		zap.Uint64("evacuated", progress.Evacuated),
+		zap.Uint64("replicated", progress.Replicated),
+		zap.Uint64("failed", progress.Failed),
+	)
+
+	if !progress.WriteCacheDone {
+		err := sh.IterateWriteCache(new(shard.IterateWriteCachePrm).
+			WithHandler(func(obj *objectSDK.Object) error {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
+				return e.evacuateObject(sh, object.AddressOf(obj), obj, prm, progress)
+			}).
+			WithIgnoreErrors(prm.ignoreErrors))
+		if err == nil {
+			err = ctx.Err()
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("could not evacuate write-cache: %w", err)
+		}
+
+		progress.WriteCacheDone = true
+
+		if err := sh.SetEvacuationProgress(progress); err != nil {
+			return nil, fmt.Errorf("could not save evacuation progress: %w", err)
+		}
+	}
+
+	listPrm := new(shard.ListWithCursorPrm).WithCount(evacuationBatchSize)
+
+	for {
+		// progress is saved after every batch,
+		// so the evacuation is interrupted between them
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("shard evacuation is interrupted: %w", err)
+		}
+
+		listRes, err := sh.ListWithCursor(listPrm.WithCursor(progress.Cursor))
+		if err != nil {
+			if errors.Is(err, shard.ErrEndOfListing) {
+				break
+			}
+
+			return nil, fmt.Errorf("could not list objects: %w", err)
+		}
+
+		for _, addr := range listRes.AddressList() {
+			getRes, err := sh.Get(new(shard.GetPrm).WithAddress(addr))
+			if err != nil {
+				if prm.ignoreErrors {
+					progress.Failed++
+					continue
+				}
+
+				return nil, fmt.Errorf("could not get object %s: %w", addr, err)
+			}
+
+			if err := e.evacuateObject(sh, addr, getRes.Object(), prm, progress); err != nil {
+				return nil, err
+			}
+		}
+
+		progress.Cursor = listRes.Cursor()
+
+		if err := sh.SetEvacuationProgress(progress); err != nil {
+			return nil, fmt.Errorf("could not save evacuation progress: %w", err)
+		}
+
+		log.Info("shard evacuation progress",
+			zap.Uint64("evacuated", progress.Evacuated),
+			zap.Uint64("replicated", progress.Replicated),
+			zap.Uint64("failed", progress.Failed),
+		)
+	}
+
+	// the next evacuation starts from the beginning
+	if err := sh.SetEvacuationProgress(nil); err != nil {
+		return nil, fmt.Errorf("could not remove evacuation progress: %w", err)
+	}
+
+	res := &EvacuateShardRes{
+		count:      progress.Evacuated,
+		replicated: progress.Replicated,
+		failed:     progress.Failed,
+	}
+
+	log.Info("finished shard evacuation",
+		zap.Uint64("evacuated", res.count),
+		zap.Uint64("replicated", res.replicated),
+		zap.Uint64("failed", res.failed),
+	)
+
+	return res, nil
+}
+
+// evacuateObject puts the object to the first suitable shard other than sh
+// or passes it to the fault handler. Returns an error only if the object
+// could not be evacuated and errors are not ignored.
+func (e *StorageEngine) evacuateObject(sh shardWrapper, addr *addressSDK.Address, obj *objectSDK.Object,
+	prm *EvacuateShardPrm, progress *shard.EvacuationProgress) error {
+	for i, target := range e.sortShardsByWeight(addr) {
+		if target.ID().String() == sh.ID().String() || target.GetMode() != shard.ModeReadWrite {
+			continue
+		}
+
+		e.mtx.RLock()
+		pool, ok := e.shardPools[target.ID().String()]
+		e.mtx.RUnlock()
+
+		if !ok {
+			continue
+		}
+
+		putDone, exists := e.putToShard(target, i, pool, addr, obj)
+		if putDone || exists {
+			progress.Evacuated++
+			return nil
+		}
+	}
+
+	err := errEvacuationNoPlacement
+
+	if prm.handler != nil {
+		err = prm.handler(addr, obj)
+		if err == nil {
+			progress.Replicated++
+			return nil
+		}
+	}
+
+	if prm.ignoreErrors {
+		e.log.Warn("could not evacuate object",
+			zap.Stringer("shard_id", sh.ID()),
+			zap.Stringer("address", addr),
+			zap.String("error", err.Error()),
+		)
+
+		progress.Failed++
+
+		return nil
+	}
+
+	return fmt.Errorf("could not evacuate object %s: %w", addr, err)
+}
+
+// startEvacuation marks the shard evacuation as running. Returns an error
+// if the shard is already being evacuated.
+func (e *StorageEngine) startEvacuation(id string) error {
+	e.evacuations.mtx.Lock()
+	defer e.evacuations.mtx.Unlock()
+
+	if _, ok := e.evacuations.m[id]; ok {
+		return errEvacuationInProgress
+	}
+
+	e.evacuations.m[id] = struct{}{}
+
+	return nil
+}
+
+// stopEvacuation marks the shard evacuation as not running.
+func (e *StorageEngine) stopEvacuation(id string) {
+	e.evacuations.mtx.Lock()
+	delete(e.evacuations.m, id)
+	e.evacuations.mtx.Unlock()
+}
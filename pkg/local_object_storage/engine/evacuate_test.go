@@ -0,0 +1,135 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"github.com/stretchr/testify/require"
+)
+
+func newEngineEvacuate(t *testing.T, shardNum int, objPerShard int) (*StorageEngine, []*shard.ID, []*objectSDK.Object) {
+	e := testNewEngineWithShardNum(t, shardNum)
+	t.Cleanup(func() {
+		_ = e.Close()
+		_ = os.RemoveAll(t.Name())
+	})
+
+	ids := make([]*shard.ID, 0, shardNum)
+	for _, sh := range e.shards {
+		ids = append(ids, sh.ID())
+	}
+
+	objects := make([]*objectSDK.Object, 0, objPerShard*shardNum)
+	for i := 0; i < objPerShard*shardNum; i++ {
+		obj := generateObjectWithCID(t, cidtest.ID())
+		require.NoError(t, Put(e, obj))
+
+		objects = append(objects, obj)
+	}
+
+	return e, ids, objects
+}
+
+func TestEvacuateShard(t *testing.T) {
+	e, ids, objects := newEngineEvacuate(t, 3, 10)
+
+	res, err := e.Evacuate(new(EvacuateShardPrm).WithShardID(ids[0]))
+	require.NoError(t, err)
+	require.NotZero(t, res.Count())
+	require.Zero(t, res.Replicated())
+	require.Zero(t, res.Failed())
+	require.Equal(t, shard.ModeReadOnly, e.shards[ids[0].String()].GetMode())
+
+	require.NoError(t, e.DetachShards([]*shard.ID{ids[0]}))
+
+	for i := range objects {
+		_, err := Get(e, object.AddressOf(objects[i]))
+		require.NoError(t, err)
+	}
+}
+
+func TestEvacuateShard_Context(t *testing.T) {
+	e, ids, objects := newEngineEvacuate(t, 3, 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := e.Evacuate(new(EvacuateShardPrm).WithShardID(ids[0]).WithContext(ctx))
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, shard.ModeReadOnly, e.shards[ids[0].String()].GetMode())
+
+	// interrupted evacuation is resumed
+	res, err := e.Evacuate(new(EvacuateShardPrm).WithShardID(ids[0]))
+	require.NoError(t, err)
+	require.NotZero(t, res.Count())
+
+	require.NoError(t, e.DetachShards([]*shard.ID{ids[0]}))
+
+	for i := range objects {
+		_, err := Get(e, object.AddressOf(objects[i]))
+		require.NoError(t, err)
+	}
+}
+
+func TestEvacuateShard_FaultHandler(t *testing.T) {
+	e, ids, objects := newEngineEvacuate(t, 1, 10)
+
+	_, err := e.Evacuate(new(EvacuateShardPrm).WithShardID(ids[0]))
+	require.ErrorIs(t, err, errMustHaveTwoShards)
+
+	errReplication := errors.New("replication failure")
+	replicated := make(map[string]struct{})
+	calls := 0
+
+	prm := new(EvacuateShardPrm).WithShardID(ids[0]).
+		WithFaultHandler(func(addr *addressSDK.Address, _ *objectSDK.Object) error {
+			calls++
+			if calls == 3 {
+				return errReplication
+			}
+
+			replicated[addr.String()] = struct{}{}
+			return nil
+		})
+
+	_, err = e.Evacuate(prm)
+	require.ErrorIs(t, err, errReplication)
+
+	// progress is saved in the metabase of the shard
+	progress, err := e.shards[ids[0].String()].EvacuationProgress()
+	require.NoError(t, err)
+	require.NotNil(t, progress)
+	require.True(t, progress.WriteCacheDone)
+
+	// evacuation is resumed after the failure
+	res, err := e.Evacuate(prm)
+	require.NoError(t, err)
+	require.Zero(t, res.Count())
+	require.GreaterOrEqual(t, res.Replicated(), uint64(len(objects)))
+	require.Len(t, replicated, len(objects))
+
+	for i := range objects {
+		require.Contains(t, replicated, object.AddressOf(objects[i]).String())
+	}
+
+	progress, err = e.shards[ids[0].String()].EvacuationProgress()
+	require.NoError(t, err)
+	require.Nil(t, progress)
+
+	t.Run("ignore errors", func(t *testing.T) {
+		res, err := e.Evacuate(new(EvacuateShardPrm).WithShardID(ids[0]).
+			WithIgnoreErrors(true).
+			WithFaultHandler(func(*addressSDK.Address, *objectSDK.Object) error {
+				return errReplication
+			}))
+		require.NoError(t, err)
+		require.Equal(t, uint64(len(objects)), res.Failed())
+	})
+}
@@ -0,0 +1,19 @@
+package engine
+
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+)
+
+// GCStats returns the statistics of the garbage collector
+// of the shard with provided identifier.
+func (e *StorageEngine) GCStats(id *shard.ID) (*shard.GCStats, error) {
+	e.mtx.RLock()
+	sh, ok := e.shards[id.String()]
+	e.mtx.RUnlock()
+
+	if !ok {
+		return nil, errShardNotFound
+	}
+
+	return sh.GCStats()
+}
@@ -60,6 +60,14 @@ func (e *StorageEngine) get(prm *GetPrm) (*GetRes, error) {
 		defer elapsed(e.metrics.AddGetDuration)()
 	}
 
+	if obj, ok := e.readCache.get(prm.addr, true); ok {
+		return &GetRes{
+			obj: obj,
+		}, nil
+	}
+
+	gen := e.readCache.generation()
+
 	var (
 		obj   *objectSDK.Object
 		siErr *objectSDK.SplitInfoError
@@ -136,12 +144,16 @@ func (e *StorageEngine) get(prm *GetPrm) (*GetRes, error) {
 			return err == nil
 		})
 		if obj == nil {
+			e.reportUnreadableObject(prm.addr)
+
 			return nil, outError
 		}
 		e.reportShardError(shardWithMeta, "meta info was present, but object is missing",
 			metaError, zap.Stringer("address", prm.addr))
 	}
 
+	e.readCache.add(obj, true, gen)
+
 	return &GetRes{
 		obj: obj,
 	}, nil
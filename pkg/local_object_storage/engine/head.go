@@ -58,6 +58,9 @@ func (r *HeadRes) Header() *objectSDK.Object {
 // Returns an error of type apistatus.ObjectNotFound if requested object is missing in local storage.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if requested object was inhumed.
 //
+// Object which is present in the shard, but can not be read from
+// any shard, is passed to the unreadable object handler.
+//
 // Returns an error if executions are blocked (see BlockExecution).
 func (e *StorageEngine) Head(prm *HeadPrm) (res *HeadRes, err error) {
 	err = e.execIfNotBlocked(func() error {
@@ -73,6 +76,14 @@ func (e *StorageEngine) head(prm *HeadPrm) (*HeadRes, error) {
 		defer elapsed(e.metrics.AddHeadDuration)()
 	}
 
+	if head, ok := e.readCache.get(prm.addr, false); ok {
+		return &HeadRes{
+			head: head,
+		}, nil
+	}
+
+	gen := e.readCache.generation()
+
 	var (
 		head  *objectSDK.Object
 		siErr *objectSDK.SplitInfoError
@@ -81,6 +92,9 @@ func (e *StorageEngine) head(prm *HeadPrm) (*HeadRes, error) {
 
 		outSI    *objectSDK.SplitInfo
 		outError error = errNotFound
+
+		// object is present in the shard, but can not be read
+		readFailed bool
 	)
 
 	shPrm := new(shard.HeadPrm).
@@ -114,6 +128,7 @@ func (e *StorageEngine) head(prm *HeadPrm) (*HeadRes, error) {
 				return true // stop, return it back
 			default:
 				e.reportShardError(sh, "could not head object from shard", err)
+				readFailed = true
 				return false
 			}
 		}
@@ -128,9 +143,18 @@ func (e *StorageEngine) head(prm *HeadPrm) (*HeadRes, error) {
 	}
 
 	if head == nil {
+		if readFailed && shard.IsErrNotFound(outError) {
+			e.reportUnreadableObject(prm.addr)
+		}
+
 		return nil, outError
 	}
 
+	// header of the virtual object is returned in non-raw mode
+	if prm.raw {
+		e.readCache.add(head, false, gen)
+	}
+
 	return &HeadRes{
 		head: head,
 	}, nil
@@ -70,6 +70,8 @@ func (e *StorageEngine) inhume(prm *InhumePrm) (*InhumeRes, error) {
 
 	shPrm := new(shard.InhumePrm)
 
+	defer e.readCache.remove(prm.addrs...)
+
 	for i := range prm.addrs {
 		if prm.tombstone != nil {
 			shPrm.WithTarget(prm.tombstone, prm.addrs[i])
@@ -173,6 +175,9 @@ func (e *StorageEngine) processExpiredTombstones(ctx context.Context, addrs []*a
 }
 
 func (e *StorageEngine) processExpiredLocks(ctx context.Context, lockers []*addressSDK.Address) {
+	// objects locked by the lockers are unknown to the engine
+	defer e.readCache.purge()
+
 	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
 		sh.HandleExpiredLocks(lockers)
 
@@ -29,6 +29,16 @@ func (e *StorageEngine) lock(idCnr cid.ID, locker oid.ID, locked []oid.ID) error
 	var addr address.Address
 	addr.SetContainerID(&idCnr)
 
+	addrs := make([]*address.Address, len(locked))
+
+	for i := range locked {
+		addrs[i] = address.NewAddress()
+		addrs[i].SetContainerID(&idCnr)
+		addrs[i].SetObjectID(&locked[i])
+	}
+
+	defer e.readCache.remove(addrs...)
+
 	for i := range locked {
 		switch e.lockSingle(idCnr, locker, locked[i], true) {
 		case 1:
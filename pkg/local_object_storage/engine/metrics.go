@@ -16,6 +16,12 @@ type MetricRegister interface {
 	AddRangeDuration(d time.Duration)
 	AddSearchDuration(d time.Duration)
 	AddListObjectsDuration(d time.Duration)
+
+	SetShardErrorCount(shardID string, count uint32)
+
+	IncReadCacheHits()
+	IncReadCacheMisses()
+	SetReadCacheSize(size uint64)
 }
 
 func elapsed(addFunc func(d time.Duration)) func() {
@@ -0,0 +1,141 @@
+package engine
+
+import (
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"go.uber.org/zap"
+)
+
+// scheduleProbe adds the shard to the set of shards
+// which are periodically probed by the shard health prober.
+func (e *StorageEngine) scheduleProbe(id string) {
+	e.probes.mtx.Lock()
+	e.probes.m[id] = 0
+	e.probes.mtx.Unlock()
+}
+
+// dropProbe removes the shard from the set of probed shards.
+func (e *StorageEngine) dropProbe(id string) {
+	e.probes.mtx.Lock()
+	delete(e.probes.m, id)
+	e.probes.mtx.Unlock()
+}
+
+// startProbeLoop starts the shard health prober if it is enabled.
+func (e *StorageEngine) startProbeLoop() {
+	if e.probeInterval <= 0 {
+		return
+	}
+
+	go e.probeLoop()
+}
+
+// stopProbeLoop stops the shard health prober. Can be called multiple times.
+func (e *StorageEngine) stopProbeLoop() {
+	e.probes.stopOnce.Do(func() {
+		close(e.probes.stop)
+	})
+}
+
+func (e *StorageEngine) probeLoop() {
+	t := time.NewTicker(e.probeInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-e.probes.stop:
+			return
+		case <-t.C:
+			_ = e.execIfNotBlocked(func() error {
+				e.probeShards()
+				return nil
+			})
+		}
+	}
+}
+
+// probeShards probes all shards which were moved to read-only mode due to
+// the error threshold. Shard is moved back to read-write mode and its error
+// counter is reset after the configured number of successful probes in a row.
+func (e *StorageEngine) probeShards() {
+	e.probes.mtx.Lock()
+	ids := make([]string, 0, len(e.probes.m))
+	for id := range e.probes.m {
+		ids = append(ids, id)
+	}
+	e.probes.mtx.Unlock()
+
+	for _, id := range ids {
+		e.mtx.RLock()
+		sh, ok := e.shards[id]
+		e.mtx.RUnlock()
+
+		// shard was detached or its mode was changed by other means
+		if !ok || sh.GetMode() != shard.ModeReadOnly {
+			e.dropProbe(id)
+			continue
+		}
+
+		if err := sh.Probe(); err != nil {
+			e.probes.mtx.Lock()
+			if _, ok := e.probes.m[id]; ok {
+				e.probes.m[id] = 0
+			}
+			e.probes.mtx.Unlock()
+
+			e.log.Warn("shard probe failed",
+				zap.String("shard_id", id),
+				zap.String("error", err.Error()),
+			)
+
+			continue
+		}
+
+		e.probes.mtx.Lock()
+		succeeded, ok := e.probes.m[id]
+		if ok {
+			succeeded++
+			e.probes.m[id] = succeeded
+		}
+		e.probes.mtx.Unlock()
+
+		if !ok || succeeded < e.probeSuccessThreshold {
+			continue
+		}
+
+		e.restoreShard(id, sh)
+	}
+}
+
+// restoreShard resets the error counter of the shard and moves it to read-write mode.
+func (e *StorageEngine) restoreShard(id string, sh shardWrapper) {
+	e.dropProbe(id)
+
+	errCount := sh.errorCount.Swap(0)
+	e.reportShardErrorCount(id, 0)
+
+	if err := sh.SetMode(shard.ModeReadWrite); err != nil {
+		e.log.Error("failed to move shard in read-write mode after successful probes",
+			zap.String("shard_id", id),
+			zap.String("error", err.Error()),
+		)
+
+		e.scheduleProbe(id)
+
+		return
+	}
+
+	e.log.Info("shard is moved in read-write mode after successful probes",
+		zap.String("shard_id", id),
+		zap.Uint32("error count", errCount),
+		zap.Uint32("probes", e.probeSuccessThreshold),
+	)
+}
+
+// reportShardErrorCount updates the shard error counter metric if metrics are enabled.
+func (e *StorageEngine) reportShardErrorCount(id string, count uint32) {
+	if e.metrics != nil {
+		e.metrics.SetShardErrorCount(id, count)
+	}
+}
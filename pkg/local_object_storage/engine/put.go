@@ -5,7 +5,9 @@ import (
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 	"go.uber.org/zap"
 )
 
@@ -61,73 +63,94 @@ func (e *StorageEngine) put(prm *PutPrm) (*PutRes, error) {
 		return nil, err
 	}
 
-	existPrm := new(shard.ExistsPrm)
-	existPrm.WithAddress(addr)
-
 	finished := false
 
 	e.iterateOverSortedShards(addr, func(ind int, sh hashedShard) (stop bool) {
 		e.mtx.RLock()
-		pool := e.shardPools[sh.ID().String()]
+		pool, ok := e.shardPools[sh.ID().String()]
 		e.mtx.RUnlock()
 
-		exitCh := make(chan struct{})
+		if !ok {
+			// shard was detached concurrently
+			return false
+		}
 
-		if err := pool.Submit(func() {
-			defer close(exitCh)
+		putDone, exists := e.putToShard(sh, ind, pool, addr, prm.obj)
+		finished = putDone || exists
 
-			exists, err := sh.Exists(existPrm)
-			if err != nil {
-				return // this is not ErrAlreadyRemoved error so we can go to the next shard
-			}
+		return finished
+	})
 
-			if exists.Exists() {
-				if ind != 0 {
-					toMoveItPrm := new(shard.ToMoveItPrm)
-					toMoveItPrm.WithAddress(addr)
-
-					_, err = sh.ToMoveIt(toMoveItPrm)
-					if err != nil {
-						e.log.Warn("could not mark object for shard relocation",
-							zap.Stringer("shard", sh.ID()),
-							zap.String("error", err.Error()),
-						)
-					}
-				}
+	if !finished {
+		err = errPutShard
+	}
 
-				finished = true
+	return nil, err
+}
 
-				return
-			}
+// putToShard puts object to sh.
+// First return value is true iff put has been successfully done.
+// Second return value is true iff object already exists.
+func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool, addr *addressSDK.Address, obj *objectSDK.Object) (bool, bool) {
+	var putSuccess, alreadyExists bool
+
+	exitCh := make(chan struct{})
 
-			putPrm := new(shard.PutPrm)
-			putPrm.WithObject(prm.obj)
+	if err := pool.Submit(func() {
+		defer close(exitCh)
 
-			_, err = sh.Put(putPrm)
-			if err != nil {
-				e.log.Warn("could not put object in shard",
-					zap.Stringer("shard", sh.ID()),
-					zap.String("error", err.Error()),
-				)
+		existPrm := new(shard.ExistsPrm)
+		existPrm.WithAddress(addr)
 
-				return
+		exists, err := sh.Exists(existPrm)
+		if err != nil {
+			return // this is not ErrAlreadyRemoved error so we can go to the next shard
+		}
+
+		if exists.Exists() {
+			if ind != 0 {
+				toMoveItPrm := new(shard.ToMoveItPrm)
+				toMoveItPrm.WithAddress(addr)
+
+				_, err = sh.ToMoveIt(toMoveItPrm)
+				if err != nil {
+					e.log.Warn("could not mark object for shard relocation",
+						zap.Stringer("shard", sh.ID()),
+						zap.String("error", err.Error()),
+					)
+				}
 			}
 
-			finished = true
-		}); err != nil {
-			close(exitCh)
+			alreadyExists = true
+
+			return
 		}
 
-		<-exitCh
+		if sh.IsFull() {
+			return // full shard does not accept new objects
+		}
 
-		return finished
-	})
+		putPrm := new(shard.PutPrm)
+		putPrm.WithObject(obj)
 
-	if !finished {
-		err = errPutShard
+		_, err = sh.Put(putPrm)
+		if err != nil {
+			e.log.Warn("could not put object in shard",
+				zap.Stringer("shard", sh.ID()),
+				zap.String("error", err.Error()),
+			)
+
+			return
+		}
+
+		putSuccess = true
+	}); err != nil {
+		close(exitCh)
 	}
 
-	return nil, err
+	<-exitCh
+
+	return putSuccess, alreadyExists
 }
 
 // Put writes provided object to local storage.
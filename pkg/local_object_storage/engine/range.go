@@ -161,6 +161,10 @@ func (e *StorageEngine) getRange(prm *RngPrm) (*RngRes, error) {
 			return err == nil
 		})
 		if obj == nil {
+			if shard.IsErrNotFound(outError) {
+				e.reportUnreadableObject(prm.addr)
+			}
+
 			return nil, outError
 		}
 		e.reportShardError(shardWithMeta, "meta info was present, but object is missing",
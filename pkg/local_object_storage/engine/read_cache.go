@@ -0,0 +1,197 @@
+package engine
+
+import (
+	"sync"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/internal/sizecache"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+)
+
+// readCache is an LRU cache of the objects read from the shards. Total size
+// of the cached objects is limited. Methods of nil cache do nothing.
+//
+// Objects with expiration epoch are not cached since they are removed by
+// the shards without the engine. Other objects are dropped from the cache
+// when they are inhumed, deleted or locked.
+type readCache struct {
+	mtx sync.Mutex
+
+	maxObjectSize uint64
+
+	// incremented on each invalidation, objects read
+	// before the invalidation are not cached
+	gen uint64
+
+	objects *sizecache.Cache
+
+	metrics MetricRegister
+}
+
+type readCacheEntry struct {
+	obj *objectSDK.Object
+
+	// false if only the header is cached
+	full bool
+}
+
+// newReadCache returns nil if capacity is zero.
+func newReadCache(capacity, maxObjectSize uint64, m MetricRegister) *readCache {
+	if capacity == 0 {
+		return nil
+	}
+
+	return &readCache{
+		maxObjectSize: maxObjectSize,
+		objects:       sizecache.New(capacity),
+		metrics:       m,
+	}
+}
+
+// get returns the cached object. If full is true, only the objects with
+// payload are returned, otherwise the header of the object is returned.
+//
+// Returned object must not be modified.
+func (c *readCache) get(addr *addressSDK.Address, full bool) (*objectSDK.Object, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mtx.Lock()
+	v, ok := c.objects.Get(addr.String())
+	c.mtx.Unlock()
+
+	var obj *objectSDK.Object
+
+	if ok {
+		entry := v.(*readCacheEntry)
+
+		switch {
+		case full && !entry.full:
+			ok = false
+		case full:
+			obj = entry.obj
+		default:
+			obj = entry.obj.CutPayload()
+		}
+	}
+
+	if c.metrics != nil {
+		if ok {
+			c.metrics.IncReadCacheHits()
+		} else {
+			c.metrics.IncReadCacheMisses()
+		}
+	}
+
+	return obj, ok
+}
+
+// generation returns the current generation of the cache
+// which must be passed to add.
+func (c *readCache) generation() uint64 {
+	if c == nil {
+		return 0
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.gen
+}
+
+// add caches the object read at the generation gen. The object is not
+// cached if the cache has been invalidated since then. Full object
+// is not replaced by its header.
+//
+// Object must not be modified after the call.
+func (c *readCache) add(obj *objectSDK.Object, full bool, gen uint64) {
+	if c == nil || !cacheable(obj) {
+		return
+	}
+
+	size := uint64(obj.ToV2().GetHeader().StableSize())
+	if full {
+		size += uint64(len(obj.Payload()))
+	}
+
+	if size > c.maxObjectSize {
+		return
+	}
+
+	key := object.AddressOf(obj).String()
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.gen != gen {
+		return
+	}
+
+	if v, ok := c.objects.Peek(key); ok && v.(*readCacheEntry).full && !full {
+		return
+	}
+
+	c.objects.Add(key, &readCacheEntry{
+		obj:  obj,
+		full: full,
+	}, size)
+
+	c.reportSize()
+}
+
+// remove drops the objects from the cache.
+func (c *readCache) remove(addrs ...*addressSDK.Address) {
+	if c == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.gen++
+
+	for i := range addrs {
+		c.objects.Remove(addrs[i].String())
+	}
+
+	c.reportSize()
+}
+
+// purge drops all objects from the cache.
+func (c *readCache) purge() {
+	if c == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.gen++
+	c.objects.Purge()
+
+	c.reportSize()
+}
+
+func (c *readCache) reportSize() {
+	if c.metrics != nil {
+		c.metrics.SetReadCacheSize(c.objects.Size())
+	}
+}
+
+// cacheable checks if the object can be cached.
+func cacheable(obj *objectSDK.Object) bool {
+	if obj.Type() != objectSDK.TypeRegular {
+		return false
+	}
+
+	for _, a := range obj.Attributes() {
+		if a.Key() == objectV2.SysAttributeExpEpoch {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,133 @@
+package engine
+
+import (
+	"testing"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCache(t *testing.T) {
+	obj := generateObjectWithCID(t, cidtest.ID())
+	addr := object.AddressOf(obj)
+
+	objSize := uint64(obj.ToV2().GetHeader().StableSize() + len(obj.Payload()))
+
+	t.Run("header", func(t *testing.T) {
+		c := newReadCache(objSize, objSize, nil)
+
+		c.add(obj.CutPayload(), false, c.generation())
+
+		_, ok := c.get(addr, true)
+		require.False(t, ok)
+
+		head, ok := c.get(addr, false)
+		require.True(t, ok)
+		require.Equal(t, obj.CutPayload(), head)
+
+		c.add(obj, true, c.generation())
+
+		// full object is not replaced by the header
+		c.add(obj.CutPayload(), false, c.generation())
+
+		res, ok := c.get(addr, true)
+		require.True(t, ok)
+		require.Equal(t, obj, res)
+	})
+
+	t.Run("capacity", func(t *testing.T) {
+		c := newReadCache(objSize, objSize, nil)
+
+		c.add(obj, true, c.generation())
+		require.Equal(t, objSize, c.objects.Size())
+
+		other := generateObjectWithCID(t, cidtest.ID())
+		c.add(other, true, c.generation())
+		require.Equal(t, objSize, c.objects.Size())
+
+		_, ok := c.get(addr, true)
+		require.False(t, ok)
+
+		c = newReadCache(2*objSize, objSize-1, nil)
+		c.add(obj, true, c.generation())
+
+		_, ok = c.get(addr, true)
+		require.False(t, ok)
+	})
+
+	t.Run("invalidation", func(t *testing.T) {
+		c := newReadCache(objSize, objSize, nil)
+
+		gen := c.generation()
+		c.add(obj, true, gen)
+		c.remove(addr)
+		require.Zero(t, c.objects.Size())
+
+		// object read before the invalidation
+		c.add(obj, true, gen)
+
+		_, ok := c.get(addr, true)
+		require.False(t, ok)
+	})
+
+	t.Run("expiring object", func(t *testing.T) {
+		c := newReadCache(objSize*2, objSize*2, nil)
+
+		obj := generateObjectWithCID(t, cidtest.ID())
+		addAttribute(obj, objectV2.SysAttributeExpEpoch, "10")
+
+		c.add(obj, true, c.generation())
+
+		_, ok := c.get(object.AddressOf(obj), true)
+		require.False(t, ok)
+	})
+}
+
+func TestStorageEngine_ReadCache(t *testing.T) {
+	e, _, _ := newEngineWithErrorThreshold(t, "", 0, WithReadCacheCapacity(1<<20))
+
+	obj := generateObjectWithCID(t, cidtest.ID())
+	addr := object.AddressOf(obj)
+
+	require.NoError(t, Put(e, obj))
+
+	_, ok := e.readCache.get(addr, false)
+	require.False(t, ok)
+
+	_, err := Head(e, addr)
+	require.NoError(t, err)
+
+	// header of the virtual object can be returned in non-raw mode
+	_, ok = e.readCache.get(addr, false)
+	require.False(t, ok)
+
+	head, err := HeadRaw(e, addr, true)
+	require.NoError(t, err)
+
+	cached, ok := e.readCache.get(addr, false)
+	require.True(t, ok)
+	require.Equal(t, head, cached)
+
+	res, err := Get(e, addr)
+	require.NoError(t, err)
+
+	cached, ok = e.readCache.get(addr, true)
+	require.True(t, ok)
+	require.Equal(t, res, cached)
+
+	res, err = Get(e, addr)
+	require.NoError(t, err)
+	require.Equal(t, obj, res)
+
+	_, err = e.Inhume(new(InhumePrm).WithTarget(object.AddressOf(generateObjectWithCID(t, cidtest.ID())), addr))
+	require.NoError(t, err)
+
+	_, ok = e.readCache.get(addr, false)
+	require.False(t, ok)
+
+	_, err = Get(e, addr)
+	require.True(t, shard.IsErrRemoved(err), err)
+}
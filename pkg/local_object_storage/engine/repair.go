@@ -0,0 +1,134 @@
+package engine
+
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.uber.org/zap"
+)
+
+// UnreadableObjectHandler is a handler of the objects which are present
+// in the metabase of some shard, but can not be read from any shard.
+type UnreadableObjectHandler func(*addressSDK.Address)
+
+// RepairPrm groups the parameters of Repair operation.
+type RepairPrm struct {
+	obj *objectSDK.Object
+}
+
+// RepairRes groups resulting values of Repair operation.
+type RepairRes struct{}
+
+// WithObject is a Repair option to set the copy of the unreadable object.
+//
+// Option is required.
+func (p *RepairPrm) WithObject(obj *objectSDK.Object) *RepairPrm {
+	if p != nil {
+		p.obj = obj
+	}
+
+	return p
+}
+
+// Repair saves the copy of the object which can not be read from
+// the local storage. Unlike Put, the object is saved regardless of
+// its presence in the metabase of the shards: it is written to the
+// first shard which does not contain the object. Does nothing if
+// the object can be read from any shard.
+//
+// Returns an error of type apistatus.ObjectAlreadyRemoved if object has been marked as removed.
+//
+// Returns an error if executions are blocked (see BlockExecution).
+func (e *StorageEngine) Repair(prm *RepairPrm) (res *RepairRes, err error) {
+	err = e.execIfNotBlocked(func() error {
+		res, err = e.repair(prm)
+		return err
+	})
+
+	return
+}
+
+func (e *StorageEngine) repair(prm *RepairPrm) (*RepairRes, error) {
+	addr := object.AddressOf(prm.obj)
+
+	_, err := e.exists(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		finished bool
+
+		existsPrm = new(shard.ExistsPrm).WithAddress(addr)
+		getPrm    = new(shard.GetPrm).WithAddress(addr)
+
+		// shards which contain the object
+		holders = make(map[string]struct{})
+	)
+
+	e.iterateOverSortedShards(addr, func(_ int, sh hashedShard) (stop bool) {
+		res, err := sh.Exists(existsPrm)
+		if err != nil || !res.Exists() {
+			return false
+		}
+
+		holders[sh.ID().String()] = struct{}{}
+
+		// the object may have been repaired concurrently
+		_, err = sh.Get(getPrm)
+		finished = err == nil
+
+		return finished
+	})
+
+	if finished {
+		return new(RepairRes), nil
+	}
+
+	e.iterateOverSortedShards(addr, func(_ int, sh hashedShard) (stop bool) {
+		if _, ok := holders[sh.ID().String()]; ok {
+			return false
+		}
+
+		_, err := sh.Put(new(shard.PutPrm).WithObject(prm.obj))
+		if err != nil {
+			e.log.Warn("could not put repaired object in shard",
+				zap.Stringer("shard", sh.ID()),
+				zap.Stringer("address", addr),
+				zap.String("error", err.Error()),
+			)
+
+			return false
+		}
+
+		e.log.Info("unreadable object has been repaired",
+			zap.Stringer("shard", sh.ID()),
+			zap.Stringer("address", addr),
+		)
+
+		finished = true
+
+		return true
+	})
+
+	if !finished {
+		return nil, errPutShard
+	}
+
+	return new(RepairRes), nil
+}
+
+// reportUnreadableObject passes the object which can not
+// be read from any shard to the handler if it is set.
+func (e *StorageEngine) reportUnreadableObject(addr *addressSDK.Address) {
+	if e.unreadableHandler == nil {
+		return
+	}
+
+	e.log.Debug("object is present in local storage, but can not be read from any shard",
+		zap.Stringer("address", addr),
+	)
+
+	e.unreadableHandler(addr)
+}
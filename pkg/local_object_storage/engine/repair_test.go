@@ -0,0 +1,57 @@
+package engine
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageEngine_Repair(t *testing.T) {
+	var unreadable []*addressSDK.Address
+
+	e, dir, id := newEngineWithErrorThreshold(t, "", 0,
+		WithUnreadableObjectHandler(func(addr *addressSDK.Address) {
+			unreadable = append(unreadable, addr)
+		}))
+
+	obj := generateObjectWithCID(t, cidtest.ID())
+	obj.SetPayload(make([]byte, errSmallSize))
+
+	addr := object.AddressOf(obj)
+
+	e.mtx.RLock()
+	_, err := e.shards[id[0].String()].Put(new(shard.PutPrm).WithObject(obj))
+	e.mtx.RUnlock()
+	require.NoError(t, err)
+
+	// readable objects are not repaired
+	_, err = e.Repair(new(RepairPrm).WithObject(obj))
+	require.NoError(t, err)
+
+	_, err = e.Get(new(GetPrm).WithAddress(addr))
+	require.NoError(t, err)
+	require.Empty(t, unreadable)
+
+	corruptSubDir(t, filepath.Join(dir, "0"))
+
+	_, err = e.Get(new(GetPrm).WithAddress(addr))
+	require.True(t, shard.IsErrNotFound(err), err)
+	require.Equal(t, []*addressSDK.Address{addr}, unreadable)
+
+	_, err = e.Repair(new(RepairPrm).WithObject(obj))
+	require.NoError(t, err)
+
+	res, err := e.Get(new(GetPrm).WithAddress(addr))
+	require.NoError(t, err)
+	require.Equal(t, obj, res.Object())
+
+	e.mtx.RLock()
+	_, err = e.shards[id[1].String()].Get(new(shard.GetPrm).WithAddress(addr))
+	e.mtx.RUnlock()
+	require.NoError(t, err)
+}
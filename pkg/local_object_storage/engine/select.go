@@ -2,23 +2,29 @@ package engine
 
 import (
 	"errors"
+	"sort"
 
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
 // SelectPrm groups the parameters of Select operation.
 type SelectPrm struct {
 	cid     *cid.ID
 	filters object.SearchFilters
+
+	count  uint32
+	cursor *oidSDK.ID
 }
 
 // SelectRes groups resulting values of Select operation.
 type SelectRes struct {
 	addrList []*addressSDK.Address
+	cursor   *oidSDK.ID
 }
 
 // WithContainerID is a Select option to set the container id to search in.
@@ -39,11 +45,37 @@ func (p *SelectPrm) WithFilters(fs object.SearchFilters) *SelectPrm {
 	return p
 }
 
+// WithCount is a Select option to set the maximum number of the selected
+// objects. See meta.SelectPrm.WithCount for details.
+func (p *SelectPrm) WithCount(count uint32) *SelectPrm {
+	if p != nil {
+		p.count = count
+	}
+
+	return p
+}
+
+// WithCursor is a Select option to continue selection after the object with
+// the given identifier. See meta.SelectPrm.WithCursor for details.
+func (p *SelectPrm) WithCursor(cursor *oidSDK.ID) *SelectPrm {
+	if p != nil {
+		p.cursor = cursor
+	}
+
+	return p
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r *SelectRes) AddressList() []*addressSDK.Address {
 	return r.addrList
 }
 
+// Cursor returns cursor for consecutive select requests.
+// See meta.SelectRes.Cursor for details.
+func (r *SelectRes) Cursor() *oidSDK.ID {
+	return r.cursor
+}
+
 // Select selects the objects from local storage that match select parameters.
 //
 // Returns any error encountered that did not allow to completely select the objects.
@@ -66,11 +98,16 @@ func (e *StorageEngine) _select(prm *SelectPrm) (*SelectRes, error) {
 	addrList := make([]*addressSDK.Address, 0)
 	uniqueMap := make(map[string]struct{})
 
-	var outError error
+	var (
+		outError error
+		more     bool
+	)
 
 	shPrm := new(shard.SelectPrm).
 		WithContainerID(prm.cid).
-		WithFilters(prm.filters)
+		WithFilters(prm.filters).
+		WithCount(prm.count).
+		WithCursor(prm.cursor)
 
 	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
 		res, err := sh.Select(shPrm)
@@ -92,14 +129,39 @@ func (e *StorageEngine) _select(prm *SelectPrm) (*SelectRes, error) {
 					addrList = append(addrList, addr)
 				}
 			}
+
+			more = more || res.Cursor() != nil
 		}
 
 		return false
 	})
 
-	return &SelectRes{
+	if prm.count == 0 {
+		return &SelectRes{
+			addrList: addrList,
+		}, outError
+	}
+
+	// every shard returns first objects after the cursor, so
+	// first count objects of the union are the requested ones
+	sort.Slice(addrList, func(i, j int) bool {
+		return addrList[i].ObjectID().String() < addrList[j].ObjectID().String()
+	})
+
+	if len(addrList) > int(prm.count) {
+		addrList = addrList[:prm.count]
+		more = true
+	}
+
+	res := &SelectRes{
 		addrList: addrList,
-	}, outError
+	}
+
+	if more && len(addrList) > 0 {
+		res.cursor = addrList[len(addrList)-1].ObjectID()
+	}
+
+	return res, outError
 }
 
 // List returns `limit` available physically storage object addresses in engine.
@@ -7,13 +7,17 @@ import (
 	"github.com/google/uuid"
 	"github.com/nspcc-dev/hrw"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 	"github.com/panjf2000/ants/v2"
 	"go.uber.org/atomic"
+	"go.uber.org/zap"
 )
 
 var errShardNotFound = errors.New("shard not found")
 
+var errDetachAllShards = errors.New("could not detach all shards of the storage engine")
+
 type hashedShard shardWrapper
 
 // AddShard adds a new shard to the storage engine.
@@ -21,34 +25,113 @@ type hashedShard shardWrapper
 // Returns any error encountered that did not allow adding a shard.
 // Otherwise returns the ID of the added shard.
 func (e *StorageEngine) AddShard(opts ...shard.Option) (*shard.ID, error) {
-	e.mtx.Lock()
-	defer e.mtx.Unlock()
-
-	pool, err := ants.NewPool(int(e.shardPoolSize), ants.WithNonblocking(true))
+	sh, err := e.createShard(opts)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := sh.UpdateID(); err != nil {
+		return nil, fmt.Errorf("could not open shard: %w", err)
+	}
+
+	return e.addShard(sh)
+}
+
+// AttachShard creates a new shard, opens and initializes it
+// and adds it to the running storage engine.
+//
+// Returns any error encountered that did not allow attaching a shard.
+// Otherwise returns the ID of the attached shard.
+func (e *StorageEngine) AttachShard(opts ...shard.Option) (*shard.ID, error) {
+	var id *shard.ID
+
+	err := e.execIfNotBlocked(func() error {
+		sh, err := e.createShard(opts)
+		if err != nil {
+			return err
+		}
+
+		// metabase of the running shard is locked,
+		// so opening it again would block
+		if err := e.checkShardPaths(sh); err != nil {
+			return err
+		}
+
+		if err := sh.UpdateID(); err != nil {
+			return fmt.Errorf("could not open shard: %w", err)
+		}
+
+		if err := sh.Open(); err != nil {
+			return fmt.Errorf("could not open shard: %w", err)
+		}
+
+		if err := sh.Init(); err != nil {
+			_ = sh.Close()
+			return fmt.Errorf("could not initialize shard: %w", err)
+		}
+
+		id, err = e.addShard(sh)
+		if err != nil {
+			_ = sh.Close()
+			return err
+		}
+
+		return nil
+	})
+
+	return id, err
+}
+
+func (e *StorageEngine) createShard(opts []shard.Option) (*shard.Shard, error) {
 	id, err := generateShardID()
 	if err != nil {
 		return nil, fmt.Errorf("could not generate shard ID: %w", err)
 	}
 
-	sh := shard.New(append(opts,
+	return shard.New(append(opts,
 		shard.WithID(id),
 		shard.WithExpiredTombstonesCallback(e.processExpiredTombstones),
 		shard.WithExpiredLocksCallback(e.processExpiredLocks),
-	)...)
+	)...), nil
+}
 
-	if err := sh.UpdateID(); err != nil {
-		return nil, fmt.Errorf("could not open shard: %w", err)
+// checkShardPaths returns an error if any shard of the storage
+// engine uses the same metabase or BLOB storage as sh.
+func (e *StorageEngine) checkShardPaths(sh *shard.Shard) error {
+	info := sh.DumpInfo()
+
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	for id, s := range e.shards {
+		sInfo := s.DumpInfo()
+
+		if sInfo.MetaBaseInfo.Path == info.MetaBaseInfo.Path {
+			return fmt.Errorf("metabase %s is used by shard %s", info.MetaBaseInfo.Path, id)
+		}
+
+		if sInfo.BlobStorInfo.RootPath == info.BlobStorInfo.RootPath {
+			return fmt.Errorf("BLOB storage %s is used by shard %s", info.BlobStorInfo.RootPath, id)
+		}
 	}
 
+	return nil
+}
+
+func (e *StorageEngine) addShard(sh *shard.Shard) (*shard.ID, error) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
 	strID := sh.ID().String()
 	if _, ok := e.shards[strID]; ok {
 		return nil, fmt.Errorf("shard with id %s was already added", strID)
 	}
 
+	pool, err := ants.NewPool(int(e.shardPoolSize), ants.WithNonblocking(true))
+	if err != nil {
+		return nil, err
+	}
+
 	e.shards[strID] = shardWrapper{
 		errorCount: atomic.NewUint32(0),
 		Shard:      sh,
@@ -59,6 +142,73 @@ func (e *StorageEngine) AddShard(opts ...shard.Option) (*shard.ID, error) {
 	return sh.ID(), nil
 }
 
+// DetachShards removes shards with provided identifiers from the
+// storage engine and closes them. Objects stored in the shards become
+// unavailable through the storage engine.
+//
+// Returns an error if any of the shards was not found or all shards
+// of the storage engine are requested to be detached. In this case
+// no shard is detached.
+func (e *StorageEngine) DetachShards(ids []*shard.ID) error {
+	return e.execIfNotBlocked(func() error {
+		return e.detachShards(ids)
+	})
+}
+
+func (e *StorageEngine) detachShards(ids []*shard.ID) error {
+	e.mtx.Lock()
+
+	detached := make(map[string]shardWrapper, len(ids))
+
+	for _, id := range ids {
+		strID := id.String()
+
+		sh, ok := e.shards[strID]
+		if !ok {
+			e.mtx.Unlock()
+			return fmt.Errorf("%w: %s", errShardNotFound, strID)
+		}
+
+		detached[strID] = sh
+	}
+
+	if len(detached) == len(e.shards) {
+		e.mtx.Unlock()
+		return errDetachAllShards
+	}
+
+	pools := make([]util.WorkerPool, 0, len(detached))
+
+	for strID := range detached {
+		pools = append(pools, e.shardPools[strID])
+
+		delete(e.shards, strID)
+		delete(e.shardPools, strID)
+
+		e.dropProbe(strID)
+	}
+
+	e.mtx.Unlock()
+
+	// objects of the detached shards are no longer available
+	e.readCache.purge()
+
+	for _, p := range pools {
+		p.Release()
+	}
+
+	for strID, sh := range detached {
+		if err := sh.Close(); err != nil {
+			e.log.Error("could not close detached shard",
+				zap.String("id", strID),
+				zap.String("error", err.Error()),
+			)
+		}
+	}
+
+	return nil
+}
+
 func generateShardID() (*shard.ID, error) {
 	uid, err := uuid.NewRandom()
 	if err != nil {
@@ -73,29 +223,52 @@ func generateShardID() (*shard.ID, error) {
 	return shard.NewIDFromBytes(bin), nil
 }
 
+// shardWeight returns the fraction of free disk space of the shard,
+// so the emptier shards are preferred.
 func (e *StorageEngine) shardWeight(sh *shard.Shard) float64 {
-	weightValues := sh.WeightValues()
-
-	return float64(weightValues.FreeSpace)
+	return 1 - sh.WeightValues().Usage()/100
 }
 
+// sortShardsByWeight returns shards sorted by the weight for the object address.
+// Full shards are placed at the end. Shards in maintenance mode are skipped.
 func (e *StorageEngine) sortShardsByWeight(objAddr fmt.Stringer) []hashedShard {
 	e.mtx.RLock()
 	defer e.mtx.RUnlock()
 
-	shards := make([]hashedShard, 0, len(e.shards))
-	weights := make([]float64, 0, len(e.shards))
+	var (
+		shards  = make([]hashedShard, 0, len(e.shards))
+		weights = make([]float64, 0, len(e.shards))
+		full    []hashedShard
+	)
 
 	for _, sh := range e.shards {
+		if sh.GetMode() == shard.ModeMaintenance {
+			continue
+		}
+
+		if sh.IsFull() {
+			full = append(full, hashedShard(sh))
+			continue
+		}
+
 		shards = append(shards, hashedShard(sh))
 		weights = append(weights, e.shardWeight(sh.Shard))
 	}
 
-	hrw.SortSliceByWeightValue(shards, weights, hrw.Hash([]byte(objAddr.String())))
+	h := hrw.Hash([]byte(objAddr.String()))
+
+	hrw.SortSliceByWeightValue(shards, weights, h)
+
+	if len(full) > 0 {
+		// full shards are still needed to read the objects
+		hrw.SortSliceByValue(full, h)
+		shards = append(shards, full...)
+	}
 
 	return shards
 }
 
+// unsortedShards returns all shards except ones in maintenance mode.
 func (e *StorageEngine) unsortedShards() []hashedShard {
 	e.mtx.RLock()
 	defer e.mtx.RUnlock()
@@ -103,6 +276,10 @@ func (e *StorageEngine) unsortedShards() []hashedShard {
 	shards := make([]hashedShard, 0, len(e.shards))
 
 	for _, sh := range e.shards {
+		if sh.GetMode() == shard.ModeMaintenance {
+			continue
+		}
+
 		shards = append(shards, hashedShard(sh))
 	}
 
@@ -136,7 +313,13 @@ func (e *StorageEngine) SetShardMode(id *shard.ID, m shard.Mode, resetErrorCount
 		if id.String() == shID {
 			if resetErrorCounter {
 				sh.errorCount.Store(0)
+				e.reportShardErrorCount(shID, 0)
 			}
+
+			// mode is set explicitly, so it must not be
+			// changed by the shard health prober
+			e.dropProbe(shID)
+
 			return sh.SetMode(m)
 		}
 	}
@@ -0,0 +1,115 @@
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageEngine_AttachDetachShards(t *testing.T) {
+	e := testNewEngineWithShardNum(t, 1)
+	t.Cleanup(func() {
+		_ = e.Close()
+		_ = os.RemoveAll(t.Name())
+	})
+
+	shardOpts := func() []shard.Option {
+		return []shard.Option{
+			shard.WithBlobStorOptions(
+				blobstor.WithRootPath(filepath.Join(t.Name(), "attached.blobstor")),
+				blobstor.WithBlobovniczaShallowWidth(1),
+				blobstor.WithBlobovniczaShallowDepth(1),
+				blobstor.WithRootPerm(0700),
+			),
+			shard.WithMetaBaseOptions(
+				meta.WithPath(filepath.Join(t.Name(), "attached.metabase")),
+				meta.WithPermissions(0700),
+			),
+		}
+	}
+
+	id, err := e.AttachShard(shardOpts()...)
+	require.NoError(t, err)
+	require.Len(t, e.shards, 2)
+	require.Len(t, e.shardPools, 2)
+
+	var oldID *shard.ID
+	for _, sh := range e.shards {
+		if sh.ID().String() != id.String() {
+			oldID = sh.ID()
+		}
+	}
+
+	require.ErrorIs(t, e.DetachShards([]*shard.ID{shard.NewIDFromBytes([]byte{1, 2, 3})}), errShardNotFound)
+	require.ErrorIs(t, e.DetachShards([]*shard.ID{id, oldID}), errDetachAllShards)
+	require.Len(t, e.shards, 2)
+
+	require.NoError(t, e.DetachShards([]*shard.ID{id}))
+	require.Len(t, e.shards, 1)
+	require.Len(t, e.shardPools, 1)
+
+	// shard ID is persisted in the metabase
+	newID, err := e.AttachShard(shardOpts()...)
+	require.NoError(t, err)
+	require.Equal(t, id, newID)
+
+	_, err = e.AttachShard(shardOpts()...)
+	require.Error(t, err, "shard must not be attached twice")
+	require.Len(t, e.shards, 2)
+}
+
+func TestStorageEngine_PutFullShard(t *testing.T) {
+	e := testEngineFromShardOpts(t, 2, func(i int) []shard.Option {
+		if i == 0 {
+			// any file system in use is filled by more than 1%
+			return []shard.Option{shard.WithUsageHighWatermark(1)}
+		}
+
+		return nil
+	})
+	t.Cleanup(func() {
+		_ = e.Close()
+		_ = os.RemoveAll(t.Name())
+	})
+
+	var full, free *shard.Shard
+
+	for _, sh := range e.shards {
+		if sh.IsFull() {
+			full = sh.Shard
+		} else {
+			free = sh.Shard
+		}
+	}
+
+	if full == nil {
+		t.Skip("disk usage is too low to fill the shard")
+	}
+
+	require.NotNil(t, free)
+
+	for i := 0; i < 10; i++ {
+		obj := generateObjectWithCID(t, cidtest.ID())
+		addr := object.AddressOf(obj)
+
+		shards := e.sortShardsByWeight(addr)
+		require.Equal(t, full.ID(), shards[len(shards)-1].ID())
+
+		require.NoError(t, Put(e, obj))
+
+		res, err := free.Exists(new(shard.ExistsPrm).WithAddress(addr))
+		require.NoError(t, err)
+		require.True(t, res.Exists())
+
+		res, err = full.Exists(new(shard.ExistsPrm).WithAddress(addr))
+		require.NoError(t, err)
+		require.False(t, res.Exists())
+	}
+}
@@ -0,0 +1,37 @@
+package engine
+
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+)
+
+// FlushWriteCache writes all objects stored in the write-cache of the shard
+// with provided identifier to the main storage of the shard.
+//
+// Returns an error if shard is not in read-write mode.
+func (e *StorageEngine) FlushWriteCache(id *shard.ID) error {
+	e.mtx.RLock()
+	sh, ok := e.shards[id.String()]
+	e.mtx.RUnlock()
+
+	if !ok {
+		return errShardNotFound
+	}
+
+	return sh.FlushWriteCache()
+}
+
+// WriteCacheStats returns the statistics of the write-cache of the shard
+// with provided identifier. Returns nil if the write-cache of the shard
+// is disabled.
+func (e *StorageEngine) WriteCacheStats(id *shard.ID) (*writecache.Stats, error) {
+	e.mtx.RLock()
+	sh, ok := e.shards[id.String()]
+	e.mtx.RUnlock()
+
+	if !ok {
+		return nil, errShardNotFound
+	}
+
+	return sh.WriteCacheStats()
+}
@@ -0,0 +1,97 @@
+// Package sizecache implements LRU cache limited by the total size of the values.
+package sizecache
+
+import (
+	"math"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+)
+
+// Cache is an LRU cache which total size of the values is limited.
+// The oldest values are evicted when the limit is exceeded.
+//
+// Cache is not safe for concurrent use.
+type Cache struct {
+	size, capacity uint64
+
+	values *simplelru.LRU
+}
+
+type entry struct {
+	value interface{}
+
+	size uint64
+}
+
+// New creates and returns Cache which total size
+// of the values is limited by capacity.
+func New(capacity uint64) *Cache {
+	c := &Cache{
+		capacity: capacity,
+	}
+
+	// the number of entries is limited by the total size
+	c.values, _ = simplelru.NewLRU(math.MaxInt32, func(_ interface{}, value interface{}) {
+		c.size -= value.(*entry).size
+	})
+
+	return c
+}
+
+// Get returns the value by the key and marks it as recently used.
+func (c *Cache) Get(key string) (interface{}, bool) {
+	v, ok := c.values.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	return v.(*entry).value, true
+}
+
+// Peek returns the value by the key without marking it as recently used.
+func (c *Cache) Peek(key string) (interface{}, bool) {
+	v, ok := c.values.Peek(key)
+	if !ok {
+		return nil, false
+	}
+
+	return v.(*entry).value, true
+}
+
+// Add adds the value of the given size replacing the previous value
+// of the key. The oldest values are evicted until the total size fits
+// the capacity. Values exceeding the capacity are not added, but
+// the previous value of the key is removed anyway, so it is not stale.
+func (c *Cache) Add(key string, value interface{}, size uint64) {
+	// Add does not evict the replaced value, so size would be miscounted
+	c.values.Remove(key)
+
+	if size > c.capacity {
+		return
+	}
+
+	c.values.Add(key, &entry{
+		value: value,
+		size:  size,
+	})
+	c.size += size
+
+	for c.size > c.capacity {
+		c.values.RemoveOldest()
+	}
+}
+
+// Remove drops the value by the key.
+func (c *Cache) Remove(key string) {
+	c.values.Remove(key)
+}
+
+// Purge drops all values.
+func (c *Cache) Purge() {
+	c.values.Purge()
+}
+
+// Size returns the total size of the cached values.
+func (c *Cache) Size() uint64 {
+	return c.size
+}
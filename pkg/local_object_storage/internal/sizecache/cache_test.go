@@ -0,0 +1,60 @@
+package sizecache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	c := New(10)
+
+	c.Add("a", 1, 4)
+	c.Add("b", 2, 4)
+	require.EqualValues(t, 8, c.Size())
+
+	// too big values are not added
+	c.Add("c", 3, 11)
+	require.EqualValues(t, 8, c.Size())
+
+	_, ok := c.Peek("c")
+	require.False(t, ok)
+
+	// "a" becomes recently used
+	v, ok := c.Get("a")
+	require.True(t, ok)
+	require.Equal(t, 1, v)
+
+	c.Add("c", 3, 4)
+	require.EqualValues(t, 8, c.Size())
+
+	_, ok = c.Peek("b")
+	require.False(t, ok)
+
+	// replaced value is not counted
+	c.Add("c", 4, 2)
+	require.EqualValues(t, 6, c.Size())
+
+	v, ok = c.Peek("c")
+	require.True(t, ok)
+	require.Equal(t, 4, v)
+
+	c.Remove("a")
+	require.EqualValues(t, 2, c.Size())
+
+	// too big value removes the previous one
+	c.Add("c", 5, 11)
+	require.Zero(t, c.Size())
+
+	_, ok = c.Peek("c")
+	require.False(t, ok)
+
+	c.Add("c", 4, 2)
+	require.EqualValues(t, 2, c.Size())
+
+	c.Purge()
+	require.Zero(t, c.Size())
+
+	_, ok = c.Get("c")
+	require.False(t, ok)
+}
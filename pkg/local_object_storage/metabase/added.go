@@ -0,0 +1,276 @@
+package meta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.etcd.io/bbolt"
+)
+
+// addedBucketName is the name of the bucket which keeps the log of the
+// physical objects in the order they were added to the DB. Keys are
+// 8-byte big-endian sequence numbers, values are object address keys.
+var addedBucketName = []byte(invalidBase58String + "Added")
+
+// addedIndexBucketName is the name of the bucket which maps object address
+// keys to the sequence numbers of their entries in the log of the added
+// objects, so the entries are removed along with the objects.
+var addedIndexBucketName = []byte(invalidBase58String + "AddedIndex")
+
+// addedTruncatedKey is the key of the checkpoint the log
+// of the added objects is truncated at in the shard info bucket.
+var addedTruncatedKey = []byte("added_truncated")
+
+// addedTruncateBatchSize is the number of the log entries
+// removed in a single transaction.
+const addedTruncateBatchSize = 10000
+
+// ErrAddedLogTruncated is returned when the log of the added
+// objects has been truncated after the requested checkpoint.
+var ErrAddedLogTruncated = errors.New("log of the added objects is truncated after the checkpoint")
+
+// AddedObjectHandler is a handler of the object address
+// from the log of the added objects.
+type AddedObjectHandler func(*addressSDK.Address) error
+
+// IterateAdded iterates over addresses of the physical objects added to DB
+// after the checkpoint in the order of addition. Zero checkpoint corresponds
+// to the beginning of the log.
+//
+// Returns the checkpoint of the last added object which can be used to
+// continue iteration later. Log entries are removed along with the
+// objects (see Delete), so only the stored objects are passed to h.
+//
+// Returns ErrAddedLogTruncated if the log has been truncated after
+// the checkpoint (see TruncateAdded).
+//
+// Returns errors of h directly.
+func (db *DB) IterateAdded(checkpoint uint64, h AddedObjectHandler) (uint64, error) {
+	var last uint64
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		if checkpoint < addedTruncated(tx) {
+			return ErrAddedLogTruncated
+		}
+
+		b := tx.Bucket(addedBucketName)
+		if b == nil {
+			return nil
+		}
+
+		last = b.Sequence()
+
+		c := b.Cursor()
+
+		for k, v := c.Seek(sequenceKey(checkpoint + 1)); k != nil; k, v = c.Next() {
+			addr, err := addressFromKey(v)
+			if err != nil {
+				return fmt.Errorf("could not parse address of added object: %w", err)
+			}
+
+			if err := h(addr); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return last, err
+}
+
+// LastAddedCheckpoint returns the checkpoint of the last object added to DB.
+func (db *DB) LastAddedCheckpoint() (uint64, error) {
+	var last uint64
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		if b := tx.Bucket(addedBucketName); b != nil {
+			last = b.Sequence()
+		}
+
+		return nil
+	})
+
+	return last, err
+}
+
+// TruncateAdded removes the entries of the log of the added objects up to
+// the checkpoint inclusive. Iteration from the previous checkpoints is
+// not possible after that. Entries are removed in batches, so the log
+// can be partially truncated if an error occurs.
+func (db *DB) TruncateAdded(checkpoint uint64) error {
+	err := db.boltDB.Update(func(tx *bbolt.Tx) error {
+		if checkpoint <= addedTruncated(tx) {
+			return nil
+		}
+
+		b, err := tx.CreateBucketIfNotExists(shardInfoBucket)
+		if err != nil {
+			return err
+		}
+
+		return b.Put(addedTruncatedKey, sequenceKey(checkpoint))
+	})
+	if err != nil {
+		return fmt.Errorf("could not save truncation checkpoint: %w", err)
+	}
+
+	for done := false; !done; {
+		err = db.boltDB.Update(func(tx *bbolt.Tx) error {
+			b := tx.Bucket(addedBucketName)
+			if b == nil {
+				done = true
+				return nil
+			}
+
+			index := tx.Bucket(addedIndexBucketName)
+			c := b.Cursor()
+
+			for i := 0; i < addedTruncateBatchSize; i++ {
+				k, v := c.First()
+				if k == nil || binary.BigEndian.Uint64(k) > checkpoint {
+					done = true
+					return nil
+				}
+
+				if index != nil && bytes.Equal(index.Get(v), k) {
+					if err := index.Delete(v); err != nil {
+						return err
+					}
+				}
+
+				if err := c.Delete(); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("could not remove log entries: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// addedTruncated returns the checkpoint the log of the added objects
+// is truncated at. Zero if the log has not been truncated.
+func addedTruncated(tx *bbolt.Tx) uint64 {
+	b := tx.Bucket(shardInfoBucket)
+	if b == nil {
+		return 0
+	}
+
+	v := b.Get(addedTruncatedKey)
+	if len(v) != 8 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(v)
+}
+
+// logAddedObject appends object address to the log of the added objects.
+func logAddedObject(tx *bbolt.Tx, addr *addressSDK.Address) error {
+	b, err := tx.CreateBucketIfNotExists(addedBucketName)
+	if err != nil {
+		return err
+	}
+
+	index, err := tx.CreateBucketIfNotExists(addedIndexBucketName)
+	if err != nil {
+		return err
+	}
+
+	seq, err := b.NextSequence()
+	if err != nil {
+		return err
+	}
+
+	addrKey := addressKey(addr)
+	seqKey := sequenceKey(seq)
+
+	if err := b.Put(seqKey, addrKey); err != nil {
+		return err
+	}
+
+	return index.Put(addrKey, seqKey)
+}
+
+// unlogAddedObject removes the entry of the object
+// from the log of the added objects if it is there.
+func unlogAddedObject(tx *bbolt.Tx, addr *addressSDK.Address) error {
+	index := tx.Bucket(addedIndexBucketName)
+	if index == nil {
+		return nil
+	}
+
+	addrKey := addressKey(addr)
+
+	seqKey := index.Get(addrKey)
+	if seqKey == nil {
+		return nil
+	}
+
+	if b := tx.Bucket(addedBucketName); b != nil {
+		if err := b.Delete(seqKey); err != nil {
+			return err
+		}
+	}
+
+	return index.Delete(addrKey)
+}
+
+// indexAddedObjects is a migration which fills the index
+// of the entries of the log of the added objects.
+func indexAddedObjects(_ *DB, tx *bbolt.Tx, marker []byte) ([]byte, int, error) {
+	b := tx.Bucket(addedBucketName)
+	if b == nil {
+		return nil, 0, nil
+	}
+
+	index, err := tx.CreateBucketIfNotExists(addedIndexBucketName)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var (
+		n    int
+		cur  = b.Cursor()
+		k, v []byte
+	)
+
+	if marker != nil {
+		k, v = cur.Seek(marker)
+		if bytes.Equal(k, marker) {
+			k, v = cur.Next()
+		}
+	} else {
+		k, v = cur.First()
+	}
+
+	for ; k != nil; k, v = cur.Next() {
+		if err := index.Put(slice.Copy(v), slice.Copy(k)); err != nil {
+			return nil, n, err
+		}
+
+		n++
+
+		if n == migrationBatchSize {
+			return slice.Copy(k), n, nil
+		}
+	}
+
+	return nil, n, nil
+}
+
+func sequenceKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+
+	return key
+}
@@ -0,0 +1,137 @@
+package meta_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_IterateAdded(t *testing.T) {
+	db := newDB(t)
+
+	const total = 5
+
+	expected := make([]string, 0, total)
+
+	for i := 0; i < total; i++ {
+		obj := generateObject(t)
+		require.NoError(t, putBig(db, obj))
+
+		expected = append(expected, object.AddressOf(obj).String())
+	}
+
+	// repeated put must not be logged twice
+	obj := generateObject(t)
+	require.NoError(t, putBig(db, obj))
+	require.NoError(t, putBig(db, obj))
+	expected = append(expected, object.AddressOf(obj).String())
+
+	collect := func(checkpoint uint64) ([]string, uint64) {
+		var got []string
+
+		last, err := db.IterateAdded(checkpoint, func(addr *addressSDK.Address) error {
+			got = append(got, addr.String())
+			return nil
+		})
+		require.NoError(t, err)
+
+		return got, last
+	}
+
+	got, last := collect(0)
+	require.Equal(t, expected, got)
+	require.EqualValues(t, len(expected), last)
+
+	lastCheckpoint, err := db.LastAddedCheckpoint()
+	require.NoError(t, err)
+	require.Equal(t, last, lastCheckpoint)
+
+	got, last = collect(3)
+	require.Equal(t, expected[3:], got)
+	require.EqualValues(t, len(expected), last)
+
+	got, _ = collect(last)
+	require.Empty(t, got)
+
+	t.Run("truncate", func(t *testing.T) {
+		require.NoError(t, db.TruncateAdded(3))
+
+		got, last := collect(3)
+		require.Equal(t, expected[3:], got)
+		require.EqualValues(t, len(expected), last)
+
+		_, err := db.IterateAdded(2, func(*addressSDK.Address) error { return nil })
+		require.ErrorIs(t, err, meta.ErrAddedLogTruncated)
+
+		// truncation to the previous checkpoint does nothing
+		require.NoError(t, db.TruncateAdded(1))
+
+		got, _ = collect(3)
+		require.Equal(t, expected[3:], got)
+
+		require.NoError(t, db.TruncateAdded(last))
+
+		got, _ = collect(last)
+		require.Empty(t, got)
+
+		lastCheckpoint, err := db.LastAddedCheckpoint()
+		require.NoError(t, err)
+		require.Equal(t, last, lastCheckpoint)
+	})
+}
+
+func TestDB_IterateAdded_Delete(t *testing.T) {
+	db := newDB(t)
+
+	const total = 4
+
+	addrs := make([]*addressSDK.Address, 0, total)
+
+	for i := 0; i < total; i++ {
+		obj := generateObject(t)
+		require.NoError(t, putBig(db, obj))
+
+		addrs = append(addrs, object.AddressOf(obj))
+	}
+
+	collect := func() []string {
+		var got []string
+
+		_, err := db.IterateAdded(0, func(addr *addressSDK.Address) error {
+			got = append(got, addr.String())
+			return nil
+		})
+		require.NoError(t, err)
+
+		return got
+	}
+
+	require.NoError(t, meta.Delete(db, addrs[1]))
+	require.Equal(t, []string{addrs[0].String(), addrs[2].String(), addrs[3].String()}, collect())
+
+	require.NoError(t, meta.Delete(db, addrs[0], addrs[2], addrs[3]))
+	require.Empty(t, collect())
+
+	// checkpoint is not affected by the removal
+	last, err := db.LastAddedCheckpoint()
+	require.NoError(t, err)
+	require.EqualValues(t, total, last)
+
+	t.Run("put after delete", func(t *testing.T) {
+		obj := generateObject(t)
+		require.NoError(t, putBig(db, obj))
+
+		addr := object.AddressOf(obj)
+		require.Equal(t, []string{addr.String()}, collect())
+
+		require.NoError(t, db.TruncateAdded(total+1))
+		require.Empty(t, collect())
+
+		// object is removed from the truncated log
+		require.NoError(t, meta.Delete(db, addr))
+		require.Empty(t, collect())
+	})
+}
@@ -0,0 +1,642 @@
+package meta
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.etcd.io/bbolt"
+)
+
+// StorageIterator passes all objects stored in the BLOB storage to f along
+// with the identifiers of the blobovniczas they are stored in. Identifier
+// is nil for the objects stored in the file tree.
+type StorageIterator func(f func(obj *objectSDK.Object, blzID *blobovnicza.ID) error) error
+
+// StorageLookup checks if the object is stored in the blobovnicza with the
+// given identifier, or in the file tree if the identifier is nil.
+type StorageLookup func(addr *addressSDK.Address, blzID *blobovnicza.ID) (bool, error)
+
+// StorageRemover removes the object from the blobovnicza with the given
+// identifier, or from the file tree if the identifier is nil.
+type StorageRemover func(addr *addressSDK.Address, blzID *blobovnicza.ID) error
+
+// CheckProblem is a kind of inconsistency between the metabase and
+// the BLOB storage.
+type CheckProblem uint8
+
+const (
+	_ CheckProblem = iota
+
+	// ProblemOrphanedIndex means that the indexed object is not stored
+	// in the BLOB storage.
+	ProblemOrphanedIndex
+
+	// ProblemMissingIndex means that the stored object is not indexed.
+	ProblemMissingIndex
+
+	// ProblemWrongBlobovnicza means that the object is not stored in the
+	// blobovnicza referenced from the metabase (or in the file tree if
+	// there is no reference).
+	ProblemWrongBlobovnicza
+
+	// ProblemInvalidGrave means that the graveyard record is malformed or
+	// refers to the tombstone from another container.
+	ProblemInvalidGrave
+
+	lastCheckProblem
+)
+
+// String implements fmt.Stringer.
+func (p CheckProblem) String() string {
+	switch p {
+	case ProblemOrphanedIndex:
+		return "orphaned index"
+	case ProblemMissingIndex:
+		return "missing index"
+	case ProblemWrongBlobovnicza:
+		return "wrong blobovnicza reference"
+	case ProblemInvalidGrave:
+		return "invalid grave"
+	default:
+		return fmt.Sprintf("unknown problem %d", p)
+	}
+}
+
+// CheckIssue describes the inconsistency found by Check.
+type CheckIssue struct {
+	// Kind of the inconsistency.
+	Problem CheckProblem
+	// Address of the object or raw key of the metabase record.
+	Key string
+	// Additional information, e.g. the reason why the issue is not fixed.
+	Details string
+	// True if the issue has been fixed.
+	Fixed bool
+}
+
+// CheckHandler is called on each inconsistency found by Check.
+type CheckHandler func(CheckIssue)
+
+// CheckPrm groups the parameters of Check operation.
+type CheckPrm struct {
+	fix bool
+
+	storage StorageIterator
+
+	lookup StorageLookup
+
+	remover StorageRemover
+
+	handler CheckHandler
+}
+
+// CheckRes groups resulting values of Check operation.
+type CheckRes struct {
+	found [lastCheckProblem]uint64
+	fixed [lastCheckProblem]uint64
+}
+
+var (
+	errNilStorageIterator = errors.New("storage iterator is not set")
+	errNilStorageLookup   = errors.New("storage lookup is not set")
+	errNilStorageRemover  = errors.New("storage remover is not set")
+)
+
+// WithStorage is a Check option to set the iterator over the objects
+// stored in the BLOB storage.
+//
+// Option is required.
+func (p *CheckPrm) WithStorage(it StorageIterator) *CheckPrm {
+	if p != nil {
+		p.storage = it
+	}
+
+	return p
+}
+
+// WithStorageLookup is a Check option to set the function checking
+// the location of the stored objects.
+//
+// Option is required.
+func (p *CheckPrm) WithStorageLookup(f StorageLookup) *CheckPrm {
+	if p != nil {
+		p.lookup = f
+	}
+
+	return p
+}
+
+// WithStorageRemover is a Check option to set the function removing
+// the stored objects.
+//
+// Option is required to fix not indexed objects which are removed.
+func (p *CheckPrm) WithStorageRemover(f StorageRemover) *CheckPrm {
+	if p != nil {
+		p.remover = f
+	}
+
+	return p
+}
+
+// WithFix is a Check option to repair the found inconsistencies.
+func (p *CheckPrm) WithFix(fix bool) *CheckPrm {
+	if p != nil {
+		p.fix = fix
+	}
+
+	return p
+}
+
+// WithHandler is a Check option to set the handler of the found
+// inconsistencies.
+func (p *CheckPrm) WithHandler(h CheckHandler) *CheckPrm {
+	if p != nil {
+		p.handler = h
+	}
+
+	return p
+}
+
+// Found returns the number of found inconsistencies of the given kind.
+func (r *CheckRes) Found(p CheckProblem) uint64 {
+	if p < lastCheckProblem {
+		return r.found[p]
+	}
+
+	return 0
+}
+
+// Fixed returns the number of fixed inconsistencies of the given kind.
+func (r *CheckRes) Fixed(p CheckProblem) uint64 {
+	if p < lastCheckProblem {
+		return r.fixed[p]
+	}
+
+	return 0
+}
+
+// Check cross-checks the metabase indexes against the objects stored in
+// the BLOB storage. It reports indexed objects which are not stored,
+// stored objects which are not indexed, wrong references to the
+// blobovniczas and malformed graveyard records. If fix is set, only
+// the broken records are repaired:
+//   - indexes of the objects which are not stored are removed, records of
+//     the objects buried with a tombstone are kept in the graveyard;
+//   - not indexed objects are put to the metabase, the ones which are
+//     buried in the graveyard are removed from the BLOB storage instead;
+//   - blobovnicza references are updated to point to the actual location;
+//   - malformed graveyard records are removed, records with invalid
+//     tombstone addresses are replaced with GC marks.
+//
+// Objects are checked one by one, so memory usage does not depend on the
+// number of the stored objects. Metabase must not be used by anyone else
+// during the check.
+func (db *DB) Check(prm *CheckPrm) (*CheckRes, error) {
+	switch {
+	case prm.storage == nil:
+		return nil, errNilStorageIterator
+	case prm.lookup == nil:
+		return nil, errNilStorageLookup
+	}
+
+	c := &checker{
+		db:        db,
+		prm:       prm,
+		res:       new(CheckRes),
+		misplaced: make(map[string]struct{}),
+	}
+
+	if err := c.checkStored(); err != nil {
+		return nil, fmt.Errorf("could not check stored objects: %w", err)
+	}
+
+	c.removeBuried()
+
+	if err := c.checkIndexed(); err != nil {
+		return nil, fmt.Errorf("could not check indexed objects: %w", err)
+	}
+
+	if err := c.checkGraveyard(); err != nil {
+		return nil, fmt.Errorf("could not check graveyard: %w", err)
+	}
+
+	return c.res, nil
+}
+
+type checker struct {
+	db *DB
+
+	prm *CheckPrm
+
+	res *CheckRes
+
+	// objects which are not stored in the referenced location
+	misplaced map[string]struct{}
+
+	// not indexed objects buried in the graveyard
+	buried []storedObject
+}
+
+type storedObject struct {
+	addr  *addressSDK.Address
+	blzID *blobovnicza.ID
+}
+
+func (c *checker) report(p CheckProblem, key, details string, fixed bool) {
+	c.res.found[p]++
+
+	if fixed {
+		c.res.fixed[p]++
+	}
+
+	if c.prm.handler != nil {
+		c.prm.handler(CheckIssue{
+			Problem: p,
+			Key:     key,
+			Details: details,
+			Fixed:   fixed,
+		})
+	}
+}
+
+// checkStored indexes the objects which are missing in the metabase
+// and fixes the wrong references to their locations.
+func (c *checker) checkStored() error {
+	return c.prm.storage(func(obj *objectSDK.Object, blzID *blobovnicza.ID) error {
+		addr := object.AddressOf(obj)
+		key := addr.String()
+
+		var (
+			indexed bool
+			buried  bool
+			ref     []byte
+		)
+
+		err := c.db.boltDB.View(func(tx *bbolt.Tx) error {
+			name := physicalBucketName(addr.ContainerID(), obj.Type())
+			indexed = name != nil && inBucket(tx, name, objectKey(addr.ObjectID()))
+
+			if !indexed {
+				buried = inGraveyard(tx, addr) > 0
+			} else if smallBkt := tx.Bucket(smallBucketName(addr.ContainerID())); smallBkt != nil {
+				ref = slice.Copy(smallBkt.Get(objectKey(addr.ObjectID())))
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if indexed {
+			return c.checkReference(addr, blzID, ref)
+		}
+
+		if buried {
+			// object has been removed, but the removal from the
+			// BLOB storage failed, so it must not be indexed again
+			c.buried = append(c.buried, storedObject{addr: addr, blzID: blzID})
+			return nil
+		}
+
+		var details string
+
+		fixed := c.prm.fix
+		if fixed {
+			if err := c.db.putMissing(obj, blzID); err != nil {
+				details = err.Error()
+				fixed = false
+			}
+		}
+
+		c.report(ProblemMissingIndex, key, details, fixed)
+
+		return nil
+	})
+}
+
+// checkReference checks that the indexed object stored in the blobovnicza
+// with blzID (file tree if nil) is referenced from the metabase correctly.
+// Object may be stored in several places, so the reference is wrong only
+// if the object is missing in the referenced location.
+func (c *checker) checkReference(addr *addressSDK.Address, blzID *blobovnicza.ID, ref []byte) error {
+	key := addr.String()
+
+	if _, ok := c.misplaced[key]; ok {
+		return nil // already reported
+	}
+
+	var loc []byte
+	if blzID != nil {
+		loc = *blzID
+	}
+
+	if bytes.Equal(loc, ref) {
+		return nil
+	}
+
+	var refID *blobovnicza.ID
+	if ref != nil {
+		refID = blobovnicza.NewIDFromBytes(ref)
+	}
+
+	ok, err := c.prm.lookup(addr, refID)
+	if err != nil {
+		return fmt.Errorf("could not look up object %s: %w", key, err)
+	} else if ok {
+		return nil
+	}
+
+	c.misplaced[key] = struct{}{}
+
+	details := "object is stored in the file tree"
+	if blzID != nil {
+		details = "object is stored in blobovnicza " + blzID.String()
+	}
+
+	c.fixRecord(ProblemWrongBlobovnicza, key, details, func(tx *bbolt.Tx) error {
+		if blzID != nil {
+			return updateBlobovniczaID(tx, addr, blzID)
+		}
+
+		bkt := tx.Bucket(smallBucketName(addr.ContainerID()))
+		if bkt == nil {
+			return nil
+		}
+
+		return bkt.Delete(objectKey(addr.ObjectID()))
+	})
+
+	return nil
+}
+
+// removeBuried removes not indexed objects buried in the graveyard
+// from the BLOB storage.
+func (c *checker) removeBuried() {
+	for i := range c.buried {
+		details := "object is removed"
+
+		fixed := c.prm.fix
+		if fixed {
+			if c.prm.remover == nil {
+				details = errNilStorageRemover.Error()
+				fixed = false
+			} else if err := c.prm.remover(c.buried[i].addr, c.buried[i].blzID); err != nil {
+				details = err.Error()
+				fixed = false
+			}
+		}
+
+		c.report(ProblemMissingIndex, c.buried[i].addr.String(), details, fixed)
+	}
+}
+
+// putMissing puts the stored object to the metabase the same way
+// it is done on metabase resynchronization.
+func (db *DB) putMissing(obj *objectSDK.Object, blzID *blobovnicza.ID) error {
+	if obj.Type() == objectSDK.TypeTombstone {
+		tombstone := objectSDK.NewTombstone()
+
+		if err := tombstone.Unmarshal(obj.Payload()); err != nil {
+			return fmt.Errorf("could not unmarshal tombstone content: %w", err)
+		}
+
+		tombAddr := object.AddressOf(obj)
+		memberIDs := tombstone.Members()
+		members := make([]*addressSDK.Address, 0, len(memberIDs))
+
+		for i := range memberIDs {
+			a := addressSDK.NewAddress()
+			a.SetContainerID(tombAddr.ContainerID())
+			a.SetObjectID(&memberIDs[i])
+
+			members = append(members, a)
+		}
+
+		_, err := db.Inhume(new(InhumePrm).
+			WithTombstoneAddress(tombAddr).
+			WithAddresses(members...),
+		)
+		if err != nil {
+			return fmt.Errorf("could not inhume tombstone members: %w", err)
+		}
+	}
+
+	return Put(db, obj, blzID)
+}
+
+// checkIndexed finds indexed objects which are not stored.
+func (c *checker) checkIndexed() error {
+	var (
+		orphans []*addressSDK.Address
+		// references to blobovniczas of not indexed objects
+		dangling []*addressSDK.Address
+	)
+
+	err := c.db.boltDB.View(func(tx *bbolt.Tx) error {
+		return tx.ForEach(func(name []byte, bkt *bbolt.Bucket) error {
+			cnr, postfix := parseContainerIDWithPostfix(name)
+			if cnr == nil {
+				return nil
+			}
+
+			switch postfix {
+			case "", storageGroupPostfix, bucketNameSuffixLockers, tombstonePostfix:
+			case smallPostfix:
+				return bkt.ForEach(func(k, _ []byte) error {
+					addr, err := addressFromKey([]byte(cnr.String() + "/" + string(k)))
+					if err == nil && !physicallyIndexed(tx, cnr, k) {
+						dangling = append(dangling, addr)
+					}
+
+					return nil
+				})
+			default:
+				return nil
+			}
+
+			smallBkt := tx.Bucket(smallBucketName(cnr))
+
+			return bkt.ForEach(func(k, _ []byte) error {
+				addr, err := addressFromKey([]byte(cnr.String() + "/" + string(k)))
+				if err != nil {
+					return nil
+				}
+
+				if _, ok := c.misplaced[addr.String()]; ok {
+					return nil // stored in another place
+				}
+
+				var ref *blobovnicza.ID
+				if smallBkt != nil {
+					if v := smallBkt.Get(k); v != nil {
+						ref = blobovnicza.NewIDFromBytes(slice.Copy(v))
+					}
+				}
+
+				ok, err := c.prm.lookup(addr, ref)
+				if err != nil {
+					return fmt.Errorf("could not look up object %s: %w", addr, err)
+				} else if !ok {
+					orphans = append(orphans, addr)
+				}
+
+				return nil
+			})
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := range orphans {
+		c.fixRecord(ProblemOrphanedIndex, orphans[i].String(), "object is not stored", func(tx *bbolt.Tx) error {
+			return c.db.deleteOrphan(tx, orphans[i])
+		})
+	}
+
+	for i := range dangling {
+		c.fixRecord(ProblemOrphanedIndex, dangling[i].String(), "blobovnicza reference of not indexed object", func(tx *bbolt.Tx) error {
+			return tx.Bucket(smallBucketName(dangling[i].ContainerID())).Delete(objectKey(dangling[i].ObjectID()))
+		})
+	}
+
+	return nil
+}
+
+// fixRecord reports the issue and fixes it with f in a separate
+// transaction if fix is requested.
+func (c *checker) fixRecord(p CheckProblem, key, details string, f func(tx *bbolt.Tx) error) {
+	fixed := c.prm.fix
+	if fixed {
+		if err := c.db.boltDB.Update(f); err != nil {
+			details = err.Error()
+			fixed = false
+		}
+	}
+
+	c.report(p, key, details, fixed)
+}
+
+// deleteOrphan removes indexes of the object. Graveyard record of the
+// object buried with a tombstone is kept, so that the object is not
+// resurrected on replication.
+func (db *DB) deleteOrphan(tx *bbolt.Tx, addr *addressSDK.Address) error {
+	var grave []byte
+
+	if graveyard := tx.Bucket(graveyardBucketName); graveyard != nil {
+		grave = graveyard.Get(addressKey(addr))
+		if grave != nil && !bytes.Equal(grave, []byte(inhumeGCMarkValue)) {
+			grave = slice.Copy(grave)
+		} else {
+			grave = nil
+		}
+	}
+
+	if err := db.deleteGroup(tx, []*addressSDK.Address{addr}); err != nil {
+		return err
+	}
+
+	if grave == nil {
+		return nil
+	}
+
+	return putGrave(tx, tx.Bucket(graveyardBucketName), addressKey(addr), grave)
+}
+
+type invalidGrave struct {
+	key []byte
+	// replace the record with GC mark if true, remove otherwise
+	mark bool
+}
+
+// checkGraveyard finds malformed graveyard records.
+func (c *checker) checkGraveyard() error {
+	var graves []invalidGrave
+
+	err := c.db.boltDB.View(func(tx *bbolt.Tx) error {
+		graveyard := tx.Bucket(graveyardBucketName)
+		if graveyard == nil {
+			return nil
+		}
+
+		return graveyard.ForEach(func(k, v []byte) error {
+			addr, err := addressFromKey(k)
+			if err != nil {
+				graves = append(graves, invalidGrave{key: slice.Copy(k)})
+				return nil
+			}
+
+			if bytes.Equal(v, []byte(inhumeGCMarkValue)) {
+				return nil
+			}
+
+			tomb, err := addressFromKey(v)
+			if err != nil || !tomb.ContainerID().Equal(addr.ContainerID()) {
+				graves = append(graves, invalidGrave{key: slice.Copy(k), mark: true})
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := range graves {
+		g := graves[i]
+
+		details := "malformed address"
+		if g.mark {
+			details = "invalid tombstone address"
+		}
+
+		c.fixRecord(ProblemInvalidGrave, string(g.key), details, func(tx *bbolt.Tx) error {
+			graveyard := tx.Bucket(graveyardBucketName)
+
+			if g.mark {
+				return putGrave(tx, graveyard, g.key, []byte(inhumeGCMarkValue))
+			}
+
+			return deleteGrave(tx, graveyard, g.key)
+		})
+	}
+
+	return nil
+}
+
+// physicalBucketName returns name of the bucket of physically stored
+// objects of the given type. Returns nil for unknown types.
+func physicalBucketName(cnr *cid.ID, typ objectSDK.Type) []byte {
+	switch typ {
+	case objectSDK.TypeRegular:
+		return primaryBucketName(cnr)
+	case objectSDK.TypeTombstone:
+		return tombstoneBucketName(cnr)
+	case objectSDK.TypeStorageGroup:
+		return storageGroupBucketName(cnr)
+	case objectSDK.TypeLock:
+		return bucketNameLockers(*cnr)
+	default:
+		return nil
+	}
+}
+
+// physicallyIndexed checks if the object with the key is indexed
+// as a physically stored one.
+func physicallyIndexed(tx *bbolt.Tx, cnr *cid.ID, key []byte) bool {
+	for _, name := range physicalBucketNames(cnr) {
+		if inBucket(tx, name, key) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,199 @@
+package meta_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"github.com/stretchr/testify/require"
+)
+
+type storedObject struct {
+	obj   *objectSDK.Object
+	blzID *blobovnicza.ID
+}
+
+func storageIterator(objs []storedObject) meta.StorageIterator {
+	return func(f func(*objectSDK.Object, *blobovnicza.ID) error) error {
+		for i := range objs {
+			if err := f(objs[i].obj, objs[i].blzID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func storageLookup(objs []storedObject) meta.StorageLookup {
+	return func(addr *addressSDK.Address, blzID *blobovnicza.ID) (bool, error) {
+		for i := range objs {
+			if object.AddressOf(objs[i].obj).String() != addr.String() {
+				continue
+			}
+
+			if blzID == nil && objs[i].blzID == nil ||
+				blzID != nil && objs[i].blzID != nil && blzID.String() == objs[i].blzID.String() {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+}
+
+func TestDB_Check(t *testing.T) {
+	db := newDB(t)
+
+	cid := cidtest.ID()
+
+	blz1 := blobovnicza.ID("0/0")
+	blz2 := blobovnicza.ID("0/1")
+
+	// consistent objects
+	small := generateObjectWithCID(t, cid)
+	require.NoError(t, meta.Put(db, small, &blz1))
+
+	big := generateObjectWithCID(t, cid)
+	require.NoError(t, putBig(db, big))
+
+	// indexed, but not stored
+	orphan := generateObjectWithCID(t, cid)
+	require.NoError(t, meta.Put(db, orphan, &blz1))
+
+	buried := generateObjectWithCID(t, cid)
+	require.NoError(t, putBig(db, buried))
+
+	tombstone := addressSDK.NewAddress()
+	tombstone.SetContainerID(cid)
+	tombstone.SetObjectID(testOID())
+
+	require.NoError(t, meta.Inhume(db, object.AddressOf(buried), tombstone))
+
+	// stored, but not indexed
+	missing := generateObjectWithCID(t, cid)
+
+	// stored in other place
+	moved := generateObjectWithCID(t, cid)
+	require.NoError(t, meta.Put(db, moved, &blz1))
+
+	grown := generateObjectWithCID(t, cid)
+	require.NoError(t, meta.Put(db, grown, &blz2))
+
+	// buried with tombstone from another container
+	foreignTomb := addressSDK.NewAddress()
+	foreignTomb.SetContainerID(cidtest.ID())
+	foreignTomb.SetObjectID(testOID())
+
+	invalid := generateObjectWithCID(t, cid)
+	require.NoError(t, meta.Put(db, invalid, &blz1))
+	require.NoError(t, meta.Inhume(db, object.AddressOf(invalid), foreignTomb))
+
+	// stored, but removed from the metabase
+	removed := generateObjectWithCID(t, cid)
+	require.NoError(t, meta.Inhume(db, object.AddressOf(removed), tombstone))
+
+	stored := []storedObject{
+		{obj: small, blzID: &blz1},
+		{obj: big},
+		{obj: missing, blzID: &blz2},
+		{obj: moved, blzID: &blz2},
+		{obj: grown},
+		{obj: invalid, blzID: &blz1},
+		{obj: removed, blzID: &blz1},
+	}
+
+	check := func(fix bool) (*meta.CheckRes, map[string]meta.CheckIssue) {
+		issues := make(map[string]meta.CheckIssue)
+
+		res, err := db.Check(new(meta.CheckPrm).
+			WithStorage(storageIterator(stored)).
+			WithStorageLookup(storageLookup(stored)).
+			WithStorageRemover(func(addr *addressSDK.Address, blzID *blobovnicza.ID) error {
+				for i := range stored {
+					if object.AddressOf(stored[i].obj).String() == addr.String() {
+						require.Equal(t, stored[i].blzID, blzID)
+
+						stored = append(append([]storedObject{}, stored[:i]...), stored[i+1:]...)
+
+						return nil
+					}
+				}
+
+				return errors.New("object is not stored")
+			}).
+			WithFix(fix).
+			WithHandler(func(issue meta.CheckIssue) {
+				issues[issue.Key] = issue
+			}),
+		)
+		require.NoError(t, err)
+
+		return res, issues
+	}
+
+	res, issues := check(false)
+	require.Len(t, issues, 7)
+	require.EqualValues(t, 2, res.Found(meta.ProblemOrphanedIndex))
+	require.EqualValues(t, 2, res.Found(meta.ProblemMissingIndex))
+	require.EqualValues(t, 2, res.Found(meta.ProblemWrongBlobovnicza))
+	require.EqualValues(t, 1, res.Found(meta.ProblemInvalidGrave))
+
+	require.Equal(t, meta.ProblemOrphanedIndex, issues[object.AddressOf(orphan).String()].Problem)
+	require.Equal(t, meta.ProblemOrphanedIndex, issues[object.AddressOf(buried).String()].Problem)
+	require.Equal(t, meta.ProblemMissingIndex, issues[object.AddressOf(missing).String()].Problem)
+	require.Equal(t, meta.ProblemMissingIndex, issues[object.AddressOf(removed).String()].Problem)
+	require.Equal(t, meta.ProblemWrongBlobovnicza, issues[object.AddressOf(moved).String()].Problem)
+	require.Equal(t, meta.ProblemWrongBlobovnicza, issues[object.AddressOf(grown).String()].Problem)
+	require.Equal(t, meta.ProblemInvalidGrave, issues[object.AddressOf(invalid).String()].Problem)
+
+	for _, issue := range issues {
+		require.False(t, issue.Fixed)
+	}
+
+	_, issues = check(true)
+	require.Len(t, issues, 7)
+
+	for _, issue := range issues {
+		require.True(t, issue.Fixed, issue.Key)
+	}
+
+	res, issues = check(false)
+	require.Empty(t, issues)
+
+	for p := meta.ProblemOrphanedIndex; p <= meta.ProblemInvalidGrave; p++ {
+		require.Zero(t, res.Found(p))
+	}
+
+	exists, err := meta.Exists(db, object.AddressOf(missing))
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, err = meta.Exists(db, object.AddressOf(orphan))
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// removed object must not be resurrected
+	_, err = meta.Exists(db, object.AddressOf(buried))
+	require.True(t, meta.IsErrRemoved(err))
+
+	_, err = meta.Exists(db, object.AddressOf(removed))
+	require.True(t, meta.IsErrRemoved(err))
+
+	for i := range stored {
+		require.NotEqual(t, object.AddressOf(removed), object.AddressOf(stored[i].obj))
+	}
+
+	blzID, err := meta.IsSmall(db, object.AddressOf(moved))
+	require.NoError(t, err)
+	require.Equal(t, &blz2, blzID)
+
+	blzID, err = meta.IsSmall(db, object.AddressOf(grown))
+	require.NoError(t, err)
+	require.Nil(t, blzID)
+}
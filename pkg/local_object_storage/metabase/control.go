@@ -9,23 +9,59 @@ import (
 	"go.uber.org/zap"
 )
 
-// Open boltDB instance for metabase.
+// Open boltDB instance for metabase. Metabase of the previous versions
+// is upgraded to the current one, unless it is opened in read-only mode.
+//
+// Returns ErrUnsupportedVersion if metabase has been written by a newer
+// version of the storage node.
 func (db *DB) Open() error {
-	err := util.MkdirAllX(filepath.Dir(db.info.Path), db.info.Permission)
-	if err != nil {
-		return fmt.Errorf("can't create dir %s for metabase: %w", db.info.Path, err)
+	return db.open(db.boltOptions)
+}
+
+// OpenReadOnly opens boltDB instance for metabase in read-only mode
+// regardless of the configured options. Metabase is not upgraded
+// and must not be initialized.
+//
+// Returns ErrUnsupportedVersion if metabase has been written by a newer
+// version of the storage node.
+func (db *DB) OpenReadOnly() error {
+	var opts bbolt.Options
+	if db.boltOptions != nil {
+		opts = *db.boltOptions
 	}
 
-	db.log.Debug("created directory for Metabase", zap.String("path", db.info.Path))
+	opts.ReadOnly = true
 
-	db.boltDB, err = bbolt.Open(db.info.Path, db.info.Permission, db.boltOptions)
+	return db.open(&opts)
+}
+
+func (db *DB) open(opts *bbolt.Options) error {
+	readOnly := opts != nil && opts.ReadOnly
+
+	if !readOnly {
+		err := util.MkdirAllX(filepath.Dir(db.info.Path), db.info.Permission)
+		if err != nil {
+			return fmt.Errorf("can't create dir %s for metabase: %w", db.info.Path, err)
+		}
+
+		db.log.Debug("created directory for Metabase", zap.String("path", db.info.Path))
+	}
+
+	var err error
+
+	db.boltDB, err = bbolt.Open(db.info.Path, db.info.Permission, opts)
 	if err != nil {
 		return fmt.Errorf("can't open boltDB database: %w", err)
 	}
 	db.boltDB.MaxBatchDelay = db.boltBatchDelay
 	db.boltDB.MaxBatchSize = db.boltBatchSize
 
-	db.log.Debug("opened boltDB instance for Metabase")
+	db.log.Debug("opened boltDB instance for Metabase", zap.Bool("read-only", readOnly))
+
+	if err := db.upgrade(); err != nil {
+		_ = db.boltDB.Close()
+		return err
+	}
 
 	return nil
 }
@@ -49,6 +85,8 @@ func (db *DB) init(reset bool) error {
 		string(containerVolumeBucketName): {},
 		string(graveyardBucketName):       {},
 		string(toMoveItBucketName):        {},
+		string(addedBucketName):           {},
+		string(addedIndexBucketName):      {},
 	}
 
 	return db.boltDB.Update(func(tx *bbolt.Tx) error {
@@ -69,13 +107,19 @@ func (db *DB) init(reset bool) error {
 			return nil
 		}
 
-		return tx.ForEach(func(name []byte, b *bbolt.Bucket) error {
+		err := tx.ForEach(func(name []byte, b *bbolt.Bucket) error {
 			if _, ok := mStaticBuckets[string(name)]; !ok {
 				return tx.DeleteBucket(name)
 			}
 
 			return nil
 		})
+		if err != nil {
+			return err
+		}
+
+		// reset metabase has the current layout
+		return writeVersion(tx, version)
 	})
 }
 
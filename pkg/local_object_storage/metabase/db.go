@@ -10,6 +10,7 @@ import (
 	"time"
 
 	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	"go.etcd.io/bbolt"
@@ -65,6 +66,10 @@ func New(opts ...Option) *DB {
 			object.MatchStringEqual:    stringEqualMatcher,
 			object.MatchStringNotEqual: stringNotEqualMatcher,
 			object.MatchCommonPrefix:   stringCommonPrefixMatcher,
+			objectcore.MatchNumGT:      numericMatcher(func(cmp int) bool { return cmp > 0 }),
+			objectcore.MatchNumGE:      numericMatcher(func(cmp int) bool { return cmp >= 0 }),
+			objectcore.MatchNumLT:      numericMatcher(func(cmp int) bool { return cmp < 0 }),
+			objectcore.MatchNumLE:      numericMatcher(func(cmp int) bool { return cmp <= 0 }),
 		},
 	}
 }
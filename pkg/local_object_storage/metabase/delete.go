@@ -89,7 +89,7 @@ func (db *DB) delete(tx *bbolt.Tx, addr *addressSDK.Address, refCounter referenc
 	// remove record from graveyard
 	graveyard := tx.Bucket(graveyardBucketName)
 	if graveyard != nil {
-		err := graveyard.Delete(addressKey(addr))
+		err := deleteGrave(tx, graveyard, addressKey(addr))
 		if err != nil {
 			return fmt.Errorf("could not remove from graveyard: %w", err)
 		}
@@ -143,6 +143,13 @@ func (db *DB) deleteObject(
 		return fmt.Errorf("can't remove list indexes: %w", err)
 	}
 
+	if !isParent {
+		err = unlogAddedObject(tx, object.AddressOf(obj))
+		if err != nil {
+			return fmt.Errorf("can't remove object from log of added objects: %w", err)
+		}
+	}
+
 	err = updateFKBTIndexes(tx, obj, delFKBTIndexItem)
 	if err != nil {
 		return fmt.Errorf("can't remove fake bucket tree indexes: %w", err)
@@ -0,0 +1,166 @@
+package meta
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"go.etcd.io/bbolt"
+)
+
+var evacuationKey = []byte("evacuation")
+
+// EvacuationProgress is the progress of the shard evacuation
+// saved in the metabase of the evacuated shard.
+type EvacuationProgress struct {
+	// Cursor is the position of the last evacuated
+	// batch of the metabase listing.
+	Cursor *Cursor
+
+	// WriteCacheDone is true if all objects
+	// of the write-cache have been evacuated.
+	WriteCacheDone bool
+
+	// Evacuated, Replicated and Failed are the object counters
+	// since the beginning of the evacuation.
+	Evacuated, Replicated, Failed uint64
+}
+
+const (
+	evacuationFlagWriteCacheDone = 1 << iota
+	evacuationFlagCursor
+)
+
+var errInvalidEvacuationProgress = errors.New("invalid evacuation progress")
+
+// ReadEvacuationProgress reads the progress of the shard evacuation.
+// If progress is missing, returns nil, nil.
+func (db *DB) ReadEvacuationProgress() (*EvacuationProgress, error) {
+	var data []byte
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(shardInfoBucket)
+		if b != nil {
+			if v := b.Get(evacuationKey); v != nil {
+				data = make([]byte, len(v))
+				copy(data, v)
+			}
+		}
+
+		return nil
+	})
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	p, err := decodeEvacuationProgress(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode evacuation progress: %w", err)
+	}
+
+	return p, nil
+}
+
+// WriteEvacuationProgress saves the progress of the shard evacuation.
+// Nil progress removes the saved one.
+func (db *DB) WriteEvacuationProgress(p *EvacuationProgress) error {
+	return db.boltDB.Update(func(tx *bbolt.Tx) error {
+		if p == nil {
+			if b := tx.Bucket(shardInfoBucket); b != nil {
+				return b.Delete(evacuationKey)
+			}
+
+			return nil
+		}
+
+		b, err := tx.CreateBucketIfNotExists(shardInfoBucket)
+		if err != nil {
+			return err
+		}
+
+		return b.Put(evacuationKey, encodeEvacuationProgress(p))
+	})
+}
+
+func encodeEvacuationProgress(p *EvacuationProgress) []byte {
+	var flags byte
+
+	if p.WriteCacheDone {
+		flags |= evacuationFlagWriteCacheDone
+	}
+
+	if p.Cursor != nil {
+		flags |= evacuationFlagCursor
+	}
+
+	data := make([]byte, 1+3*8, 1+3*8+2*binary.MaxVarintLen64)
+	data[0] = flags
+	binary.LittleEndian.PutUint64(data[1:], p.Evacuated)
+	binary.LittleEndian.PutUint64(data[9:], p.Replicated)
+	binary.LittleEndian.PutUint64(data[17:], p.Failed)
+
+	if p.Cursor != nil {
+		data = appendBytes(data, p.Cursor.bucketName)
+		data = appendBytes(data, p.Cursor.inBucketOffset)
+	}
+
+	return data
+}
+
+func decodeEvacuationProgress(data []byte) (*EvacuationProgress, error) {
+	if len(data) < 1+3*8 {
+		return nil, errInvalidEvacuationProgress
+	}
+
+	p := &EvacuationProgress{
+		WriteCacheDone: data[0]&evacuationFlagWriteCacheDone != 0,
+		Evacuated:      binary.LittleEndian.Uint64(data[1:]),
+		Replicated:     binary.LittleEndian.Uint64(data[9:]),
+		Failed:         binary.LittleEndian.Uint64(data[17:]),
+	}
+
+	if data[0]&evacuationFlagCursor == 0 {
+		return p, nil
+	}
+
+	data = data[1+3*8:]
+
+	bucketName, data, ok := consumeBytes(data)
+	if !ok {
+		return nil, errInvalidEvacuationProgress
+	}
+
+	offset, _, ok := consumeBytes(data)
+	if !ok {
+		return nil, errInvalidEvacuationProgress
+	}
+
+	p.Cursor = &Cursor{
+		bucketName:     bucketName,
+		inBucketOffset: offset,
+	}
+
+	return p, nil
+}
+
+// appendBytes appends v prefixed with its length to data.
+func appendBytes(data, v []byte) []byte {
+	var buf [binary.MaxVarintLen64]byte
+
+	n := binary.PutUvarint(buf[:], uint64(len(v)))
+
+	return append(append(data, buf[:n]...), v...)
+}
+
+// consumeBytes reads the length-prefixed value from data.
+// Returns the value, the rest of data and false if data is invalid.
+func consumeBytes(data []byte) ([]byte, []byte, bool) {
+	ln, n := binary.Uvarint(data)
+	if n <= 0 || uint64(len(data)-n) < ln {
+		return nil, nil, false
+	}
+
+	data = data[n:]
+
+	return data[:ln:ln], data[ln:], true
+}
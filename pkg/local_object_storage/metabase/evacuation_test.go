@@ -0,0 +1,57 @@
+package meta_test
+
+import (
+	"testing"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_EvacuationProgress(t *testing.T) {
+	db := newDB(t)
+
+	p, err := db.ReadEvacuationProgress()
+	require.NoError(t, err)
+	require.Nil(t, p)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, putBig(db, generateObject(t)))
+	}
+
+	_, cursor, err := meta.ListWithCursor(db, 1, nil)
+	require.NoError(t, err)
+
+	expected := &meta.EvacuationProgress{
+		Cursor:         cursor,
+		WriteCacheDone: true,
+		Evacuated:      1,
+		Replicated:     2,
+		Failed:         3,
+	}
+
+	require.NoError(t, db.WriteEvacuationProgress(expected))
+
+	p, err = db.ReadEvacuationProgress()
+	require.NoError(t, err)
+	require.Equal(t, expected, p)
+
+	// listing is continued from the saved cursor
+	next, _, err := meta.ListWithCursor(db, 2, cursor)
+	require.NoError(t, err)
+
+	restored, _, err := meta.ListWithCursor(db, 2, p.Cursor)
+	require.NoError(t, err)
+	require.Equal(t, next, restored)
+
+	require.NoError(t, db.WriteEvacuationProgress(&meta.EvacuationProgress{Evacuated: 1}))
+
+	p, err = db.ReadEvacuationProgress()
+	require.NoError(t, err)
+	require.Equal(t, &meta.EvacuationProgress{Evacuated: 1}, p)
+
+	require.NoError(t, db.WriteEvacuationProgress(nil))
+
+	p, err = db.ReadEvacuationProgress()
+	require.NoError(t, err)
+	require.Nil(t, p)
+}
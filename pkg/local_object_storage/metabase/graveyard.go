@@ -2,9 +2,11 @@ package meta
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 	"go.etcd.io/bbolt"
 )
@@ -76,3 +78,155 @@ func graveFromKV(k, v []byte) (*Grave, error) {
 		addr:   addr,
 	}, nil
 }
+
+// graveyardCountersKey is the key of the graveyard counters
+// in the shard info bucket.
+var graveyardCountersKey = []byte("graveyard")
+
+// GraveyardCounters groups the numbers of the graveyard records.
+type GraveyardCounters struct {
+	// Number of the objects in the graveyard.
+	Graves uint64
+
+	// Number of the objects marked as garbage.
+	Garbage uint64
+}
+
+// GraveyardCounters returns the numbers of the graveyard records.
+// Counters are updated along with the graveyard, so they are
+// read without iterating over the graveyard.
+func (db *DB) GraveyardCounters() (c GraveyardCounters, err error) {
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		c = readGraveyardCounters(tx)
+		return nil
+	})
+
+	return
+}
+
+func readGraveyardCounters(tx *bbolt.Tx) (c GraveyardCounters) {
+	if info := tx.Bucket(shardInfoBucket); info != nil {
+		if data := info.Get(graveyardCountersKey); len(data) == 16 {
+			c.Graves = binary.LittleEndian.Uint64(data)
+			c.Garbage = binary.LittleEndian.Uint64(data[8:])
+		}
+	}
+
+	return
+}
+
+func writeGraveyardCounters(tx *bbolt.Tx, c GraveyardCounters) error {
+	info, err := tx.CreateBucketIfNotExists(shardInfoBucket)
+	if err != nil {
+		return fmt.Errorf("could not create shard info bucket: %w", err)
+	}
+
+	data := make([]byte, 16)
+	binary.LittleEndian.PutUint64(data, c.Graves)
+	binary.LittleEndian.PutUint64(data[8:], c.Garbage)
+
+	return info.Put(graveyardCountersKey, data)
+}
+
+// graveCounters returns the contribution of the grave
+// with value v to the graveyard counters.
+func graveCounters(v []byte) (graves, garbage int) {
+	switch {
+	case v == nil:
+		return 0, 0
+	case bytes.Equal(v, []byte(inhumeGCMarkValue)):
+		return 1, 1
+	default:
+		return 1, 0
+	}
+}
+
+// putGrave puts the grave to the graveyard and updates the counters.
+func putGrave(tx *bbolt.Tx, graveyard *bbolt.Bucket, key, val []byte) error {
+	oldGraves, oldGarbage := graveCounters(graveyard.Get(key))
+
+	if err := graveyard.Put(key, val); err != nil {
+		return err
+	}
+
+	graves, garbage := graveCounters(val)
+
+	return updateGraveyardCounters(tx, graves-oldGraves, garbage-oldGarbage)
+}
+
+// deleteGrave removes the grave from the graveyard and updates the counters.
+func deleteGrave(tx *bbolt.Tx, graveyard *bbolt.Bucket, key []byte) error {
+	graves, garbage := graveCounters(graveyard.Get(key))
+	if graves == 0 {
+		return nil
+	}
+
+	if err := graveyard.Delete(key); err != nil {
+		return err
+	}
+
+	return updateGraveyardCounters(tx, -graves, -garbage)
+}
+
+func updateGraveyardCounters(tx *bbolt.Tx, graves, garbage int) error {
+	if graves == 0 && garbage == 0 {
+		return nil
+	}
+
+	c := readGraveyardCounters(tx)
+	c.Graves = addDelta(c.Graves, graves)
+	c.Garbage = addDelta(c.Garbage, garbage)
+
+	return writeGraveyardCounters(tx, c)
+}
+
+func addDelta(v uint64, d int) uint64 {
+	if d < 0 && uint64(-d) > v {
+		return 0
+	}
+
+	return uint64(int64(v) + int64(d))
+}
+
+// countGraves is the migration filling the graveyard counters.
+// Marker is the key of the last counted grave.
+func countGraves(_ *DB, tx *bbolt.Tx, marker []byte) ([]byte, int, error) {
+	var c GraveyardCounters
+	if marker != nil {
+		c = readGraveyardCounters(tx)
+	}
+
+	var (
+		n    int
+		next []byte
+	)
+
+	if graveyard := tx.Bucket(graveyardBucketName); graveyard != nil {
+		cur := graveyard.Cursor()
+
+		var k, v []byte
+		if marker != nil {
+			k, v = cur.Seek(marker)
+			if bytes.Equal(k, marker) {
+				k, v = cur.Next()
+			}
+		} else {
+			k, v = cur.First()
+		}
+
+		for ; k != nil; k, v = cur.Next() {
+			graves, garbage := graveCounters(v)
+			c.Graves += uint64(graves)
+			c.Garbage += uint64(garbage)
+
+			n++
+
+			if n == migrationBatchSize {
+				next = slice.Copy(k)
+				break
+			}
+		}
+	}
+
+	return next, n, writeGraveyardCounters(tx, c)
+}
@@ -64,3 +64,44 @@ func TestDB_IterateOverGraveyard(t *testing.T) {
 	require.Equal(t, []*addressSDK.Address{object.AddressOf(obj1)}, buriedTS)
 	require.Equal(t, []*addressSDK.Address{object.AddressOf(obj2)}, buriedGC)
 }
+
+func TestDB_GraveyardCounters(t *testing.T) {
+	db := newDB(t)
+
+	check := func(graves, garbage uint64) {
+		c, err := db.GraveyardCounters()
+		require.NoError(t, err)
+		require.Equal(t, meta.GraveyardCounters{Graves: graves, Garbage: garbage}, c)
+	}
+
+	check(0, 0)
+
+	addr1 := generateAddress()
+	addr2 := generateAddress()
+	addr3 := generateAddress()
+
+	_, err := db.Inhume(new(meta.InhumePrm).
+		WithAddresses(addr1, addr2).
+		WithTombstoneAddress(generateAddress()),
+	)
+	require.NoError(t, err)
+
+	check(2, 0)
+
+	_, err = db.Inhume(new(meta.InhumePrm).
+		WithAddresses(addr2, addr3).
+		WithGCMark(),
+	)
+	require.NoError(t, err)
+
+	check(3, 2)
+
+	require.NoError(t, meta.Delete(db, addr1, addr3))
+
+	check(1, 1)
+
+	// deletion of the object without grave does not change counters
+	require.NoError(t, meta.Delete(db, generateAddress()))
+
+	check(1, 1)
+}
@@ -90,7 +90,7 @@ func (db *DB) Inhume(prm *InhumePrm) (res *InhumeRes, err error) {
 			// tombstones can be marked for GC in graveyard, so exclude this case
 			data := graveyard.Get(tombKey)
 			if data != nil && !bytes.Equal(data, []byte(inhumeGCMarkValue)) {
-				err := graveyard.Delete(tombKey)
+				err := deleteGrave(tx, graveyard, tombKey)
 				if err != nil {
 					return fmt.Errorf("could not remove grave with tombstone key: %w", err)
 				}
@@ -158,7 +158,7 @@ func (db *DB) Inhume(prm *InhumePrm) (res *InhumeRes, err error) {
 			}
 
 			// consider checking if target is already in graveyard?
-			err = graveyard.Put(targetKey, tombKey)
+			err = putGrave(tx, graveyard, targetKey, tombKey)
 			if err != nil {
 				return err
 			}
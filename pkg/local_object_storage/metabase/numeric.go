@@ -0,0 +1,220 @@
+package meta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.etcd.io/bbolt"
+)
+
+// numericKeySize is the size of the sortable binary representation of
+// the numeric values: sign byte followed by 8-byte big-endian value.
+const numericKeySize = 9
+
+// numericKey returns binary representation of the decimal integer s
+// which has the same order as the numbers. Values in [-2^63, 2^64)
+// range are supported.
+func numericKey(s string) ([]byte, bool) {
+	key := make([]byte, numericKeySize)
+
+	if n, err := strconv.ParseUint(s, 10, 64); err == nil {
+		key[0] = 1
+		binary.BigEndian.PutUint64(key[1:], n)
+
+		return key, true
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+
+	if n >= 0 {
+		key[0] = 1
+	}
+
+	// two's complement of the negative numbers keeps their order
+	binary.BigEndian.PutUint64(key[1:], uint64(n))
+
+	return key, true
+}
+
+// numericMatcher returns matcher which compares values as numbers
+// and passes the result of comparison to match.
+func numericMatcher(match func(cmp int) bool) func(string, []byte, string) bool {
+	return func(key string, objVal []byte, filterVal string) bool {
+		objNum, ok := numericKey(stringifyValue(key, objVal))
+		if !ok {
+			return false
+		}
+
+		filterNum, ok := numericKey(filterVal)
+
+		return ok && match(bytes.Compare(objNum, filterNum))
+	}
+}
+
+// selectFromNumericIndex looks into ordered numeric <fkbt> index to find
+// list of addresses to add in resulting cache. Only values in the range
+// of the filter are visited.
+func selectFromNumericIndex(
+	tx *bbolt.Tx,
+	name []byte, // numeric fkbt root bucket name
+	f object.SearchFilter, // filter for operation and value
+	prefix string, // prefix to create addr from oid in index
+	to map[string]int, // resulting cache
+	fNum int, // index of filter
+) {
+	bkt := tx.Bucket(name)
+	if bkt == nil {
+		return
+	}
+
+	val, ok := numericKey(f.Value())
+	if !ok {
+		return
+	}
+
+	op := f.Operation()
+	c := bkt.Cursor()
+
+	var k []byte
+
+	switch op {
+	case objectcore.MatchNumGT, objectcore.MatchNumGE:
+		k, _ = c.Seek(val)
+		if op == objectcore.MatchNumGT && bytes.Equal(k, val) {
+			k, _ = c.Next()
+		}
+	default:
+		k, _ = c.First()
+	}
+
+	for ; k != nil; k, _ = c.Next() {
+		cmp := bytes.Compare(k, val)
+		if op == objectcore.MatchNumLT && cmp >= 0 || op == objectcore.MatchNumLE && cmp > 0 {
+			break
+		}
+
+		fkbtLeaf := bkt.Bucket(k)
+		if fkbtLeaf == nil {
+			continue
+		}
+
+		_ = fkbtLeaf.ForEach(func(k, _ []byte) error {
+			markAddressInCache(to, fNum, prefix+string(k))
+
+			return nil
+		})
+	}
+}
+
+// fillNumericIndexes fills numeric indexes of the user attributes from
+// the existing attribute indexes. At most migrationBatchSize objects
+// following the marker are indexed. Marker is the position of the last
+// indexed object: attribute bucket name, attribute value and object key.
+func fillNumericIndexes(_ *DB, tx *bbolt.Tx, marker []byte) ([]byte, int, error) {
+	var afterName, afterVal, afterObj []byte
+
+	if marker != nil {
+		var ok bool
+
+		if afterName, marker, ok = consumeBytes(marker); ok {
+			if afterVal, marker, ok = consumeBytes(marker); ok {
+				afterObj, _, ok = consumeBytes(marker)
+			}
+		}
+
+		if !ok {
+			return nil, 0, errors.New("invalid migration marker")
+		}
+	}
+
+	var (
+		items []namedBucketItem
+		next  []byte
+	)
+
+	// buckets can not be created during iteration,
+	// so the batch is collected first
+	c := tx.Cursor()
+
+	var name []byte
+	if afterName != nil {
+		name, _ = c.Seek(afterName)
+	} else {
+		name, _ = c.First()
+	}
+
+	for ; name != nil && next == nil; name, _ = c.Next() {
+		// container ID can not contain the postfix, attribute key can
+		ind := bytes.Index(name, []byte(userAttributePostfix))
+		if ind <= 0 || bytes.Contains(name[:ind], []byte(invalidBase58String)) {
+			continue
+		}
+
+		numName := append(append([]byte{}, name[:ind]...), numericPostfix...)
+		numName = append(numName, name[ind+len(userAttributePostfix):]...)
+
+		bkt := tx.Bucket(name)
+		vc := bkt.Cursor()
+
+		resume := bytes.Equal(name, afterName)
+
+		var val []byte
+		if resume {
+			val, _ = vc.Seek(afterVal)
+		} else {
+			val, _ = vc.First()
+		}
+
+		for ; val != nil && next == nil; val, _ = vc.Next() {
+			numVal, ok := numericKey(string(val))
+			if !ok {
+				continue
+			}
+
+			fkbtLeaf := bkt.Bucket(val)
+			if fkbtLeaf == nil {
+				continue
+			}
+
+			oc := fkbtLeaf.Cursor()
+
+			objKey, _ := oc.First()
+			if resume && bytes.Equal(val, afterVal) {
+				objKey, _ = oc.Seek(afterObj)
+				if bytes.Equal(objKey, afterObj) {
+					objKey, _ = oc.Next()
+				}
+			}
+
+			for ; objKey != nil; objKey, _ = oc.Next() {
+				items = append(items, namedBucketItem{
+					name: numName,
+					key:  numVal,
+					val:  slice.Copy(objKey),
+				})
+
+				if len(items) == migrationBatchSize {
+					next = appendBytes(appendBytes(appendBytes(nil, name), val), objKey)
+					break
+				}
+			}
+		}
+	}
+
+	for i := range items {
+		if err := putFKBTIndexItem(tx, items[i]); err != nil {
+			return nil, 0, fmt.Errorf("could not fill numeric index %s: %w", items[i].name, err)
+		}
+	}
+
+	return next, len(items), nil
+}
@@ -139,6 +139,13 @@ func (db *DB) put(tx *bbolt.Tx, obj *objectSDK.Object, id *blobovnicza.ID, si *o
 		return fmt.Errorf("can't put list indexes: %w", err)
 	}
 
+	if !isParent {
+		err = logAddedObject(tx, object.AddressOf(obj))
+		if err != nil {
+			return fmt.Errorf("can't log added object: %w", err)
+		}
+	}
+
 	err = updateFKBTIndexes(tx, obj, putFKBTIndexItem)
 	if err != nil {
 		return fmt.Errorf("can't put fake bucket tree indexes: %w", err)
@@ -309,6 +316,18 @@ func updateFKBTIndexes(tx *bbolt.Tx, obj *objectSDK.Object, f updateIndexItemFun
 		if err != nil {
 			return err
 		}
+
+		// numeric values are additionally indexed in the numeric order
+		if numVal, ok := numericKey(attrs[i].Value()); ok {
+			err = f(tx, namedBucketItem{
+				name: numericAttributeBucketName(addr.ContainerID(), attrs[i].Key()),
+				key:  numVal,
+				val:  objKey,
+			})
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -7,9 +7,11 @@ import (
 	"strings"
 
 	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.etcd.io/bbolt"
 	"go.uber.org/zap"
 )
@@ -30,11 +32,15 @@ type (
 type SelectPrm struct {
 	cid     *cid.ID
 	filters object.SearchFilters
+
+	count  int
+	cursor *oidSDK.ID
 }
 
 // SelectRes groups resulting values of Select operation.
 type SelectRes struct {
 	addrList []*addressSDK.Address
+	cursor   *oidSDK.ID
 }
 
 // WithContainerID is a Select option to set the container id to search in.
@@ -55,11 +61,40 @@ func (p *SelectPrm) WithFilters(fs object.SearchFilters) *SelectPrm {
 	return p
 }
 
+// WithCount is a Select option to set the maximum number of the selected
+// objects. If count is set, objects are selected in the order of their
+// identifiers, so the selection can be continued with WithCursor.
+func (p *SelectPrm) WithCount(count uint32) *SelectPrm {
+	if p != nil {
+		p.count = int(count)
+	}
+
+	return p
+}
+
+// WithCursor is a Select option to continue selection after the object with
+// the given identifier. For initial request ignore this param or use nil value.
+// For consecutive requests, use value from SelectRes. Has effect only along
+// with WithCount.
+func (p *SelectPrm) WithCursor(cursor *oidSDK.ID) *SelectPrm {
+	if p != nil {
+		p.cursor = cursor
+	}
+
+	return p
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r *SelectRes) AddressList() []*addressSDK.Address {
 	return r.addrList
 }
 
+// Cursor returns cursor for consecutive select requests. Returns nil
+// if there are no more objects to select or count was not set.
+func (r *SelectRes) Cursor() *oidSDK.ID {
+	return r.cursor
+}
+
 var ErrMissingContainerID = errors.New("missing container id field")
 
 // Select selects the objects from DB with filtering.
@@ -81,7 +116,11 @@ func (db *DB) Select(prm *SelectPrm) (res *SelectRes, err error) {
 	}
 
 	return res, db.boltDB.View(func(tx *bbolt.Tx) error {
-		res.addrList, err = db.selectObjects(tx, prm.cid, prm.filters)
+		if prm.count > 0 {
+			res.addrList, res.cursor, err = db.selectObjectsN(tx, prm.cid, prm.filters, prm.count, prm.cursor)
+		} else {
+			res.addrList, err = db.selectObjects(tx, prm.cid, prm.filters)
+		}
 
 		return err
 	})
@@ -212,9 +251,12 @@ func (db *DB) selectFastFilter(
 	default: // user attribute
 		bucketName := attributeBucketName(cid, f.Header())
 
-		if f.Operation() == object.MatchNotPresent {
+		switch op := f.Operation(); {
+		case op == object.MatchNotPresent:
 			selectOutsideFKBT(tx, allBucketNames(cid), bucketName, f, prefix, to, fNum)
-		} else {
+		case objectcore.IsNumericMatch(op):
+			selectFromNumericIndex(tx, numericAttributeBucketName(cid, f.Header()), f, prefix, to, fNum)
+		default:
 			db.selectFromFKBT(tx, bucketName, f, prefix, to, fNum)
 		}
 	}
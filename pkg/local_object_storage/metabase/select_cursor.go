@@ -0,0 +1,321 @@
+package meta
+
+import (
+	"bytes"
+	"sort"
+
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// selectObjectsN selects at most count objects with identifiers greater than
+// the cursor one in the order of identifiers. Filters are processed by the
+// same indexes as in selectObjects. If one of the filters is served by an
+// index with the keys sorted by object identifiers and the rest of them can
+// be checked by the object key (see matchByKey), the index is read starting
+// from the cursor, so memory usage does not depend on the number of objects
+// in the container. Otherwise, see selectFastFiltersSorted.
+//
+// Returns the identifier of the last selected object if there are more
+// objects to select, nil otherwise.
+func (db *DB) selectObjectsN(tx *bbolt.Tx, cid *cid.ID, fs object.SearchFilters, count int, cursor *oidSDK.ID) ([]*addressSDK.Address, *oidSDK.ID, error) {
+	if cid == nil {
+		return nil, nil, ErrMissingContainerID
+	}
+
+	group, err := groupFilters(fs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// if there are conflicts in query and cid then it means that there is no
+	// objects to match this query.
+	if group.cid != nil && !cid.Equal(group.cid) {
+		return nil, nil, nil
+	}
+
+	var after []byte
+	if cursor != nil {
+		after = objectKey(cursor)
+	}
+
+	var (
+		prefix = cid.String() + "/"
+		res    = make([]*addressSDK.Address, 0, count)
+		more   bool
+	)
+
+	selectKey := func(key []byte) bool {
+		if len(res) == count {
+			more = true
+			return false
+		}
+
+		addr, err := addressFromKey([]byte(prefix + string(key)))
+		if err != nil {
+			return true
+		}
+
+		if inGraveyard(tx, addr) > 0 {
+			return true // ignore removed objects
+		}
+
+		if db.matchSlowFilters(tx, addr, group.slowFilters) {
+			res = append(res, addr)
+		}
+
+		return true
+	}
+
+	if bkts, ok := sortedIndex(tx, cid, group.fastFilters); ok {
+		iterateSorted(bkts, after, selectKey)
+	} else if bkts, rest, ok := sortedIndexWithRest(tx, cid, group.fastFilters); ok {
+		iterateSorted(bkts, after, func(key []byte) bool {
+			for i := range rest {
+				if !matchByKey(tx, cid, rest[i], key) {
+					return true
+				}
+			}
+
+			return selectKey(key)
+		})
+	} else {
+		for _, key := range db.selectFastFiltersSorted(tx, cid, group.fastFilters, prefix, string(after)) {
+			if !selectKey([]byte(key)) {
+				break
+			}
+		}
+	}
+
+	if !more {
+		return res, nil, nil
+	}
+
+	return res, res[len(res)-1].ObjectID(), nil
+}
+
+// selectFastFiltersSorted returns sorted identifiers of the objects greater
+// than after which match all the fast filters.
+//
+// All objects matching the filters are collected and sorted on every call,
+// so memory usage and time of the paged selection are proportional to the
+// number of the matching objects. It is used only if the filters can not be
+// served by the sorted index (see sortedIndexWithRest), e.g. if there are
+// several numeric filters.
+func (db *DB) selectFastFiltersSorted(tx *bbolt.Tx, cid *cid.ID, fs object.SearchFilters, prefix, after string) []string {
+	mAddr := make(map[string]int)
+
+	for i := range fs {
+		db.selectFastFilter(tx, cid, fs[i], mAddr, i)
+	}
+
+	res := make([]string, 0, len(mAddr))
+
+	for a, ind := range mAddr {
+		if ind != len(fs) {
+			continue // ignore objects with unmatched fast filters
+		}
+
+		if key := a[len(prefix):]; key > after {
+			res = append(res, key)
+		}
+	}
+
+	sort.Strings(res)
+
+	return res
+}
+
+// sortedIndex returns buckets which keys are identifiers of the objects
+// matching the fast filters. Returns false if the filters can not be served
+// by the single index with the keys sorted by object identifiers.
+func sortedIndex(tx *bbolt.Tx, cid *cid.ID, fs object.SearchFilters) ([]*bbolt.Bucket, bool) {
+	switch len(fs) {
+	case 0:
+		return existingBuckets(tx, append(physicalBucketNames(cid), parentBucketName(cid))...), true
+	case 1:
+	default:
+		return nil, false
+	}
+
+	switch hdr := fs[0].Header(); hdr {
+	case v2object.FilterPropertyRoot:
+		return existingBuckets(tx, rootBucketName(cid)), true
+	case v2object.FilterPropertyPhy:
+		return existingBuckets(tx, physicalBucketNames(cid)...), true
+	case v2object.FilterHeaderOwnerID:
+		if fs[0].Operation() == object.MatchStringEqual {
+			return fkbtLeaf(tx, ownerBucketName(cid), fs[0].Value()), true
+		}
+	default:
+		if !isSystemKey(hdr) && fs[0].Operation() == object.MatchStringEqual {
+			return fkbtLeaf(tx, attributeBucketName(cid, hdr), fs[0].Value()), true
+		}
+	}
+
+	return nil, false
+}
+
+// sortedIndexWithRest returns buckets of the sorted index of one of the
+// filters (see sortedIndex) and the rest of the filters which can be checked
+// by the object key (see matchByKey). Equality filters are preferred as the
+// most selective ones. Returns false if there is no such filter.
+func sortedIndexWithRest(tx *bbolt.Tx, cid *cid.ID, fs object.SearchFilters) ([]*bbolt.Bucket, object.SearchFilters, bool) {
+	driver := -1
+
+	for i := range fs {
+		if _, ok := sortedIndex(tx, cid, fs[i:i+1]); !ok {
+			continue
+		}
+
+		if driver < 0 || (fs[i].Operation() == object.MatchStringEqual && fs[driver].Operation() != object.MatchStringEqual) {
+			driver = i
+		}
+	}
+
+	if driver < 0 {
+		return nil, nil, false
+	}
+
+	rest := make(object.SearchFilters, 0, len(fs)-1)
+
+	for i := range fs {
+		if i == driver {
+			continue
+		}
+
+		if !matchableByKey(fs[i]) {
+			return nil, nil, false
+		}
+
+		rest = append(rest, fs[i])
+	}
+
+	bkts, _ := sortedIndex(tx, cid, fs[driver:driver+1])
+
+	return bkts, rest, true
+}
+
+// matchableByKey checks if the filter can be checked by matchByKey.
+func matchableByKey(f object.SearchFilter) bool {
+	switch hdr := f.Header(); hdr {
+	case v2object.FilterPropertyRoot, v2object.FilterPropertyPhy, v2object.FilterHeaderObjectType:
+		return true
+	case v2object.FilterHeaderOwnerID:
+		return f.Operation() == object.MatchStringEqual
+	default:
+		return !isSystemKey(hdr) && f.Operation() == object.MatchStringEqual
+	}
+}
+
+// matchByKey checks if the object with the key matches the filter by
+// looking up the key in the filter index. Filter must be matchable by
+// the key (see matchableByKey).
+func matchByKey(tx *bbolt.Tx, cid *cid.ID, f object.SearchFilter, key []byte) bool {
+	var bkts []*bbolt.Bucket
+
+	switch hdr := f.Header(); hdr {
+	case v2object.FilterPropertyRoot:
+		bkts = existingBuckets(tx, rootBucketName(cid))
+	case v2object.FilterPropertyPhy:
+		bkts = existingBuckets(tx, physicalBucketNames(cid)...)
+	case v2object.FilterHeaderObjectType:
+		bkts = existingBuckets(tx, bucketNamesForType(cid, f.Operation(), f.Value())...)
+	case v2object.FilterHeaderOwnerID:
+		bkts = fkbtLeaf(tx, ownerBucketName(cid), f.Value())
+	default:
+		bkts = fkbtLeaf(tx, attributeBucketName(cid, hdr), f.Value())
+	}
+
+	for i := range bkts {
+		// using `get` as `exists`, see inBucket
+		if len(bkts[i].Get(key)) != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// physicalBucketNames returns names of the buckets of physically stored objects.
+func physicalBucketNames(cid *cid.ID) [][]byte {
+	return [][]byte{
+		primaryBucketName(cid),
+		tombstoneBucketName(cid),
+		storageGroupBucketName(cid),
+		bucketNameLockers(*cid),
+	}
+}
+
+func existingBuckets(tx *bbolt.Tx, names ...[]byte) []*bbolt.Bucket {
+	res := make([]*bbolt.Bucket, 0, len(names))
+
+	for i := range names {
+		if bkt := tx.Bucket(names[i]); bkt != nil {
+			res = append(res, bkt)
+		}
+	}
+
+	return res
+}
+
+func fkbtLeaf(tx *bbolt.Tx, name []byte, val string) []*bbolt.Bucket {
+	fkbtRoot := tx.Bucket(name)
+	if fkbtRoot == nil {
+		return nil
+	}
+
+	if leaf := fkbtRoot.Bucket([]byte(val)); leaf != nil {
+		return []*bbolt.Bucket{leaf}
+	}
+
+	return nil
+}
+
+// iterateSorted passes keys of all buckets greater than after to f in
+// ascending order. Keys present in several buckets are passed once.
+// Iteration is stopped if f returns false.
+func iterateSorted(bkts []*bbolt.Bucket, after []byte, f func([]byte) bool) {
+	cs := make([]*bbolt.Cursor, len(bkts))
+	ks := make([][]byte, len(bkts))
+
+	for i := range bkts {
+		cs[i] = bkts[i].Cursor()
+
+		if after == nil {
+			ks[i], _ = cs[i].First()
+			continue
+		}
+
+		ks[i], _ = cs[i].Seek(after)
+		if bytes.Equal(ks[i], after) {
+			ks[i], _ = cs[i].Next()
+		}
+	}
+
+	for {
+		next := -1
+
+		for i := range ks {
+			if ks[i] != nil && (next < 0 || bytes.Compare(ks[i], ks[next]) < 0) {
+				next = i
+			}
+		}
+
+		if next < 0 || !f(ks[next]) {
+			return
+		}
+
+		key := ks[next]
+
+		for i := range ks {
+			if ks[i] != nil && bytes.Equal(ks[i], key) {
+				ks[i], _ = cs[i].Next()
+			}
+		}
+	}
+}
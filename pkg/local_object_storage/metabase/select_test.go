@@ -2,6 +2,8 @@ package meta_test
 
 import (
 	"encoding/hex"
+	"sort"
+	"strconv"
 	"testing"
 
 	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
@@ -10,6 +12,7 @@ import (
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/version"
 	"github.com/stretchr/testify/require"
 )
@@ -136,6 +139,70 @@ func TestDB_SelectUserAttributes(t *testing.T) {
 	)
 }
 
+func TestDB_SelectNumeric(t *testing.T) {
+	db := newDB(t)
+
+	cid := cidtest.ID()
+
+	values := []string{"-10", "0", "5", "10", "18446744073709551615", "abc"}
+	addrs := make([]*addressSDK.Address, len(values))
+
+	for i := range values {
+		raw := generateObjectWithCID(t, cid)
+		addAttribute(raw, objectSDK.AttributeTimestamp, values[i])
+		raw.SetCreationEpoch(uint64(i))
+
+		require.NoError(t, putBig(db, raw))
+
+		addrs[i] = object.AddressOf(raw)
+	}
+
+	testCases := []struct {
+		op  objectSDK.SearchMatchType
+		val string
+		exp []*addressSDK.Address
+	}{
+		{object.MatchNumGT, "5", addrs[3:5]},
+		{object.MatchNumGT, "4", addrs[2:5]},
+		{object.MatchNumGE, "5", addrs[2:5]},
+		{object.MatchNumGE, "-100", addrs[:5]},
+		{object.MatchNumLT, "5", addrs[:2]},
+		{object.MatchNumLT, "-10", nil},
+		{object.MatchNumLE, "-10", addrs[:1]},
+		{object.MatchNumLE, "18446744073709551615", addrs[:5]},
+		{object.MatchNumGT, "abc", nil},
+	}
+
+	for _, tc := range testCases {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter(objectSDK.AttributeTimestamp, tc.val, tc.op)
+
+		testSelect(t, db, cid, fs, tc.exp...)
+	}
+
+	t.Run("creation epoch", func(t *testing.T) {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter(v2object.FilterHeaderCreationEpoch, "3", object.MatchNumGE)
+
+		testSelect(t, db, cid, fs, addrs[3:]...)
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddFilter(v2object.FilterHeaderCreationEpoch, "1", object.MatchNumLT)
+		fs.AddFilter(objectSDK.AttributeTimestamp, "-10", object.MatchNumLE)
+
+		testSelect(t, db, cid, fs, addrs[0])
+	})
+
+	t.Run("deleted object", func(t *testing.T) {
+		require.NoError(t, meta.Delete(db, addrs[3]))
+
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter(objectSDK.AttributeTimestamp, "5", object.MatchNumGT)
+
+		testSelect(t, db, cid, fs, addrs[4])
+	})
+}
+
 func TestDB_SelectRootPhyParent(t *testing.T) {
 	db := newDB(t)
 
@@ -710,3 +777,131 @@ func TestDB_SelectContainerID(t *testing.T) {
 		testSelect(t, db, cid, fs)
 	})
 }
+
+func TestDB_SelectWithCursor(t *testing.T) {
+	db := newDB(t)
+
+	cid := cidtest.ID()
+
+	for i := 0; i < 20; i++ {
+		raw := generateObjectWithCID(t, cid)
+		addAttribute(raw, "parity", strconv.Itoa(i%2))
+		addAttribute(raw, objectSDK.AttributeTimestamp, strconv.Itoa(i))
+
+		if i%5 == 0 {
+			raw.SetType(objectSDK.TypeTombstone)
+		}
+
+		require.NoError(t, putBig(db, raw))
+
+		if i%7 == 0 {
+			tombstone := addressSDK.NewAddress()
+			tombstone.SetContainerID(cid)
+			tombstone.SetObjectID(testOID())
+
+			require.NoError(t, meta.Inhume(db, object.AddressOf(raw), tombstone))
+		}
+	}
+
+	parent := generateObjectWithCID(t, cid)
+	addAttribute(parent, "parity", "0")
+
+	child := generateObjectWithCID(t, cid)
+	child.SetParent(parent)
+	child.SetParentID(parent.ID())
+	require.NoError(t, putBig(db, child))
+
+	// objects of another container must not be selected
+	require.NoError(t, putBig(db, generateObject(t)))
+
+	newFilters := func(key, val string, op objectSDK.SearchMatchType) objectSDK.SearchFilters {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter(key, val, op)
+
+		return fs
+	}
+
+	rootFilters := objectSDK.SearchFilters{}
+	rootFilters.AddRootFilter()
+
+	phyFilters := objectSDK.SearchFilters{}
+	phyFilters.AddPhyFilter()
+
+	typeFilters := objectSDK.SearchFilters{}
+	typeFilters.AddTypeFilter(objectSDK.MatchStringNotEqual, objectSDK.TypeTombstone)
+
+	rootAttrFilters := objectSDK.SearchFilters{}
+	rootAttrFilters.AddRootFilter()
+	rootAttrFilters.AddFilter("parity", "0", objectSDK.MatchStringEqual)
+
+	attrTypeFilters := newFilters("parity", "1", objectSDK.MatchStringEqual)
+	attrTypeFilters.AddTypeFilter(objectSDK.MatchStringNotEqual, objectSDK.TypeTombstone)
+	attrTypeFilters.AddPhyFilter()
+
+	twoAttrFilters := newFilters("parity", "0", objectSDK.MatchStringEqual)
+	twoAttrFilters.AddFilter(objectSDK.AttributeTimestamp, "4", objectSDK.MatchStringEqual)
+
+	// numeric filter can not be checked by the object key,
+	// so all matching objects are selected on every page
+	multiFilters := newFilters("parity", "0", objectSDK.MatchStringEqual)
+	multiFilters.AddTypeFilter(objectSDK.MatchStringNotEqual, objectSDK.TypeTombstone)
+	multiFilters.AddFilter(objectSDK.AttributeTimestamp, "5", object.MatchNumGT)
+
+	testCases := []struct {
+		name string
+		fs   objectSDK.SearchFilters
+	}{
+		{"all", objectSDK.SearchFilters{}},
+		{"attribute EQ", newFilters("parity", "0", objectSDK.MatchStringEqual)},
+		{"attribute NE", newFilters("parity", "0", objectSDK.MatchStringNotEqual)},
+		{"attribute not present", newFilters("parity", "", objectSDK.MatchNotPresent)},
+		{"numeric", newFilters(objectSDK.AttributeTimestamp, "10", object.MatchNumGE)},
+		{"root", rootFilters},
+		{"phy", phyFilters},
+		{"type", typeFilters},
+		{"root and attribute", rootAttrFilters},
+		{"attribute, type and phy", attrTypeFilters},
+		{"two attributes", twoAttrFilters},
+		{"several filters", multiFilters},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			exp, err := meta.Select(db, cid, tc.fs)
+			require.NoError(t, err)
+
+			sort.Slice(exp, func(i, j int) bool {
+				return exp[i].ObjectID().String() < exp[j].ObjectID().String()
+			})
+
+			var (
+				res    []*addressSDK.Address
+				cursor *oidSDK.ID
+			)
+
+			for {
+				r, err := db.Select(new(meta.SelectPrm).
+					WithContainerID(cid).
+					WithFilters(tc.fs).
+					WithCount(3).
+					WithCursor(cursor),
+				)
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(r.AddressList()), 3)
+
+				res = append(res, r.AddressList()...)
+
+				cursor = r.Cursor()
+				if cursor == nil {
+					break
+				}
+			}
+
+			require.Equal(t, len(exp), len(res))
+
+			for i := range exp {
+				require.Equal(t, exp[i].String(), res[i].String())
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package meta
 
 import (
+	"bytes"
+	"fmt"
+
 	"github.com/nspcc-dev/neo-go/pkg/util/slice"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
@@ -72,3 +75,84 @@ func (db *DB) isSmall(tx *bbolt.Tx, addr *addressSDK.Address) (*blobovnicza.ID,
 
 	return blobovnicza.NewIDFromBytes(slice.Copy(blobovniczaID)), nil
 }
+
+// MoveSmallPrm groups the parameters of MoveSmall operation.
+type MoveSmallPrm struct {
+	from *blobovnicza.ID
+
+	addrs []*addressSDK.Address
+	ids   []*blobovnicza.ID
+}
+
+// MoveSmallRes groups resulting values of MoveSmall operation.
+type MoveSmallRes struct {
+	skipped []*addressSDK.Address
+}
+
+// WithSource is a MoveSmall option to set the identifier
+// of the blobovnicza the objects are moved from.
+func (p *MoveSmallPrm) WithSource(id *blobovnicza.ID) *MoveSmallPrm {
+	if p != nil {
+		p.from = id
+	}
+
+	return p
+}
+
+// AddObject adds the object moved to the blobovnicza with provided identifier.
+func (p *MoveSmallPrm) AddObject(addr *addressSDK.Address, id *blobovnicza.ID) *MoveSmallPrm {
+	if p != nil {
+		p.addrs = append(p.addrs, addr)
+		p.ids = append(p.ids, id)
+	}
+
+	return p
+}
+
+// Skipped returns addresses of the objects which blobovnicza identifiers
+// have not been updated since they are not referenced from the source
+// blobovnicza anymore.
+func (r *MoveSmallRes) Skipped() []*addressSDK.Address {
+	return r.skipped
+}
+
+// MoveSmall updates blobovnicza identifiers of the objects moved from the
+// source blobovnicza to other ones. All identifiers are updated in a single
+// transaction. Objects which are not referenced from the source blobovnicza
+// (e.g. removed) are skipped. Objects which are already referenced from the
+// target blobovnicza are left as is, so the call can be repeated.
+func (db *DB) MoveSmall(prm *MoveSmallPrm) (*MoveSmallRes, error) {
+	res := new(MoveSmallRes)
+
+	err := db.boltDB.Update(func(tx *bbolt.Tx) error {
+		res.skipped = res.skipped[:0]
+
+		for i := range prm.addrs {
+			id, err := db.isSmall(tx, prm.addrs[i])
+			if err != nil {
+				return err
+			}
+
+			if id != nil && bytes.Equal(*id, *prm.ids[i]) {
+				// already moved by the previous call
+				continue
+			}
+
+			if id == nil || !bytes.Equal(*id, *prm.from) {
+				res.skipped = append(res.skipped, prm.addrs[i])
+				continue
+			}
+
+			if err := updateBlobovniczaID(tx, prm.addrs[i], prm.ids[i]); err != nil {
+				return fmt.Errorf("could not update blobovnicza ID of %s: %w", prm.addrs[i], err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 	"github.com/stretchr/testify/require"
 )
 
@@ -40,3 +41,52 @@ func TestDB_IsSmall(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, &blobovniczaID, fetchedBlobovniczaID)
 }
+
+func TestDB_MoveSmall(t *testing.T) {
+	db := newDB(t)
+
+	from := blobovnicza.ID{1, 2, 3}
+	to := blobovnicza.ID{4, 5, 6}
+	other := blobovnicza.ID{7, 8, 9}
+
+	raw1 := generateObject(t)
+	raw2 := generateObject(t)
+	raw3 := generateObject(t)
+
+	require.NoError(t, meta.Put(db, raw1, &from))
+	require.NoError(t, meta.Put(db, raw2, &other))
+
+	addr1 := object.AddressOf(raw1)
+	addr2 := object.AddressOf(raw2)
+	addr3 := object.AddressOf(raw3)
+
+	res, err := db.MoveSmall(new(meta.MoveSmallPrm).
+		WithSource(&from).
+		AddObject(addr1, &to).
+		AddObject(addr2, &to).
+		AddObject(addr3, &to))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*addressSDK.Address{addr2, addr3}, res.Skipped())
+
+	id, err := meta.IsSmall(db, addr1)
+	require.NoError(t, err)
+	require.Equal(t, &to, id)
+
+	// object referenced from other blobovnicza must not be updated
+	id, err = meta.IsSmall(db, addr2)
+	require.NoError(t, err)
+	require.Equal(t, &other, id)
+
+	t.Run("repeat", func(t *testing.T) {
+		res, err := db.MoveSmall(new(meta.MoveSmallPrm).
+			WithSource(&from).
+			AddObject(addr1, &to).
+			AddObject(addr2, &to))
+		require.NoError(t, err)
+		require.Equal(t, []*addressSDK.Address{addr2}, res.Skipped())
+
+		id, err := meta.IsSmall(db, addr1)
+		require.NoError(t, err)
+		require.Equal(t, &to, id)
+	})
+}
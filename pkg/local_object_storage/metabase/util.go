@@ -37,6 +37,7 @@ var (
 	splitPostfix        = invalidBase58String + "splitid"
 
 	userAttributePostfix = invalidBase58String + "attr_"
+	numericPostfix       = invalidBase58String + "num_"
 
 	splitInfoError *object.SplitInfoError // for errors.As comparisons
 )
@@ -81,6 +82,11 @@ func cidFromAttributeBucket(val []byte, attributeKey string) []byte {
 	return val[:len(val)-len(suffix)]
 }
 
+// numericAttributeBucketName returns <CID>_num_<attributeKey>.
+func numericAttributeBucketName(cid *cid.ID, attributeKey string) []byte {
+	return []byte(cid.String() + numericPostfix + attributeKey)
+}
+
 // payloadHashBucketName returns <CID>_payloadhash.
 func payloadHashBucketName(cid *cid.ID) []byte {
 	return []byte(cid.String() + payloadHashPostfix)
@@ -0,0 +1,228 @@
+package meta
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+// version is the current version of the metabase layout. It must be
+// increased along with adding the migration from the previous version
+// on every layout change which is incompatible with the stored data.
+const version = 4
+
+// versionKey is the key of the layout version in the shard info bucket.
+var versionKey = []byte("version")
+
+// migrationKey is the key of the position of the interrupted
+// migration in the shard info bucket.
+var migrationKey = []byte("migration")
+
+// migrationBatchSize is the maximum number of items processed
+// by the migration in a single transaction.
+var migrationBatchSize = 10000
+
+// migrationProgressStep is the number of processed items
+// after which migrations log their progress.
+const migrationProgressStep = 100000
+
+// ErrUnsupportedVersion is returned by Open if metabase has been
+// written by a newer version of the storage node.
+var ErrUnsupportedVersion = errors.New("unsupported metabase version")
+
+// migration upgrades metabase layout to the next version.
+type migration struct {
+	desc string
+	// migrate processes at most migrationBatchSize items following the
+	// marker (nil for the first batch) and returns the marker of the last
+	// processed item along with the number of processed items. Returned
+	// marker is nil if there are no more items to process.
+	migrate func(db *DB, tx *bbolt.Tx, marker []byte) ([]byte, int, error)
+}
+
+// migrations[i] upgrades metabase from version i+1 to i+2.
+var migrations = []migration{
+	{
+		desc:    "fill numeric attribute indexes",
+		migrate: fillNumericIndexes,
+	},
+	{
+		desc:    "count graveyard records",
+		migrate: countGraves,
+	},
+	{
+		desc:    "index log of added objects",
+		migrate: indexAddedObjects,
+	},
+}
+
+// upgrade checks the version of the metabase layout and applies the
+// migrations to the current version. Migrations are applied in batches,
+// each batch is committed along with the position of its last item, and
+// the last one along with the version update, so the upgrade is continued
+// from the last committed batch if it has been interrupted.
+func (db *DB) upgrade() error {
+	var (
+		v     uint64
+		empty bool
+	)
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		v = readVersion(tx)
+
+		name, _ := tx.Cursor().First()
+		empty = name == nil
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not read metabase version: %w", err)
+	}
+
+	readOnly := db.boltDB.IsReadOnly()
+
+	switch {
+	case v > version:
+		return fmt.Errorf("%w %d: the latest supported version is %d", ErrUnsupportedVersion, v, version)
+	case v == version:
+		return nil
+	case readOnly:
+		db.log.Warn("metabase has outdated version, upgrade is skipped in read-only mode",
+			zap.Uint64("version", v),
+			zap.Uint64("current version", version),
+		)
+
+		return nil
+	case empty:
+		// new metabase has the current layout
+		return db.boltDB.Update(func(tx *bbolt.Tx) error {
+			return writeVersion(tx, version)
+		})
+	}
+
+	for ; v < version; v++ {
+		m := migrations[v-1]
+
+		db.log.Info("upgrading metabase...",
+			zap.Uint64("from", v),
+			zap.Uint64("to", v+1),
+			zap.String("migration", m.desc),
+		)
+
+		start := time.Now()
+
+		if err := db.migrate(m, v); err != nil {
+			return fmt.Errorf("could not upgrade metabase from version %d: %w", v, err)
+		}
+
+		db.log.Info("metabase upgraded",
+			zap.Uint64("version", v+1),
+			zap.Duration("duration", time.Since(start)),
+		)
+	}
+
+	return nil
+}
+
+// migrate applies migration m upgrading metabase from version v.
+func (db *DB) migrate(m migration, v uint64) error {
+	var (
+		marker    []byte
+		processed int
+	)
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		if info := tx.Bucket(shardInfoBucket); info != nil {
+			if data := info.Get(migrationKey); data != nil {
+				marker = slice.Copy(data)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if marker != nil {
+		db.log.Info("continuing interrupted metabase upgrade")
+	}
+
+	for {
+		var n int
+
+		err := db.boltDB.Update(func(tx *bbolt.Tx) error {
+			var err error
+
+			marker, n, err = m.migrate(db, tx, marker)
+			if err != nil {
+				return err
+			}
+
+			if marker != nil {
+				return writeMigrationMarker(tx, marker)
+			}
+
+			if info := tx.Bucket(shardInfoBucket); info != nil {
+				if err := info.Delete(migrationKey); err != nil {
+					return err
+				}
+			}
+
+			return writeVersion(tx, v+1)
+		})
+		if err != nil {
+			return err
+		}
+
+		if processed/migrationProgressStep != (processed+n)/migrationProgressStep {
+			db.log.Info("metabase upgrade in progress",
+				zap.Int("processed", processed+n),
+			)
+		}
+
+		processed += n
+
+		if marker == nil {
+			return nil
+		}
+	}
+}
+
+func writeMigrationMarker(tx *bbolt.Tx, marker []byte) error {
+	info, err := tx.CreateBucketIfNotExists(shardInfoBucket)
+	if err != nil {
+		return fmt.Errorf("could not create shard info bucket: %w", err)
+	}
+
+	return info.Put(migrationKey, marker)
+}
+
+// readVersion returns version of the metabase layout. Metabases
+// without the version are treated as the first version.
+func readVersion(tx *bbolt.Tx) uint64 {
+	if info := tx.Bucket(shardInfoBucket); info != nil {
+		if data := info.Get(versionKey); len(data) == 8 {
+			return binary.LittleEndian.Uint64(data)
+		}
+	}
+
+	return 1
+}
+
+func writeVersion(tx *bbolt.Tx, v uint64) error {
+	info, err := tx.CreateBucketIfNotExists(shardInfoBucket)
+	if err != nil {
+		return fmt.Errorf("could not create shard info bucket: %w", err)
+	}
+
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, v)
+
+	return info.Put(versionKey, data)
+}
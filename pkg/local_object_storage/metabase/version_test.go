@@ -0,0 +1,182 @@
+package meta
+
+import (
+	"errors"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/address/test"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func TestVersion(t *testing.T) {
+	dir := t.TempDir()
+
+	newDB := func(name string, opts ...Option) *DB {
+		return New(append([]Option{
+			WithPath(filepath.Join(dir, name)),
+			WithPermissions(0600),
+		}, opts...)...)
+	}
+
+	check := func(t *testing.T, db *DB, exp uint64) {
+		require.NoError(t, db.boltDB.View(func(tx *bbolt.Tx) error {
+			require.Equal(t, exp, readVersion(tx))
+			return nil
+		}))
+	}
+
+	t.Run("new metabase", func(t *testing.T) {
+		db := newDB("new")
+		require.NoError(t, db.Open())
+		check(t, db, version)
+		require.NoError(t, db.Init())
+		require.NoError(t, db.Close())
+
+		require.NoError(t, db.Open())
+		check(t, db, version)
+		require.NoError(t, db.Close())
+	})
+
+	t.Run("upgrade", func(t *testing.T) {
+		cid := cidtest.ID()
+		objKey := []byte("object")
+		addr := objecttest.Address()
+
+		db := newDB("old")
+		require.NoError(t, db.Open())
+		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+			// layout of the first version: attribute index only, no version
+			require.NoError(t, tx.DeleteBucket(shardInfoBucket))
+
+			graveyard, err := tx.CreateBucketIfNotExists(graveyardBucketName)
+			require.NoError(t, err)
+			require.NoError(t, graveyard.Put([]byte("grave"), []byte("tombstone")))
+			require.NoError(t, graveyard.Put([]byte("garbage"), []byte(inhumeGCMarkValue)))
+
+			// log of the added objects without the index
+			require.NoError(t, logAddedObject(tx, addr))
+			require.NoError(t, tx.DeleteBucket(addedIndexBucketName))
+
+			return putFKBTIndexItem(tx, namedBucketItem{
+				name: attributeBucketName(cid, "Timestamp"),
+				key:  []byte("10"),
+				val:  objKey,
+			})
+		}))
+		require.NoError(t, db.Close())
+
+		require.NoError(t, db.Open())
+		check(t, db, version)
+
+		numKey, ok := numericKey("10")
+		require.True(t, ok)
+
+		require.NoError(t, db.boltDB.View(func(tx *bbolt.Tx) error {
+			bkt := tx.Bucket(numericAttributeBucketName(cid, "Timestamp"))
+			require.NotNil(t, bkt)
+
+			leaf := bkt.Bucket(numKey)
+			require.NotNil(t, leaf)
+			require.NotNil(t, leaf.Get(objKey))
+
+			require.Equal(t, GraveyardCounters{Graves: 2, Garbage: 1}, readGraveyardCounters(tx))
+
+			require.Equal(t, sequenceKey(1), tx.Bucket(addedIndexBucketName).Get(addressKey(addr)))
+
+			return nil
+		}))
+		require.NoError(t, db.Close())
+	})
+
+	t.Run("interrupted upgrade", func(t *testing.T) {
+		batchSize := migrationBatchSize
+		migrationBatchSize = 2
+
+		defer func() { migrationBatchSize = batchSize }()
+
+		cid := cidtest.ID()
+		attrBkt := attributeBucketName(cid, "Timestamp")
+
+		objKeys := [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("d"), []byte("e")}
+
+		db := newDB("interrupted")
+		require.NoError(t, db.Open())
+		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+			require.NoError(t, tx.DeleteBucket(shardInfoBucket))
+
+			for i := range objKeys {
+				require.NoError(t, putFKBTIndexItem(tx, namedBucketItem{
+					name: attrBkt,
+					key:  []byte(strconv.Itoa(i % 2)),
+					val:  objKeys[i],
+				}))
+			}
+
+			require.NoError(t, putFKBTIndexItem(tx, namedBucketItem{
+				name: attrBkt,
+				key:  []byte("not a number"),
+				val:  []byte("f"),
+			}))
+
+			// the first batch of the upgrade is committed
+			marker, n, err := fillNumericIndexes(db, tx, nil)
+			require.NoError(t, err)
+			require.Equal(t, 2, n)
+			require.NotNil(t, marker)
+
+			return writeMigrationMarker(tx, marker)
+		}))
+		require.NoError(t, db.Close())
+
+		require.NoError(t, db.Open())
+		check(t, db, version)
+
+		require.NoError(t, db.boltDB.View(func(tx *bbolt.Tx) error {
+			require.Nil(t, tx.Bucket(shardInfoBucket).Get(migrationKey))
+
+			bkt := tx.Bucket(numericAttributeBucketName(cid, "Timestamp"))
+			require.NotNil(t, bkt)
+
+			for i := range objKeys {
+				numKey, ok := numericKey(strconv.Itoa(i % 2))
+				require.True(t, ok)
+
+				leaf := bkt.Bucket(numKey)
+				require.NotNil(t, leaf)
+				require.NotNil(t, leaf.Get(objKeys[i]))
+			}
+
+			return nil
+		}))
+		require.NoError(t, db.Close())
+	})
+
+	setVersion := func(t *testing.T, db *DB, v uint64) {
+		require.NoError(t, db.Open())
+		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+			return writeVersion(tx, v)
+		}))
+		require.NoError(t, db.Close())
+	}
+
+	t.Run("newer version", func(t *testing.T) {
+		db := newDB("newer")
+		setVersion(t, db, version+1)
+
+		err := db.Open()
+		require.True(t, errors.Is(err, ErrUnsupportedVersion), err)
+	})
+
+	t.Run("read-only", func(t *testing.T) {
+		setVersion(t, newDB("read-only"), 1)
+
+		db := newDB("read-only", WithBoltDBOptions(&bbolt.Options{ReadOnly: true}))
+		require.NoError(t, db.Open())
+		check(t, db, 1)
+		require.NoError(t, db.Close())
+	})
+}
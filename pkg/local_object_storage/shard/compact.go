@@ -0,0 +1,100 @@
+package shard
+
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+)
+
+// CompactPrm groups the parameters of Compact operation.
+type CompactPrm struct {
+	fillPercent uint32
+}
+
+// CompactRes groups resulting values of Compact operation.
+type CompactRes struct {
+	compacted uint64
+	moved     uint64
+	reclaimed uint64
+}
+
+// WithFillPercent is a Compact option to set the percent of the blobovnicza
+// file size occupied by the stored objects below which blobovnicza is
+// compacted. blobstor.DefaultCompactFillPercent is used if not set.
+func (p *CompactPrm) WithFillPercent(v uint32) *CompactPrm {
+	p.fillPercent = v
+	return p
+}
+
+// Compacted returns the number of compacted blobovniczas.
+func (r *CompactRes) Compacted() uint64 {
+	return r.compacted
+}
+
+// Moved returns the number of objects moved to other blobovniczas.
+func (r *CompactRes) Moved() uint64 {
+	return r.moved
+}
+
+// Reclaimed returns the number of bytes freed on the disk.
+func (r *CompactRes) Reclaimed() uint64 {
+	return r.reclaimed
+}
+
+// Compact rewrites objects from the sparse blobovniczas of the shard to the
+// other ones and removes sparse blobovniczas. Metabase references to the
+// moved objects are updated atomically for each compacted blobovnicza.
+// Objects remain readable during compaction.
+//
+// Shard lock is taken for each blobovnicza separately, so compaction does
+// not block mode switches. It is stopped if the shard leaves "read-write"
+// mode, the interrupted compaction is resumed by the next call.
+//
+// Shard must be in "read-write" mode.
+func (s *Shard) Compact(prm *CompactPrm) (*CompactRes, error) {
+	if err := s.writeModeError(); err != nil {
+		return nil, err
+	}
+
+	bPrm := new(blobstor.CompactPrm)
+	bPrm.SetFillPercent(prm.fillPercent)
+	bPrm.SetHandler(s.updateMovedObjects)
+	bPrm.SetGuard(func() (func(), error) {
+		s.m.RLock()
+
+		if err := s.info.Mode.writeError(); err != nil {
+			s.m.RUnlock()
+			return nil, err
+		}
+
+		return s.m.RUnlock, nil
+	})
+
+	res, err := s.blobStor.Compact(bPrm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CompactRes{
+		compacted: res.Compacted(),
+		moved:     res.Moved(),
+		reclaimed: res.Reclaimed(),
+	}, nil
+}
+
+// updateMovedObjects updates metabase references to the objects
+// moved from the compacted blobovnicza.
+func (s *Shard) updateMovedObjects(from *blobovnicza.ID, moved []blobstor.MovedObject) ([]*addressSDK.Address, error) {
+	prm := new(meta.MoveSmallPrm).WithSource(from)
+	for i := range moved {
+		prm.AddObject(moved[i].Address, moved[i].BlobovniczaID)
+	}
+
+	res, err := s.metaBase.MoveSmall(prm)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Skipped(), nil
+}
@@ -0,0 +1,72 @@
+package shard_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_Compact(t *testing.T) {
+	const objCount = 60
+
+	sh := newCustomShard(t, t.TempDir(), false, nil, []blobstor.Option{
+		blobstor.WithBlobovniczaShallowWidth(4),
+		blobstor.WithBlobovniczaSize(8 * 1024),
+	})
+	defer releaseShard(sh, t)
+
+	objects := make([]*objectSDK.Object, objCount)
+	for i := range objects {
+		objects[i] = generateObjectWithPayload(cidtest.ID(), make([]byte, 1024))
+
+		_, err := sh.Put(new(shard.PutPrm).WithObject(objects[i]))
+		require.NoError(t, err)
+	}
+
+	// remove most of the objects to make blobovniczas sparse
+	var kept []*objectSDK.Object
+	for i := range objects {
+		if i%5 == 0 {
+			kept = append(kept, objects[i])
+			continue
+		}
+
+		_, err := sh.Delete(new(shard.DeletePrm).WithAddresses(object.AddressOf(objects[i])))
+		require.NoError(t, err)
+	}
+
+	res, err := sh.Compact(new(shard.CompactPrm).WithFillPercent(100))
+	require.NoError(t, err)
+	require.NotZero(t, res.Compacted())
+	require.NotZero(t, res.Reclaimed())
+	require.LessOrEqual(t, res.Moved(), uint64(len(kept)))
+
+	for i := range kept {
+		_, err := sh.Get(new(shard.GetPrm).WithAddress(object.AddressOf(kept[i])))
+		require.NoError(t, err, i)
+	}
+
+	t.Run("put after compaction", func(t *testing.T) {
+		for i := 0; i < objCount; i++ {
+			obj := generateObjectWithPayload(cidtest.ID(), make([]byte, 1024))
+
+			_, err := sh.Put(new(shard.PutPrm).WithObject(obj))
+			require.NoError(t, err)
+
+			_, err = sh.Get(new(shard.GetPrm).WithAddress(object.AddressOf(obj)))
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("read-only mode", func(t *testing.T) {
+		require.NoError(t, sh.SetMode(shard.ModeReadOnly))
+
+		_, err := sh.Compact(new(shard.CompactPrm))
+		require.ErrorIs(t, err, shard.ErrReadOnlyMode)
+	})
+}
@@ -27,6 +27,13 @@ func (r *ContainerSizeRes) Size() uint64 {
 }
 
 func (s *Shard) ContainerSize(prm *ContainerSizePrm) (*ContainerSizeRes, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if err := s.info.Mode.metabaseError(); err != nil {
+		return nil, err
+	}
+
 	size, err := s.metaBase.ContainerSize(prm.cid)
 	if err != nil {
 		return nil, fmt.Errorf("could not get container size: %w", err)
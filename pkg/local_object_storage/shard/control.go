@@ -7,17 +7,28 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 )
 
 // Open opens all Shard's components.
+//
+// Components which are not used in the current shard's mode are not opened.
 func (s *Shard) Open() error {
-	components := []interface{ Open() error }{
-		s.blobStor, s.metaBase,
+	var components []interface{ Open() error }
+
+	mode := s.GetMode()
+
+	if mode.blobStorOpened() {
+		components = append(components, s.blobStor)
 	}
 
-	if s.hasWriteCache() {
+	if mode.metabaseOpened() {
+		components = append(components, s.metaBase)
+	}
+
+	if mode.blobStorOpened() && s.hasWriteCache() {
 		components = append(components, s.writeCache)
 	}
 
@@ -26,24 +37,33 @@ func (s *Shard) Open() error {
 			return fmt.Errorf("could not open %T: %w", component, err)
 		}
 	}
+
+	if mode == ModeDegraded {
+		s.openReadOnlyMetabase()
+	}
+
 	return nil
 }
 
 // Init initializes all Shard's components.
 func (s *Shard) Init() error {
-	var fMetabase func() error
+	var components []func() error
+
+	mode := s.GetMode()
 
-	if s.needRefillMetabase() {
-		fMetabase = s.refillMetabase
-	} else {
-		fMetabase = s.metaBase.Init
+	if mode.blobStorOpened() {
+		components = append(components, s.blobStor.Init)
 	}
 
-	components := []func() error{
-		s.blobStor.Init, fMetabase,
+	if mode.metabaseOpened() {
+		if s.needRefillMetabase() {
+			components = append(components, s.refillMetabase)
+		} else {
+			components = append(components, s.metaBase.Init)
+		}
 	}
 
-	if s.hasWriteCache() {
+	if mode.blobStorOpened() && s.hasWriteCache() {
 		components = append(components, s.writeCache.Init)
 	}
 
@@ -56,6 +76,7 @@ func (s *Shard) Init() error {
 	s.gc = &gc{
 		gcCfg:       s.gcCfg,
 		remover:     s.removeGarbage,
+		load:        s.fgOps.Load,
 		stopChannel: make(chan struct{}),
 		mEventHandler: map[eventType]*eventHandlers{
 			eventNewEpoch: {
@@ -71,6 +92,15 @@ func (s *Shard) Init() error {
 
 	s.gc.init()
 
+	if mode.blobStorOpened() && s.hasWriteCache() && mode != ModeReadWrite {
+		s.writeCache.SetMode(writecache.ModeReadOnly)
+	}
+
+	s.updateWeightValues()
+
+	s.weightStop = make(chan struct{})
+	go s.updateWeightValuesLoop(s.weightStop)
+
 	return nil
 }
 
@@ -123,13 +153,28 @@ func (s *Shard) refillMetabase() error {
 
 // Close releases all Shard's components.
 func (s *Shard) Close() error {
-	components := []interface{ Close() error }{}
+	// weight values are sampled from the components,
+	// so sampling is stopped even if they fail to close
+	if s.weightStop != nil {
+		close(s.weightStop)
+		s.weightStop = nil
+	}
 
-	if s.hasWriteCache() {
+	var components []interface{ Close() error }
+
+	mode := s.GetMode()
+
+	if mode.blobStorOpened() && s.hasWriteCache() {
 		components = append(components, s.writeCache)
 	}
 
-	components = append(components, s.blobStor, s.metaBase)
+	if mode.blobStorOpened() {
+		components = append(components, s.blobStor)
+	}
+
+	if mode.metabaseOpened() {
+		components = append(components, s.metaBase)
+	}
 
 	for _, component := range components {
 		if err := component.Close(); err != nil {
@@ -137,7 +182,56 @@ func (s *Shard) Close() error {
 		}
 	}
 
+	if err := s.closeReadOnlyMetabase(); err != nil {
+		return err
+	}
+
 	s.gc.stop()
 
 	return nil
 }
+
+type component interface {
+	Open() error
+	Init() error
+	Close() error
+}
+
+// blobStorComponents returns the BLOB storage and the write-cache (if enabled).
+func (s *Shard) blobStorComponents() []component {
+	components := []component{s.blobStor}
+
+	if s.hasWriteCache() {
+		components = append(components, s.writeCache)
+	}
+
+	return components
+}
+
+// openComponents opens and initializes the components in the direct order.
+func (s *Shard) openComponents(components []component) error {
+	for _, c := range components {
+		if err := c.Open(); err != nil {
+			return fmt.Errorf("could not open %T: %w", c, err)
+		}
+	}
+
+	for _, c := range components {
+		if err := c.Init(); err != nil {
+			return fmt.Errorf("could not initialize %T: %w", c, err)
+		}
+	}
+
+	return nil
+}
+
+// closeComponents closes the components in the reverse order.
+func (s *Shard) closeComponents(components []component) error {
+	for i := len(components) - 1; i >= 0; i-- {
+		if err := components[i].Close(); err != nil {
+			return fmt.Errorf("could not close %T: %w", components[i], err)
+		}
+	}
+
+	return nil
+}
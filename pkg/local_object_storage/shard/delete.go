@@ -31,8 +31,16 @@ func (p *DeletePrm) WithAddresses(addr ...*addressSDK.Address) *DeletePrm {
 // Delete removes data from the shard's writeCache, metaBase and
 // blobStor.
 func (s *Shard) Delete(prm *DeletePrm) (*DeleteRes, error) {
-	if s.GetMode() == ModeReadOnly {
-		return nil, ErrReadOnlyMode
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	return s.delete(prm)
+}
+
+// delete removes the objects. The caller must hold the shard's read lock.
+func (s *Shard) delete(prm *DeletePrm) (*DeleteRes, error) {
+	if err := s.info.Mode.writeError(); err != nil {
+		return nil, err
 	}
 
 	ln := len(prm.addr)
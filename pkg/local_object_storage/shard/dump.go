@@ -1,22 +1,35 @@
 package shard
 
 import (
-	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/dump"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.uber.org/zap"
 )
 
-var dumpMagic = []byte("NEOF")
-
 // DumpPrm groups the parameters of Dump operation.
 type DumpPrm struct {
 	path         string
 	stream       io.Writer
 	ignoreErrors bool
+	compress     bool
+
+	containers map[string]struct{}
+
+	epochFrom, epochTo uint64
+
+	incremental bool
+	checkpoint  uint64
 }
 
 // WithPath is an Dump option to set the destination path.
@@ -39,9 +52,80 @@ func (p *DumpPrm) WithIgnoreErrors(ignore bool) *DumpPrm {
 	return p
 }
 
+// WithCompression is a Dump option to compress the dump with zstd.
+func (p *DumpPrm) WithCompression(compress bool) *DumpPrm {
+	p.compress = compress
+	return p
+}
+
+// WithContainers is a Dump option to dump only the objects
+// of the specified containers. All objects are dumped if
+// the list is empty.
+func (p *DumpPrm) WithContainers(ids []*cid.ID) *DumpPrm {
+	if len(ids) == 0 {
+		p.containers = nil
+		return p
+	}
+
+	p.containers = make(map[string]struct{}, len(ids))
+	for i := range ids {
+		p.containers[ids[i].String()] = struct{}{}
+	}
+
+	return p
+}
+
+// WithEpochRange is a Dump option to dump only the objects created
+// in the epochs from the [from, to] range. Zero to means
+// there is no upper bound.
+func (p *DumpPrm) WithEpochRange(from, to uint64) *DumpPrm {
+	p.epochFrom, p.epochTo = from, to
+	return p
+}
+
+// WithCheckpoint is a Dump option to make the incremental dump which includes
+// only the objects added to the shard after the checkpoint of the previous dump
+// (see DumpRes.Checkpoint). Objects of the write-cache are not dumped: they are
+// added to the shard when flushed, so they are included in the incremental dump
+// following the flush.
+//
+// Incremental dump requires the shard metabase, so it can not be done
+// in "degraded" mode. The checkpoint must not precede the checkpoint of the
+// last full dump, see Dump.
+func (p *DumpPrm) WithCheckpoint(checkpoint uint64) *DumpPrm {
+	p.incremental = true
+	p.checkpoint = checkpoint
+	return p
+}
+
+// filtered returns true if only some objects should be dumped.
+func (p *DumpPrm) filtered() bool {
+	return len(p.containers) != 0 || p.epochFrom != 0 || p.epochTo != 0
+}
+
+// matchContainer checks if the objects of the container should be dumped.
+func (p *DumpPrm) matchContainer(id *cid.ID) bool {
+	if len(p.containers) == 0 {
+		return true
+	}
+
+	_, ok := p.containers[id.String()]
+	return ok
+}
+
+// match checks if the object should be dumped.
+func (p *DumpPrm) match(obj *objectSDK.Object) bool {
+	epoch := obj.CreationEpoch()
+
+	return p.matchContainer(obj.ContainerID()) &&
+		epoch >= p.epochFrom && (p.epochTo == 0 || epoch <= p.epochTo)
+}
+
 // DumpRes groups the result fields of Dump operation.
 type DumpRes struct {
 	count int
+
+	checkpoint uint64
 }
 
 // Count return amount of object written.
@@ -49,16 +133,45 @@ func (r *DumpRes) Count() int {
 	return r.count
 }
 
+// Checkpoint returns the checkpoint of the dump which can be passed
+// to the next incremental dump (see DumpPrm.WithCheckpoint). Zero
+// if the dump was done in "degraded" mode.
+func (r *DumpRes) Checkpoint() uint64 {
+	return r.checkpoint
+}
+
 var ErrMustBeReadOnly = errors.New("shard must be in read-only mode")
 
-// Dump dumps all objects from the shard to a file or stream.
+// ErrCheckpointTruncated is returned when the checkpoint of the incremental
+// dump precedes the checkpoint of the last full dump.
+var ErrCheckpointTruncated = meta.ErrAddedLogTruncated
+
+// Dump dumps objects from the shard to a file or stream. By default,
+// all objects are dumped, see DumpPrm options to make filtered or
+// incremental dumps.
+//
+// Shard must be in "read-only" or "degraded" mode.
 //
+// Full dump without filters truncates the log of the objects added
+// before it, so the next incremental dumps must start from its
+// checkpoint or the later ones.
+//
+// Returns ErrCheckpointTruncated if the checkpoint of the incremental
+// dump precedes the checkpoint of the last full dump.
 // Returns any error encountered.
 func (s *Shard) Dump(prm *DumpPrm) (*DumpRes, error) {
 	s.m.RLock()
 	defer s.m.RUnlock()
 
-	if s.info.Mode != ModeReadOnly {
+	switch s.info.Mode {
+	case ModeReadOnly:
+	case ModeDegraded:
+		if prm.incremental {
+			return nil, ErrDegradedMode
+		}
+	case ModeMaintenance:
+		return nil, ErrMaintenanceMode
+	default:
 		return nil, ErrMustBeReadOnly
 	}
 
@@ -73,58 +186,150 @@ func (s *Shard) Dump(prm *DumpPrm) (*DumpRes, error) {
 		w = f
 	}
 
-	_, err := w.Write(dumpMagic)
+	dw, err := dump.NewWriter(w, prm.compress)
 	if err != nil {
 		return nil, err
 	}
 
-	var count int
+	write := dw.Write
+
+	writeFiltered := write
+	if prm.filtered() {
+		writeFiltered = func(data []byte) error {
+			obj := objectSDK.New()
+			if err := obj.Unmarshal(data); err != nil {
+				if prm.ignoreErrors {
+					return nil
+				}
 
-	if s.hasWriteCache() {
-		err := s.writeCache.Iterate(new(writecache.IterationPrm).WithHandler(func(data []byte) error {
-			var size [4]byte
-			binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
-			if _, err := w.Write(size[:]); err != nil {
-				return err
+				return fmt.Errorf("could not unmarshal object: %w", err)
 			}
 
-			if _, err := w.Write(data); err != nil {
-				return err
+			if !prm.match(obj) {
+				return nil
 			}
 
-			count++
-			return nil
-		}).WithIgnoreErrors(prm.ignoreErrors))
+			return write(data)
+		}
+	}
+
+	if s.hasWriteCache() && !prm.incremental {
+		err := s.writeCache.Iterate(new(writecache.IterationPrm).
+			WithHandler(writeFiltered).
+			WithIgnoreErrors(prm.ignoreErrors))
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	var checkpoint uint64
+
+	if prm.incremental {
+		checkpoint, err = s.dumpAdded(prm, write)
+		if err != nil {
+			return nil, err
+		}
+
+		return finishDump(dw, checkpoint)
+	}
+
+	if s.info.Mode != ModeDegraded {
+		// shard is read-only, so no objects are added during the dump
+		checkpoint, err = s.metaBase.LastAddedCheckpoint()
+		if err != nil {
+			return nil, fmt.Errorf("could not read checkpoint from metabase: %w", err)
+		}
+	}
+
 	var pi blobstor.IteratePrm
 
 	if prm.ignoreErrors {
 		pi.IgnoreErrors()
 	}
 	pi.SetIterationHandler(func(elem blobstor.IterationElement) error {
-		data := elem.ObjectData()
+		return writeFiltered(elem.ObjectData())
+	})
+
+	if _, err := s.blobStor.Iterate(pi); err != nil {
+		return nil, err
+	}
 
-		var size [4]byte
-		binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
-		if _, err := w.Write(size[:]); err != nil {
-			return err
+	res, err := finishDump(dw, checkpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.info.Mode != ModeDegraded && !prm.filtered() {
+		// the log before the full dump is not needed for the incremental dumps
+		if err := s.metaBase.TruncateAdded(checkpoint); err != nil {
+			s.log.Warn("could not truncate the log of the added objects",
+				zap.String("error", err.Error()),
+			)
 		}
+	}
 
-		if _, err := w.Write(data); err != nil {
-			return err
+	return res, nil
+}
+
+// finishDump writes the dump trailer.
+func finishDump(dw *dump.Writer, checkpoint uint64) (*DumpRes, error) {
+	if err := dw.Close(); err != nil {
+		return nil, err
+	}
+
+	return &DumpRes{
+		count:      int(dw.Count()),
+		checkpoint: checkpoint,
+	}, nil
+}
+
+// dumpAdded writes the objects added to the BLOB storage after the checkpoint.
+// Returns the checkpoint of the last added object.
+func (s *Shard) dumpAdded(prm *DumpPrm, write func([]byte) error) (uint64, error) {
+	checkpoint, err := s.metaBase.IterateAdded(prm.checkpoint, func(addr *addressSDK.Address) error {
+		if !prm.matchContainer(addr.ContainerID()) {
+			return nil
+		}
+
+		obj, err := s.getAdded(addr)
+		if err != nil {
+			if errors.As(err, new(apistatus.ObjectNotFound)) {
+				// object has already been removed
+				return nil
+			}
+
+			if prm.ignoreErrors {
+				return nil
+			}
+
+			return fmt.Errorf("could not get object %s: %w", addr, err)
+		}
+
+		if !prm.match(obj) {
+			return nil
+		}
+
+		data, err := obj.Marshal()
+		if err != nil {
+			return fmt.Errorf("could not marshal object %s: %w", addr, err)
 		}
 
-		count++
-		return nil
+		return write(data)
 	})
+	if err != nil {
+		return 0, fmt.Errorf("could not iterate over added objects: %w", err)
+	}
 
-	if _, err := s.blobStor.Iterate(pi); err != nil {
-		return nil, err
+	return checkpoint, nil
+}
+
+// getAdded reads the object from the BLOB storage using
+// the blobovnicza ID stored in the metabase.
+func (s *Shard) getAdded(addr *addressSDK.Address) (*objectSDK.Object, error) {
+	blzID, err := meta.IsSmall(s.metaBase, addr)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch blobovnicza id from metabase: %w", err)
 	}
 
-	return &DumpRes{count: count}, nil
+	return s.getFromBlobStor(addr, blzID)
 }
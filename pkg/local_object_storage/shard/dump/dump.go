@@ -0,0 +1,87 @@
+// Package dump implements the format of the shard dump files.
+//
+// Dump file starts with a header which consists of 4-byte magic, 1-byte
+// format version and 1-byte flags. Header is followed by the stream of
+// records which is optionally compressed with zstd. Each record consists
+// of 4-byte little-endian length, marshaled object and 4-byte little-endian
+// CRC32-C checksum of the object data. Records are followed by the end
+// marker (0xFFFFFFFF length) and the trailer: 8-byte little-endian number
+// of records and SHA256 hash of the data of all records.
+//
+// Legacy dumps (version 0) start with a different magic and contain only
+// length-prefixed objects without checksums and trailer. They can be read,
+// but are never written.
+package dump
+
+import (
+	"crypto/sha256"
+	"errors"
+	"hash"
+	"hash/crc32"
+)
+
+// Version is the version of the dump format written by Writer.
+const Version = 1
+
+// DefaultMaxRecordSize is the default limit of the record size accepted
+// by Reader. It covers the maximum object size of the network with
+// a margin for the object header.
+const DefaultMaxRecordSize = 128 << 20 // 128 MiB
+
+// Flags of the dump header.
+const (
+	// FlagCompressed is set if the record stream is compressed with zstd.
+	FlagCompressed = 1 << iota
+)
+
+var (
+	// legacyMagic is the magic of the dump files of version 0.
+	legacyMagic = []byte("NEOF")
+
+	// magic is the magic of the versioned dump files.
+	magic = []byte("NEOD")
+)
+
+const (
+	magicSize  = 4
+	headerSize = magicSize + 2
+
+	// endMarker is written instead of the record length after the last record.
+	endMarker = 0xFFFFFFFF
+
+	trailerSize = 8 + sha256.Size
+)
+
+var (
+	// ErrInvalidMagic is returned when dump file starts with unknown magic.
+	ErrInvalidMagic = errors.New("invalid magic")
+
+	// ErrUnsupportedVersion is returned when dump format version is not supported.
+	ErrUnsupportedVersion = errors.New("unsupported dump format version")
+
+	// ErrChecksumMismatch is returned when the record checksum does not match its data.
+	ErrChecksumMismatch = errors.New("record checksum mismatch")
+
+	// ErrCountMismatch is returned when the number of records differs from
+	// the one written in the trailer.
+	ErrCountMismatch = errors.New("record count mismatch")
+
+	// ErrHashMismatch is returned when the hash of all records differs from
+	// the one written in the trailer.
+	ErrHashMismatch = errors.New("dump hash mismatch")
+
+	// ErrRecordTooBig is returned when the record can not be written
+	// or the record size exceeds the limit of Reader.
+	ErrRecordTooBig = errors.New("record is too big")
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// checksum returns CRC32-C checksum of data.
+func checksum(data []byte) uint32 {
+	return crc32.Checksum(data, crcTable)
+}
+
+func newHash() hash.Hash {
+	return sha256.New()
+}
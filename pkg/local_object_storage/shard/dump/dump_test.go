@@ -0,0 +1,108 @@
+package dump_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/dump"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriterReader(t *testing.T) {
+	records := [][]byte{{1, 2, 3}, {}, bytes.Repeat([]byte{4}, 1024)}
+
+	write := func(t *testing.T, compress bool) []byte {
+		var buf bytes.Buffer
+
+		w, err := dump.NewWriter(&buf, compress)
+		require.NoError(t, err)
+
+		for i := range records {
+			require.NoError(t, w.Write(records[i]))
+		}
+
+		require.EqualValues(t, len(records), w.Count())
+		require.NoError(t, w.Close())
+
+		return buf.Bytes()
+	}
+
+	readAll := func(data []byte) ([][]byte, error) {
+		r, err := dump.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+
+		var res [][]byte
+		for {
+			rec, err := r.Next()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return res, nil
+				}
+				return res, err
+			}
+
+			res = append(res, append([]byte{}, rec...))
+		}
+	}
+
+	for _, compress := range []bool{false, true} {
+		data := write(t, compress)
+
+		r, err := dump.NewReader(bytes.NewReader(data))
+		require.NoError(t, err)
+		require.Equal(t, dump.Version, r.Version())
+		require.Equal(t, compress, r.Compressed())
+		r.Close()
+
+		res, err := readAll(data)
+		require.NoError(t, err)
+		require.Equal(t, records, res)
+
+		_, err = readAll(data[:len(data)-1])
+		require.Error(t, err)
+	}
+
+	t.Run("corrupted trailer", func(t *testing.T) {
+		data := write(t, false)
+
+		hashCorrupted := append([]byte{}, data...)
+		hashCorrupted[len(hashCorrupted)-1] ^= 0xFF
+
+		_, err := readAll(hashCorrupted)
+		require.True(t, errors.Is(err, dump.ErrHashMismatch), "got: %v", err)
+
+		countCorrupted := append([]byte{}, data...)
+		countCorrupted[len(countCorrupted)-40] ^= 0xFF
+
+		_, err = readAll(countCorrupted)
+		require.True(t, errors.Is(err, dump.ErrCountMismatch), "got: %v", err)
+	})
+
+	t.Run("too big record", func(t *testing.T) {
+		data := write(t, false)
+
+		r, err := dump.NewReader(bytes.NewReader(data))
+		require.NoError(t, err)
+		defer r.Close()
+
+		r.SetMaxRecordSize(uint32(len(records[0])))
+
+		_, err = r.Next()
+		require.NoError(t, err)
+
+		_, err = r.Next()
+		require.NoError(t, err)
+
+		_, err = r.Next()
+		require.True(t, errors.Is(err, dump.ErrRecordTooBig), "got: %v", err)
+
+		// the rest of the dump is not read
+		_, err = r.Next()
+		require.True(t, errors.Is(err, dump.ErrRecordTooBig), "got: %v", err)
+	})
+}
@@ -0,0 +1,221 @@
+package dump
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Reader reads records from the dump file and verifies their integrity.
+type Reader struct {
+	r io.Reader
+
+	dec *zstd.Decoder
+
+	version byte
+	flags   byte
+
+	count uint64
+	hash  hash.Hash
+
+	done bool
+
+	// err is returned by all further calls of Next
+	// after the dump stream becomes unreadable
+	err error
+
+	maxSize uint32
+
+	data []byte
+}
+
+// NewReader reads the dump header from r and returns Reader of the records.
+// Both versioned and legacy dumps are supported.
+//
+// Close must be called after reading is finished.
+func NewReader(r io.Reader) (*Reader, error) {
+	m := make([]byte, magicSize)
+	_, _ = io.ReadFull(r, m)
+
+	res := &Reader{
+		r:       r,
+		hash:    newHash(),
+		maxSize: DefaultMaxRecordSize,
+	}
+
+	switch {
+	case bytes.Equal(m, legacyMagic):
+		return res, nil
+	case !bytes.Equal(m, magic):
+		return nil, ErrInvalidMagic
+	}
+
+	var hdr [2]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return nil, fmt.Errorf("could not read dump header: %w", unexpectedEOF(err))
+	}
+
+	res.version, res.flags = hdr[0], hdr[1]
+
+	if res.version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, res.version)
+	}
+
+	if res.Compressed() {
+		dec, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("could not create zstd decoder: %w", err)
+		}
+
+		res.r = dec
+		res.dec = dec
+	}
+
+	return res, nil
+}
+
+// Version returns the version of the dump format.
+func (r *Reader) Version() int {
+	return int(r.version)
+}
+
+// Compressed returns true if the record stream is compressed.
+func (r *Reader) Compressed() bool {
+	return r.flags&FlagCompressed != 0
+}
+
+// SetMaxRecordSize sets the limit of the record size. Records
+// with a bigger size are rejected before their data is read.
+//
+// DefaultMaxRecordSize is used by default.
+func (r *Reader) SetMaxRecordSize(sz uint32) {
+	r.maxSize = sz
+}
+
+// Count returns the number of records read so far
+// including ones with invalid checksum.
+func (r *Reader) Count() uint64 {
+	return r.count
+}
+
+// Next returns the data of the next record. The data is valid
+// until the next call.
+//
+// Returns io.EOF after the last record if the dump trailer is correct.
+// Returns ErrChecksumMismatch if the record is corrupted, the next
+// records can still be read in this case. Returns ErrRecordTooBig if
+// the record size exceeds the limit, the dump can not be read further.
+func (r *Reader) Next() ([]byte, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if r.done {
+		return nil, io.EOF
+	}
+
+	var buf [4]byte
+
+	// If there are less than 4 bytes left, `Read` returns nil error instead of
+	// io.ErrUnexpectedEOF, thus `ReadFull` is used.
+	_, err := io.ReadFull(r.r, buf[:])
+	if err != nil {
+		if r.version == 0 && errors.Is(err, io.EOF) {
+			r.done = true
+			return nil, io.EOF
+		}
+
+		// versioned dump must end with the trailer
+		return nil, unexpectedEOF(err)
+	}
+
+	sz := binary.LittleEndian.Uint32(buf[:])
+
+	if r.version != 0 && sz == endMarker {
+		r.done = true
+
+		if err := r.readTrailer(); err != nil {
+			return nil, err
+		}
+
+		return nil, io.EOF
+	}
+
+	// size is read from the file, so it is checked before allocation
+	if sz > r.maxSize {
+		r.err = fmt.Errorf("%w: record #%d has size %d, limit is %d",
+			ErrRecordTooBig, r.count+1, sz, r.maxSize)
+		return nil, r.err
+	}
+
+	if uint32(cap(r.data)) < sz {
+		r.data = make([]byte, sz)
+	} else {
+		r.data = r.data[:sz]
+	}
+
+	if _, err := io.ReadFull(r.r, r.data); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+
+	r.count++
+
+	if r.version == 0 {
+		return r.data, nil
+	}
+
+	if _, err := io.ReadFull(r.r, buf[:]); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+
+	r.hash.Write(r.data)
+
+	if binary.LittleEndian.Uint32(buf[:]) != checksum(r.data) {
+		return nil, fmt.Errorf("%w: record #%d", ErrChecksumMismatch, r.count)
+	}
+
+	return r.data, nil
+}
+
+// readTrailer reads the dump trailer and compares it
+// with the records read.
+func (r *Reader) readTrailer() error {
+	buf := make([]byte, trailerSize)
+
+	if _, err := io.ReadFull(r.r, buf); err != nil {
+		return fmt.Errorf("could not read dump trailer: %w", unexpectedEOF(err))
+	}
+
+	if count := binary.LittleEndian.Uint64(buf); count != r.count {
+		return fmt.Errorf("%w: expected %d, read %d", ErrCountMismatch, count, r.count)
+	}
+
+	if !bytes.Equal(buf[8:], r.hash.Sum(nil)) {
+		return ErrHashMismatch
+	}
+
+	return nil
+}
+
+// Close releases resources of the Reader.
+// It does not close the underlying reader.
+func (r *Reader) Close() {
+	if r.dec != nil {
+		r.dec.Close()
+	}
+}
+
+// unexpectedEOF converts io.EOF to io.ErrUnexpectedEOF since
+// versioned dump can not end in the middle of the record.
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
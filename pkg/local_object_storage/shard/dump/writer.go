@@ -0,0 +1,110 @@
+package dump
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Writer writes records to the dump file.
+type Writer struct {
+	w io.Writer
+
+	enc *zstd.Encoder
+
+	count uint64
+	hash  hash.Hash
+}
+
+// NewWriter writes the dump header to w and returns Writer of the records.
+// If compress is true, record stream is compressed with zstd.
+//
+// Close must be called after all records are written.
+func NewWriter(w io.Writer, compress bool) (*Writer, error) {
+	hdr := make([]byte, headerSize)
+	copy(hdr, magic)
+	hdr[magicSize] = Version
+
+	if compress {
+		hdr[magicSize+1] |= FlagCompressed
+	}
+
+	if _, err := w.Write(hdr); err != nil {
+		return nil, fmt.Errorf("could not write dump header: %w", err)
+	}
+
+	res := &Writer{
+		w:    w,
+		hash: newHash(),
+	}
+
+	if compress {
+		// single-threaded encoder does not start any goroutines,
+		// so it does not leak if the dump is interrupted
+		enc, err := zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, fmt.Errorf("could not create zstd encoder: %w", err)
+		}
+
+		res.w = enc
+		res.enc = enc
+	}
+
+	return res, nil
+}
+
+// Write writes the record with data to the dump.
+func (w *Writer) Write(data []byte) error {
+	if uint64(len(data)) >= endMarker {
+		return ErrRecordTooBig
+	}
+
+	var buf [4]byte
+
+	binary.LittleEndian.PutUint32(buf[:], uint32(len(data)))
+	if _, err := w.w.Write(buf[:]); err != nil {
+		return err
+	}
+
+	if _, err := w.w.Write(data); err != nil {
+		return err
+	}
+
+	binary.LittleEndian.PutUint32(buf[:], checksum(data))
+	if _, err := w.w.Write(buf[:]); err != nil {
+		return err
+	}
+
+	w.hash.Write(data)
+	w.count++
+
+	return nil
+}
+
+// Count returns the number of records written.
+func (w *Writer) Count() uint64 {
+	return w.count
+}
+
+// Close writes the dump trailer and flushes compressed data.
+// It does not close the underlying writer.
+func (w *Writer) Close() error {
+	buf := make([]byte, 4+trailerSize)
+
+	binary.LittleEndian.PutUint32(buf, endMarker)
+	binary.LittleEndian.PutUint64(buf[4:], w.count)
+	copy(buf[12:], w.hash.Sum(nil))
+
+	if _, err := w.w.Write(buf); err != nil {
+		return fmt.Errorf("could not write dump trailer: %w", err)
+	}
+
+	if w.enc != nil {
+		return w.enc.Close()
+	}
+
+	return nil
+}
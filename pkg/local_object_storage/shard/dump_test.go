@@ -2,6 +2,7 @@ package shard_test
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"io"
 	"io/ioutil"
@@ -16,7 +17,10 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/dump"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
@@ -132,31 +136,85 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 				require.True(t, errors.Is(err, shard.ErrInvalidMagic), "got: %v", err)
 			})
 
+			t.Run("unsupported version", func(t *testing.T) {
+				out := out + ".wrongversion"
+				require.NoError(t, ioutil.WriteFile(out, []byte{'N', 'E', 'O', 'D', 0xFF, 0}, os.ModePerm))
+
+				_, err := sh.Restore(new(shard.RestorePrm).WithPath(out))
+				require.True(t, errors.Is(err, dump.ErrUnsupportedVersion), "got: %v", err)
+			})
+
 			fileData, err := ioutil.ReadFile(out)
 			require.NoError(t, err)
 
-			t.Run("incomplete size", func(t *testing.T) {
-				out := out + ".wrongsize"
-				fileData := append(fileData, 1)
-				require.NoError(t, ioutil.WriteFile(out, fileData, os.ModePerm))
+			t.Run("truncated", func(t *testing.T) {
+				out := out + ".truncated"
+				require.NoError(t, ioutil.WriteFile(out, fileData[:len(fileData)-1], os.ModePerm))
 
 				_, err := sh.Restore(new(shard.RestorePrm).WithPath(out))
 				require.True(t, errors.Is(err, io.ErrUnexpectedEOF), "got: %v", err)
 			})
-			t.Run("incomplete object data", func(t *testing.T) {
-				out := out + ".wrongsize"
-				fileData := append(fileData, 1, 0, 0, 0)
+			t.Run("corrupted trailer", func(t *testing.T) {
+				fileData := append([]byte{}, fileData...)
+				fileData[len(fileData)-1] ^= 0xFF
+
+				out := out + ".badtrailer"
 				require.NoError(t, ioutil.WriteFile(out, fileData, os.ModePerm))
 
 				_, err := sh.Restore(new(shard.RestorePrm).WithPath(out))
-				require.True(t, errors.Is(err, io.EOF), "got: %v", err)
+				require.True(t, errors.Is(err, dump.ErrHashMismatch), "got: %v", err)
+
+				// hide io.Seeker to restore through the temporary file
+				stream := io.MultiReader(bytes.NewReader(fileData))
+				_, err = sh.Restore(new(shard.RestorePrm).WithStream(stream).WithTempDir(t.TempDir()))
+				require.True(t, errors.Is(err, dump.ErrHashMismatch), "got: %v", err)
+
+				// nothing is restored from the invalid dump
+				for i := range objects {
+					_, err := sh.Get(new(shard.GetPrm).WithAddress(object.AddressOf(objects[i])))
+					require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+				}
 			})
-			t.Run("invalid object", func(t *testing.T) {
-				out := out + ".wrongobj"
-				fileData := append(fileData, 1, 0, 0, 0, 0xFF, 4, 0, 0, 0, 1, 2, 3, 4)
+			t.Run("too big record", func(t *testing.T) {
+				_, err := sh.Restore(new(shard.RestorePrm).WithPath(out).WithMaxObjectSize(1))
+				require.True(t, errors.Is(err, dump.ErrRecordTooBig), "got: %v", err)
+			})
+			t.Run("corrupted record", func(t *testing.T) {
+				out := out + ".corrupted"
+				fileData := append([]byte{}, fileData...)
+				fileData[10] ^= 0xFF // first byte of the first record data
 				require.NoError(t, ioutil.WriteFile(out, fileData, os.ModePerm))
 
 				_, err := sh.Restore(new(shard.RestorePrm).WithPath(out))
+				require.True(t, errors.Is(err, dump.ErrChecksumMismatch), "got: %v", err)
+
+				t.Run("skip errors", func(t *testing.T) {
+					sh := newCustomShard(t, filepath.Join(t.TempDir(), "ignore"), false, nil, nil)
+					defer releaseShard(sh, t)
+
+					res, err := sh.Restore(new(shard.RestorePrm).WithPath(out).WithIgnoreErrors(true))
+					require.NoError(t, err)
+					require.Equal(t, objCount-1, res.Count())
+					require.Equal(t, 1, res.FailCount())
+				})
+			})
+			t.Run("invalid object", func(t *testing.T) {
+				out := out + ".wrongobj"
+
+				var buf bytes.Buffer
+				w, err := dump.NewWriter(&buf, false)
+				require.NoError(t, err)
+				for i := range objects {
+					data, err := objects[i].Marshal()
+					require.NoError(t, err)
+					require.NoError(t, w.Write(data))
+				}
+				require.NoError(t, w.Write([]byte{0xFF}))
+				require.NoError(t, w.Write([]byte{1, 2, 3, 4}))
+				require.NoError(t, w.Close())
+				require.NoError(t, ioutil.WriteFile(out, buf.Bytes(), os.ModePerm))
+
+				_, err = sh.Restore(new(shard.RestorePrm).WithPath(out))
 				require.Error(t, err)
 
 				t.Run("skip errors", func(t *testing.T) {
@@ -171,6 +229,35 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 			})
 		})
 
+		t.Run("legacy format", func(t *testing.T) {
+			sh := newCustomShard(t, filepath.Join(t.TempDir(), "legacy"), false, nil, nil)
+			defer releaseShard(sh, t)
+
+			fileData := []byte("NEOF")
+			for i := range objects {
+				data, err := objects[i].Marshal()
+				require.NoError(t, err)
+
+				var size [4]byte
+				binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
+				fileData = append(fileData, size[:]...)
+				fileData = append(fileData, data...)
+			}
+
+			out := out + ".legacy"
+			require.NoError(t, ioutil.WriteFile(out, fileData, os.ModePerm))
+
+			t.Run("incomplete size", func(t *testing.T) {
+				out := out + ".wrongsize"
+				require.NoError(t, ioutil.WriteFile(out, append(fileData, 1), os.ModePerm))
+
+				_, err := sh.Restore(new(shard.RestorePrm).WithPath(out))
+				require.True(t, errors.Is(err, io.ErrUnexpectedEOF), "got: %v", err)
+			})
+
+			checkRestore(t, sh, new(shard.RestorePrm).WithPath(out), objects)
+		})
+
 		prm := new(shard.RestorePrm).WithPath(out)
 		t.Run("must allow write", func(t *testing.T) {
 			require.NoError(t, sh.SetMode(shard.ModeReadOnly))
@@ -351,3 +438,145 @@ func TestDumpIgnoreErrors(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, objCount, res.Count())
 }
+
+func TestDumpFiltered(t *testing.T) {
+	sh := newShard(t, false)
+	defer releaseShard(sh, t)
+
+	cnr1, cnr2 := cidtest.ID(), cidtest.ID()
+
+	const objPerEpoch = 2
+
+	for epoch := uint64(1); epoch <= 3; epoch++ {
+		for _, cnr := range []*cid.ID{cnr1, cnr2} {
+			for i := 0; i < objPerEpoch; i++ {
+				obj := generateObjectWithCID(t, cnr)
+				obj.SetCreationEpoch(epoch)
+
+				_, err := sh.Put(new(shard.PutPrm).WithObject(obj))
+				require.NoError(t, err)
+			}
+		}
+	}
+
+	require.NoError(t, sh.SetMode(shard.ModeReadOnly))
+
+	dump := func(prm *shard.DumpPrm) int {
+		res, err := sh.Dump(prm.WithPath(filepath.Join(t.TempDir(), "dump")))
+		require.NoError(t, err)
+
+		return res.Count()
+	}
+
+	require.Equal(t, 3*2*objPerEpoch, dump(new(shard.DumpPrm)))
+	require.Equal(t, 3*objPerEpoch, dump(new(shard.DumpPrm).WithContainers([]*cid.ID{cnr1})))
+	require.Equal(t, 2*2*objPerEpoch, dump(new(shard.DumpPrm).WithEpochRange(2, 0)))
+	require.Equal(t, 2*objPerEpoch, dump(new(shard.DumpPrm).WithEpochRange(2, 2)))
+	require.Equal(t, objPerEpoch, dump(new(shard.DumpPrm).
+		WithContainers([]*cid.ID{cnr2}).
+		WithEpochRange(1, 1)))
+}
+
+func TestDumpIncremental(t *testing.T) {
+	sh := newShard(t, false)
+	defer releaseShard(sh, t)
+
+	cnr1, cnr2 := cidtest.ID(), cidtest.ID()
+
+	put := func(n int) {
+		require.NoError(t, sh.SetMode(shard.ModeReadWrite))
+
+		for i := 0; i < n; i++ {
+			for _, cnr := range []*cid.ID{cnr1, cnr2} {
+				_, err := sh.Put(new(shard.PutPrm).WithObject(generateObjectWithCID(t, cnr)))
+				require.NoError(t, err)
+			}
+		}
+
+		require.NoError(t, sh.SetMode(shard.ModeReadOnly))
+	}
+
+	out := filepath.Join(t.TempDir(), "dump")
+
+	put(3)
+
+	res, err := sh.Dump(new(shard.DumpPrm).WithPath(out))
+	require.NoError(t, err)
+	require.Equal(t, 6, res.Count())
+
+	checkpoint := res.Checkpoint()
+
+	res, err = sh.Dump(new(shard.DumpPrm).WithPath(out).WithCheckpoint(checkpoint))
+	require.NoError(t, err)
+	require.Equal(t, 0, res.Count())
+	require.Equal(t, checkpoint, res.Checkpoint())
+
+	put(2)
+
+	res, err = sh.Dump(new(shard.DumpPrm).WithPath(out).WithCheckpoint(checkpoint))
+	require.NoError(t, err)
+	require.Equal(t, 4, res.Count())
+	require.Greater(t, res.Checkpoint(), checkpoint)
+
+	res, err = sh.Dump(new(shard.DumpPrm).WithPath(out).
+		WithCheckpoint(checkpoint).
+		WithContainers([]*cid.ID{cnr1}))
+	require.NoError(t, err)
+	require.Equal(t, 2, res.Count())
+
+	t.Run("truncated log", func(t *testing.T) {
+		res, err := sh.Dump(new(shard.DumpPrm).WithPath(out))
+		require.NoError(t, err)
+		require.Equal(t, 10, res.Count())
+
+		_, err = sh.Dump(new(shard.DumpPrm).WithPath(out).WithCheckpoint(checkpoint))
+		require.ErrorIs(t, err, shard.ErrCheckpointTruncated)
+
+		next, err := sh.Dump(new(shard.DumpPrm).WithPath(out).WithCheckpoint(res.Checkpoint()))
+		require.NoError(t, err)
+		require.Equal(t, 0, next.Count())
+	})
+
+	t.Run("degraded mode", func(t *testing.T) {
+		require.NoError(t, sh.SetMode(shard.ModeDegraded))
+
+		_, err := sh.Dump(new(shard.DumpPrm).WithPath(out).WithCheckpoint(checkpoint))
+		require.True(t, errors.Is(err, shard.ErrDegradedMode), "got: %v", err)
+	})
+}
+
+func TestDumpCompressed(t *testing.T) {
+	sh1 := newCustomShard(t, filepath.Join(t.TempDir(), "shard1"), false, nil, nil)
+	defer releaseShard(sh1, t)
+
+	sh2 := newCustomShard(t, filepath.Join(t.TempDir(), "shard2"), false, nil, nil)
+	defer releaseShard(sh2, t)
+
+	const objCount = 5
+	objects := make([]*objectSDK.Object, objCount)
+	for i := 0; i < objCount; i++ {
+		objects[i] = generateObjectWithCID(t, cidtest.ID())
+
+		_, err := sh1.Put(new(shard.PutPrm).WithObject(objects[i]))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, sh1.SetMode(shard.ModeReadOnly))
+
+	out := filepath.Join(t.TempDir(), "dump")
+
+	res, err := sh1.Dump(new(shard.DumpPrm).WithPath(out).WithCompression(true))
+	require.NoError(t, err)
+	require.Equal(t, objCount, res.Count())
+
+	f, err := os.Open(out)
+	require.NoError(t, err)
+	defer f.Close()
+
+	r, err := dump.NewReader(f)
+	require.NoError(t, err)
+	require.True(t, r.Compressed())
+	r.Close()
+
+	checkRestore(t, sh2, new(shard.RestorePrm).WithPath(out), objects)
+}
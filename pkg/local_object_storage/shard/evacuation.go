@@ -0,0 +1,42 @@
+package shard
+
+import (
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+)
+
+// EvacuationProgress is the progress of the shard evacuation.
+type EvacuationProgress = meta.EvacuationProgress
+
+// EvacuationProgress returns the progress of the shard evacuation
+// saved in the metabase. Returns nil if progress is missing.
+//
+// Returns ErrDegradedMode or ErrMaintenanceMode if the metabase
+// is not used in the current shard's mode.
+func (s *Shard) EvacuationProgress() (*EvacuationProgress, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if err := s.info.Mode.metabaseError(); err != nil {
+		return nil, err
+	}
+
+	return s.metaBase.ReadEvacuationProgress()
+}
+
+// SetEvacuationProgress saves the progress of the shard evacuation
+// in the metabase. Nil progress removes the saved one. Progress is
+// saved in "read-only" mode too, since the evacuated shard does not
+// accept new objects.
+//
+// Returns ErrDegradedMode or ErrMaintenanceMode if the metabase
+// is not used in the current shard's mode.
+func (s *Shard) SetEvacuationProgress(p *EvacuationProgress) error {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if err := s.info.Mode.metabaseError(); err != nil {
+		return err
+	}
+
+	return s.metaBase.WriteEvacuationProgress(p)
+}
@@ -36,6 +36,11 @@ func (p *ExistsRes) Exists() bool {
 //
 // Returns an error of type apistatus.ObjectAlreadyRemoved if object has been marked as removed.
 func (s *Shard) Exists(prm *ExistsPrm) (*ExistsRes, error) {
+	defer s.foreground()()
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
 	exists, err := s.objectExists(prm.addr)
 
 	return &ExistsRes{
@@ -43,6 +48,24 @@ func (s *Shard) Exists(prm *ExistsPrm) (*ExistsRes, error) {
 	}, err
 }
 
+// objectExists checks if object is presented in shard.
+// The caller must hold the shard's read lock.
 func (s *Shard) objectExists(addr *addressSDK.Address) (bool, error) {
+	switch s.info.Mode {
+	case ModeMaintenance:
+		return false, ErrMaintenanceMode
+	case ModeDegraded:
+		_, err := s.headDegraded(addr)
+		if err != nil {
+			if IsErrNotFound(err) {
+				return false, nil
+			}
+
+			return false, err
+		}
+
+		return true, nil
+	}
+
 	return meta.Exists(s.metaBase, addr)
 }
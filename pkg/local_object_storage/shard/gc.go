@@ -2,6 +2,7 @@ package shard
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -60,7 +61,14 @@ type gc struct {
 
 	remover func()
 
+	// load returns the number of the foreground
+	// operations in progress.
+	load func() int32
+
 	mEventHandler map[eventType]*eventHandlers
+
+	statsMtx sync.RWMutex
+	stats    GCStats
 }
 
 type gcCfg struct {
@@ -68,9 +76,59 @@ type gcCfg struct {
 
 	removerInterval time.Duration
 
+	removerMaxInterval time.Duration
+
+	loadThreshold uint32
+
+	quietHours *QuietHours
+
 	log *logger.Logger
 
 	workerPoolInit func(int) util.WorkerPool
+
+	metrics MetricRegister
+}
+
+// QuietHours represents the daily time window in which the GC neither
+// removes objects nor marks expired objects as garbage. Bounds are offsets
+// from the local midnight, the window wraps around the midnight if From > To.
+type QuietHours struct {
+	From, To time.Duration
+}
+
+// contains checks if the time of the day of t is inside the window.
+func (q *QuietHours) contains(t time.Time) bool {
+	y, m, d := t.Date()
+	offset := t.Sub(time.Date(y, m, d, 0, 0, 0, 0, t.Location()))
+
+	if q.From <= q.To {
+		return offset >= q.From && offset < q.To
+	}
+
+	return offset >= q.From || offset < q.To
+}
+
+// GCStats groups the statistics of the shard's garbage collector.
+type GCStats struct {
+	// Number of the objects in the graveyard.
+	GraveyardSize uint64
+
+	// Number of the objects marked as garbage
+	// and waiting to be removed.
+	PendingGarbage uint64
+
+	// Number of the objects removed during the last remover run.
+	LastRemoved uint64
+
+	// Total number of the objects removed since the shard initialization.
+	TotalRemoved uint64
+
+	// Number of the remover runs skipped because of
+	// the foreground load or quiet hours.
+	SkippedRuns uint64
+
+	// Time of the last remover run. Zero if the remover has not run yet.
+	LastRun time.Time
 }
 
 func defaultGCCfg() *gcCfg {
@@ -81,8 +139,9 @@ func defaultGCCfg() *gcCfg {
 		eventChanInit: func() <-chan Event {
 			return ch
 		},
-		removerInterval: 10 * time.Second,
-		log:             zap.L(),
+		removerInterval:    10 * time.Second,
+		removerMaxInterval: 10 * time.Minute,
+		log:                zap.L(),
 		workerPoolInit: func(int) util.WorkerPool {
 			return nil
 		},
@@ -146,7 +205,9 @@ func (gc *gc) listenEvents() {
 }
 
 func (gc *gc) tickRemover() {
-	timer := time.NewTimer(gc.removerInterval)
+	interval := gc.removerInterval
+
+	timer := time.NewTimer(interval)
 	defer timer.Stop()
 
 	for {
@@ -158,10 +219,94 @@ func (gc *gc) tickRemover() {
 			gc.log.Debug("GC is stopped")
 			return
 		case <-timer.C:
-			gc.remover()
-			timer.Reset(gc.removerInterval)
+			interval = gc.nextInterval(interval)
+			timer.Reset(interval)
+		}
+	}
+}
+
+// nextInterval runs the remover if the shard is idle and returns
+// the interval before the next run. If the remover is skipped because
+// of the foreground load, the interval is doubled up to the limit.
+func (gc *gc) nextInterval(cur time.Duration) time.Duration {
+	if gc.quiet() {
+		gc.skipRun()
+		return gc.removerInterval
+	}
+
+	if gc.overloaded() {
+		gc.skipRun()
+
+		cur *= 2
+		if cur > gc.removerMaxInterval {
+			cur = gc.removerMaxInterval
+		}
+
+		if cur < gc.removerInterval {
+			cur = gc.removerInterval
+		}
+
+		gc.log.Debug("GC remover backed off due to foreground load",
+			zap.Duration("interval", cur),
+		)
+
+		return cur
+	}
+
+	gc.remover()
+
+	return gc.removerInterval
+}
+
+// quiet checks if the current time is inside the quiet hours.
+func (gc *gc) quiet() bool {
+	return gc.quietHours != nil && gc.quietHours.contains(time.Now())
+}
+
+// overloaded checks if the number of the foreground operations
+// in progress reached the threshold.
+func (gc *gc) overloaded() bool {
+	return gc.loadThreshold > 0 && gc.load() >= int32(gc.loadThreshold)
+}
+
+// waitIdle blocks until the shard leaves the quiet hours and
+// the foreground load goes below the threshold.
+// Returns false if ctx is done earlier.
+func (gc *gc) waitIdle(ctx context.Context) bool {
+	for gc.quiet() || gc.overloaded() {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(gc.removerInterval):
 		}
 	}
+
+	return ctx.Err() == nil
+}
+
+func (gc *gc) skipRun() {
+	gc.statsMtx.Lock()
+	gc.stats.SkippedRuns++
+	gc.statsMtx.Unlock()
+}
+
+// reportRun saves the results of the remover run in the statistics.
+func (gc *gc) reportRun(id *ID, graves, garbage, removed uint64) {
+	gc.statsMtx.Lock()
+	gc.stats.GraveyardSize = graves
+	gc.stats.PendingGarbage = garbage
+	gc.stats.LastRemoved = removed
+	gc.stats.TotalRemoved += removed
+	gc.stats.LastRun = time.Now()
+	gc.statsMtx.Unlock()
+
+	if gc.metrics != nil {
+		shardID := id.String()
+
+		gc.metrics.SetGCGraveyardSize(shardID, graves)
+		gc.metrics.SetGCPendingGarbage(shardID, garbage)
+		gc.metrics.AddGCRemovedObjects(shardID, removed)
+	}
 }
 
 func (gc *gc) stop() {
@@ -172,9 +317,12 @@ func (gc *gc) stop() {
 
 // iterates over metabase graveyard and deletes objects
 // with GC-marked graves.
-// Does nothing if shard is in "read-only" mode.
+// Does nothing if shard is not in "read-write" mode.
 func (s *Shard) removeGarbage() {
-	if s.GetMode() == ModeReadOnly {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode != ModeReadWrite {
 		return
 	}
 
@@ -198,22 +346,81 @@ func (s *Shard) removeGarbage() {
 			zap.String("error", err.Error()),
 		)
 
-		return
-	} else if len(buf) == 0 {
 		return
 	}
 
-	// delete accumulated objects
-	_, err = s.Delete(new(DeletePrm).
-		WithAddresses(buf...),
-	)
+	var removed uint64
+
+	if len(buf) != 0 {
+		// delete accumulated objects
+		_, err = s.delete(new(DeletePrm).
+			WithAddresses(buf...),
+		)
+		if err != nil {
+			s.log.Warn("could not delete the objects",
+				zap.String("error", err.Error()),
+			)
+
+			return
+		}
+
+		removed = uint64(len(buf))
+	}
+
+	c, err := s.metaBase.GraveyardCounters()
 	if err != nil {
-		s.log.Warn("could not delete the objects",
+		s.log.Warn("could not read graveyard counters",
 			zap.String("error", err.Error()),
 		)
 
 		return
 	}
+
+	s.gc.reportRun(s.ID(), c.Graves, c.Garbage, removed)
+}
+
+// GCStats returns the statistics of the shard's garbage collector.
+// Graveyard size and number of the pending garbage objects
+// are read from the counters kept by the metabase.
+//
+// Returns ErrDegradedMode or ErrMaintenanceMode if the metabase
+// is not used in the current shard's mode.
+func (s *Shard) GCStats() (*GCStats, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if err := s.info.Mode.metabaseError(); err != nil {
+		return nil, err
+	}
+
+	c, err := s.metaBase.GraveyardCounters()
+	if err != nil {
+		return nil, fmt.Errorf("could not read graveyard counters: %w", err)
+	}
+
+	var stats GCStats
+
+	if s.gc != nil {
+		s.gc.statsMtx.RLock()
+		stats = s.gc.stats
+		s.gc.statsMtx.RUnlock()
+	}
+
+	stats.GraveyardSize = c.Graves
+	stats.PendingGarbage = c.Garbage
+
+	return &stats, nil
+}
+
+// foreground marks the start of the foreground operation and returns
+// the function marking its end. GC backs off while the number of the
+// foreground operations in progress is above the threshold.
+func (s *Shard) foreground() func() {
+	s.fgOps.Inc()
+
+	return func() {
+		s.fgOps.Dec()
+	}
 }
 
 func (s *Shard) collectExpiredObjects(ctx context.Context, e Event) {
@@ -227,18 +434,47 @@ func (s *Shard) collectExpiredObjects(ctx context.Context, e Event) {
 		return
 	}
 
-	// inhume the collected objects
-	_, err = s.metaBase.Inhume(new(meta.InhumePrm).
-		WithAddresses(expired...).
+	// inhume the collected objects in batches, so as not
+	// to compete with the foreground operations
+	for len(expired) > 0 {
+		if !s.gc.waitIdle(ctx) {
+			return
+		}
+
+		batch := expired
+		if len(batch) > s.rmBatchSize {
+			batch = batch[:s.rmBatchSize]
+		}
+
+		expired = expired[len(batch):]
+
+		err = s.markGarbage(batch)
+		if err != nil {
+			s.log.Warn("could not inhume the objects",
+				zap.String("error", err.Error()),
+			)
+
+			return
+		}
+	}
+}
+
+// markGarbage marks the objects to be removed by GC.
+// Does nothing if the metabase is closed in the current shard's mode.
+func (s *Shard) markGarbage(addrs []*addressSDK.Address) error {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if !s.info.Mode.metabaseOpened() {
+		return nil
+	}
+
+	_, err := s.metaBase.Inhume(new(meta.InhumePrm).
+		WithAddresses(addrs...).
 		WithGCMark(),
 	)
-	if err != nil {
-		s.log.Warn("could not inhume the objects",
-			zap.String("error", err.Error()),
-		)
 
-		return
-	}
+	return err
 }
 
 func (s *Shard) collectExpiredTombstones(ctx context.Context, e Event) {
@@ -270,6 +506,13 @@ func (s *Shard) collectExpiredLocks(ctx context.Context, e Event) {
 }
 
 func (s *Shard) getExpiredObjects(ctx context.Context, epoch uint64, typeCond func(object.Type) bool) ([]*addressSDK.Address, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if !s.info.Mode.metabaseOpened() {
+		return nil, nil
+	}
+
 	var expired []*addressSDK.Address
 
 	err := s.metaBase.IterateExpired(epoch, func(expiredObject *meta.ExpiredObject) error {
@@ -294,7 +537,15 @@ func (s *Shard) getExpiredObjects(ctx context.Context, epoch uint64, typeCond fu
 // If successful, marks tombstones themselves as garbage.
 //
 // Does not modify tss.
+// Does nothing if the metabase is closed in the current shard's mode.
 func (s *Shard) HandleExpiredTombstones(tss map[string]*addressSDK.Address) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if !s.info.Mode.metabaseOpened() {
+		return
+	}
+
 	inhume := make([]*addressSDK.Address, 0, len(tss))
 
 	// Collect all objects covered by the tombstones.
@@ -354,7 +605,15 @@ func (s *Shard) HandleExpiredTombstones(tss map[string]*addressSDK.Address) {
 
 // HandleExpiredLocks unlocks all objects which were locked by lockers.
 // If successful, marks lockers themselves as garbage.
+// Does nothing if the metabase is closed in the current shard's mode.
 func (s *Shard) HandleExpiredLocks(lockers []*addressSDK.Address) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if !s.info.Mode.metabaseOpened() {
+		return
+	}
+
 	err := s.metaBase.FreeLockedBy(lockers)
 	if err != nil {
 		s.log.Warn("failure to unlock objects",
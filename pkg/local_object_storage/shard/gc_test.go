@@ -0,0 +1,143 @@
+package shard
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestQuietHours(t *testing.T) {
+	at := func(h, m int) time.Time {
+		return time.Date(2022, 1, 1, h, m, 0, 0, time.Local)
+	}
+
+	q := &QuietHours{From: 2 * time.Hour, To: 6 * time.Hour}
+	require.False(t, q.contains(at(1, 59)))
+	require.True(t, q.contains(at(2, 0)))
+	require.True(t, q.contains(at(5, 59)))
+	require.False(t, q.contains(at(6, 0)))
+
+	q = &QuietHours{From: 22 * time.Hour, To: 2 * time.Hour}
+	require.False(t, q.contains(at(21, 59)))
+	require.True(t, q.contains(at(23, 0)))
+	require.True(t, q.contains(at(0, 30)))
+	require.False(t, q.contains(at(2, 0)))
+}
+
+func TestGC_NextInterval(t *testing.T) {
+	var (
+		load int32
+		runs int
+	)
+
+	gc := &gc{
+		gcCfg: &gcCfg{
+			removerInterval:    time.Second,
+			removerMaxInterval: 5 * time.Second,
+			loadThreshold:      2,
+			log:                zap.L(),
+		},
+		remover: func() { runs++ },
+		load:    func() int32 { return load },
+	}
+
+	require.Equal(t, time.Second, gc.nextInterval(time.Second))
+	require.Equal(t, 1, runs)
+
+	load = 2
+
+	cur := time.Second
+	for _, exp := range []time.Duration{2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		cur = gc.nextInterval(cur)
+		require.Equal(t, exp, cur)
+	}
+
+	require.Equal(t, 1, runs)
+	require.EqualValues(t, 4, gc.stats.SkippedRuns)
+
+	load = 1
+
+	require.Equal(t, time.Second, gc.nextInterval(cur))
+	require.Equal(t, 2, runs)
+
+	now := time.Now()
+	y, m, d := now.Date()
+	offset := now.Sub(time.Date(y, m, d, 0, 0, 0, 0, now.Location()))
+
+	gc.quietHours = &QuietHours{From: offset - time.Hour, To: offset + time.Hour}
+	if gc.quietHours.From < 0 {
+		gc.quietHours.From += 24 * time.Hour
+	}
+	gc.quietHours.To %= 24 * time.Hour
+
+	require.Equal(t, time.Second, gc.nextInterval(cur))
+	require.Equal(t, 2, runs)
+	require.EqualValues(t, 5, gc.stats.SkippedRuns)
+}
+
+func TestShard_GCStats(t *testing.T) {
+	p := t.TempDir()
+
+	sh := New(
+		WithBlobStorOptions(
+			blobstor.WithRootPath(filepath.Join(p, "blob")),
+			blobstor.WithBlobovniczaShallowWidth(1),
+			blobstor.WithBlobovniczaShallowDepth(1),
+		),
+		WithMetaBaseOptions(meta.WithPath(filepath.Join(p, "meta"))),
+		WithGCRemoverSleepInterval(10*time.Millisecond),
+		WithGCLoadThreshold(1),
+	)
+
+	// do not let the remover run before the graveyard is filled
+	sh.fgOps.Store(1)
+
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+
+	defer sh.Close()
+
+	const objNum = 5
+
+	objs := make([]*objectSDK.Object, objNum)
+	for i := range objs {
+		objs[i] = objecttest.Object()
+		objs[i].SetType(objectSDK.TypeRegular)
+
+		_, err := sh.Put(new(PutPrm).WithObject(objs[i]))
+		require.NoError(t, err)
+	}
+
+	ts := object.AddressOf(objecttest.Object())
+
+	_, err := sh.Inhume(new(InhumePrm).WithTarget(ts, object.AddressOf(objs[0]), object.AddressOf(objs[1])))
+	require.NoError(t, err)
+
+	_, err = sh.Inhume(new(InhumePrm).MarkAsGarbage(object.AddressOf(objs[2]), object.AddressOf(objs[3])))
+	require.NoError(t, err)
+
+	stats, err := sh.GCStats()
+	require.NoError(t, err)
+	require.EqualValues(t, 4, stats.GraveyardSize)
+	require.EqualValues(t, 2, stats.PendingGarbage)
+	require.Zero(t, stats.TotalRemoved)
+
+	sh.fgOps.Store(0)
+
+	require.Eventually(t, func() bool {
+		stats, err = sh.GCStats()
+		return err == nil && stats.TotalRemoved == 2
+	}, 3*time.Second, 10*time.Millisecond)
+
+	require.EqualValues(t, 2, stats.GraveyardSize)
+	require.Zero(t, stats.PendingGarbage)
+	require.False(t, stats.LastRun.IsZero())
+}
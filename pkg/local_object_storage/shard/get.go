@@ -66,7 +66,13 @@ func (r *GetRes) HasMeta() bool {
 //
 // Returns an error of type apistatus.ObjectNotFound if requested object is missing in shard.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if requested object has been marked as removed in shard.
+// Returns ErrMaintenanceMode error if shard is in "maintenance" mode.
 func (s *Shard) Get(prm *GetPrm) (*GetRes, error) {
+	defer s.foreground()()
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
 	var big, small storFetcher
 
 	big = func(stor *blobstor.BlobStor, _ *blobovnicza.ID) (*objectSDK.Object, error) {
@@ -103,12 +109,27 @@ func (s *Shard) Get(prm *GetPrm) (*GetRes, error) {
 }
 
 // fetchObjectData looks through writeCache and blobStor to find object.
+// The caller must hold the shard's read lock.
+//
+// Metabase is used in ModeDegraded only to check
+// removed objects regardless of skipMeta.
 func (s *Shard) fetchObjectData(addr *addressSDK.Address, skipMeta bool, big, small storFetcher) (*objectSDK.Object, bool, error) {
 	var (
 		err error
 		res *objectSDK.Object
 	)
 
+	switch s.info.Mode {
+	case ModeMaintenance:
+		return nil, false, ErrMaintenanceMode
+	case ModeDegraded:
+		if err := s.checkRemoved(addr); err != nil {
+			return nil, false, err
+		}
+
+		skipMeta = true
+	}
+
 	if s.hasWriteCache() {
 		res, err = s.writeCache.Get(addr)
 		if err == nil {
@@ -155,3 +176,72 @@ func (s *Shard) fetchObjectData(addr *addressSDK.Address, skipMeta bool, big, sm
 
 	return res, true, err
 }
+
+// checkRemoved returns an error of type apistatus.ObjectAlreadyRemoved
+// or apistatus.ObjectNotFound if the object has been marked as removed
+// in the metabase opened in ModeDegraded. Other metabase errors are
+// ignored, since the metabase may be broken in this mode.
+func (s *Shard) checkRemoved(addr *addressSDK.Address) error {
+	if !s.readOnlyMeta {
+		return nil
+	}
+
+	_, err := meta.Exists(s.metaBase, addr)
+	if IsErrRemoved(err) || IsErrNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// headFromBlobStor reads the object header from the blobovniczas,
+// and from the shallow dir if the object is not found there.
+// Metabase is not used.
+func (s *Shard) headFromBlobStor(addr *addressSDK.Address) (*objectSDK.Object, error) {
+	smallPrm := new(blobstor.GetSmallPrm)
+	smallPrm.SetAddress(addr)
+	smallPrm.SetHeaderOnly(true)
+
+	smallRes, err := s.blobStor.GetSmall(smallPrm)
+	if err == nil {
+		return smallRes.Object(), nil
+	}
+
+	bigPrm := new(blobstor.GetBigPrm)
+	bigPrm.SetAddress(addr)
+	bigPrm.SetHeaderOnly(true)
+
+	bigRes, err := s.blobStor.GetBig(bigPrm)
+	if err != nil {
+		return nil, err
+	}
+
+	return bigRes.Object(), nil
+}
+
+// getFromBlobStor reads the object from the blobovnicza
+// if blzID is set, and from the shallow dir otherwise.
+func (s *Shard) getFromBlobStor(addr *addressSDK.Address, blzID *blobovnicza.ID) (*objectSDK.Object, error) {
+	if blzID != nil {
+		getPrm := new(blobstor.GetSmallPrm)
+		getPrm.SetAddress(addr)
+		getPrm.SetBlobovniczaID(blzID)
+
+		res, err := s.blobStor.GetSmall(getPrm)
+		if err != nil {
+			return nil, err
+		}
+
+		return res.Object(), nil
+	}
+
+	getPrm := new(blobstor.GetBigPrm)
+	getPrm.SetAddress(addr)
+
+	res, err := s.blobStor.GetBig(getPrm)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Object(), nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.uber.org/zap"
 )
 
 // HeadPrm groups the parameters of Head operation.
@@ -53,7 +54,30 @@ func (r *HeadRes) Object() *objectSDK.Object {
 //
 // Returns an error of type apistatus.ObjectNotFound if object is missing in Shard.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if requested object has been marked as removed in shard.
+// Returns ErrMaintenanceMode error if shard is in "maintenance" mode.
+//
+// In "degraded" mode header is read from the object stored in blobstor,
+// so raw flag is not taken into account.
 func (s *Shard) Head(prm *HeadPrm) (*HeadRes, error) {
+	defer s.foreground()()
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	switch s.info.Mode {
+	case ModeMaintenance:
+		return nil, ErrMaintenanceMode
+	case ModeDegraded:
+		header, err := s.headDegraded(prm.addr)
+		if err != nil {
+			return nil, err
+		}
+
+		return &HeadRes{
+			obj: header,
+		}, nil
+	}
+
 	// object can be saved in write-cache (if enabled) or in metabase
 
 	if s.hasWriteCache() {
@@ -84,3 +108,22 @@ func (s *Shard) Head(prm *HeadPrm) (*HeadRes, error) {
 		obj: res.Header(),
 	}, nil
 }
+
+// headDegraded reads the object header from the write-cache (if enabled)
+// or from the BLOB storage directly. Object payload is not read.
+func (s *Shard) headDegraded(addr *addressSDK.Address) (*objectSDK.Object, error) {
+	if err := s.checkRemoved(addr); err != nil {
+		return nil, err
+	}
+
+	if s.hasWriteCache() {
+		header, err := s.writeCache.Head(addr)
+		if err == nil {
+			return header, nil
+		} else if !writecache.IsErrNotFound(err) {
+			s.log.Error("failed to read header from write-cache", zap.String("error", err.Error()))
+		}
+	}
+
+	return s.headFromBlobStor(addr)
+}
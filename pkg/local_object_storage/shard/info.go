@@ -32,5 +32,8 @@ type Info struct {
 
 // DumpInfo returns information about the Shard.
 func (s *Shard) DumpInfo() Info {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
 	return s.info
 }
@@ -49,10 +49,14 @@ func (p *InhumePrm) MarkAsGarbage(addr ...*addressSDK.Address) *InhumePrm {
 // Allows inhuming non-locked objects only. Returns apistatus.ObjectLocked
 // if at least one object is locked.
 //
-// Returns ErrReadOnlyMode error if shard is in "read-only" mode.
+// Returns ErrReadOnlyMode, ErrDegradedMode or ErrMaintenanceMode error
+// if shard is not in "read-write" mode.
 func (s *Shard) Inhume(prm *InhumePrm) (*InhumeRes, error) {
-	if s.GetMode() == ModeReadOnly {
-		return nil, ErrReadOnlyMode
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if err := s.info.Mode.writeError(); err != nil {
+		return nil, err
 	}
 
 	if s.hasWriteCache() {
@@ -66,6 +66,13 @@ func (r ListWithCursorRes) Cursor() *Cursor {
 
 // List returns all objects physically stored in the Shard.
 func (s *Shard) List() (*SelectRes, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if err := s.info.Mode.metabaseError(); err != nil {
+		return nil, err
+	}
+
 	lst, err := s.metaBase.Containers()
 	if err != nil {
 		return nil, fmt.Errorf("can't list stored containers: %w", err)
@@ -92,6 +99,13 @@ func (s *Shard) List() (*SelectRes, error) {
 }
 
 func (s *Shard) ListContainers(_ *ListContainersPrm) (*ListContainersRes, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if err := s.info.Mode.metabaseError(); err != nil {
+		return nil, err
+	}
+
 	containers, err := s.metaBase.Containers()
 	if err != nil {
 		return nil, fmt.Errorf("could not get list of containers: %w", err)
@@ -118,6 +132,13 @@ func ListContainers(s *Shard) ([]*cid.ID, error) {
 // Returns ErrEndOfListing if there are no more objects to return or count
 // parameter set to zero.
 func (s *Shard) ListWithCursor(prm *ListWithCursorPrm) (*ListWithCursorRes, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if err := s.info.Mode.metabaseError(); err != nil {
+		return nil, err
+	}
+
 	metaPrm := new(meta.ListPrm).WithCount(prm.count).WithCursor(prm.cursor)
 	res, err := s.metaBase.ListWithCursor(metaPrm)
 	if err != nil {
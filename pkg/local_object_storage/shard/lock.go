@@ -14,8 +14,11 @@ import (
 //
 // Locked list should be unique. Panics if it is empty.
 func (s *Shard) Lock(idCnr cid.ID, locker oid.ID, locked []oid.ID) error {
-	if s.GetMode() == ModeReadOnly {
-		return ErrReadOnlyMode
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if err := s.info.Mode.writeError(); err != nil {
+		return err
 	}
 
 	err := s.metaBase.Lock(idCnr, locker, locked)
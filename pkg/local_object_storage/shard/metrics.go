@@ -0,0 +1,14 @@
+package shard
+
+// MetricRegister represents Shard's metric register.
+type MetricRegister interface {
+	// SetGCGraveyardSize registers the number of the objects
+	// in the graveyard of the shard.
+	SetGCGraveyardSize(shardID string, n uint64)
+	// SetGCPendingGarbage registers the number of the objects
+	// marked as garbage and waiting to be removed from the shard.
+	SetGCPendingGarbage(shardID string, n uint64)
+	// AddGCRemovedObjects registers the number of the objects
+	// removed from the shard by the GC run.
+	AddGCRemovedObjects(shardID string, n uint64)
+}
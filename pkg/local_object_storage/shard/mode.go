@@ -2,8 +2,10 @@ package shard
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	"go.uber.org/zap"
 )
 
 // Mode represents enumeration of Shard work modes.
@@ -13,6 +15,14 @@ type Mode uint32
 // that changes shard's memory due to the "read-only" shard's mode.
 var ErrReadOnlyMode = errors.New("shard is in read-only mode")
 
+// ErrDegradedMode is returned when it is impossible to apply operation
+// that requires the metabase due to the "degraded" shard's mode.
+var ErrDegradedMode = errors.New("shard is in degraded mode")
+
+// ErrMaintenanceMode is returned when it is impossible to apply any operation
+// due to the "maintenance" shard's mode.
+var ErrMaintenanceMode = errors.New("shard is in maintenance mode")
+
 const (
 	// ModeReadWrite is a Mode value for shard that is available
 	// for read and write operations. Default shard mode.
@@ -21,6 +31,16 @@ const (
 	// ModeReadOnly is a Mode value for shard that does not
 	// accept write operation but is readable.
 	ModeReadOnly
+
+	// ModeDegraded is a Mode value for shard that does not use
+	// the metabase. Objects are read from the BLOB storage directly,
+	// write operations and metabase-dependent requests are not accepted.
+	// Metabase is opened in read-only mode to check removed objects only.
+	ModeDegraded
+
+	// ModeMaintenance is a Mode value for shard with all components
+	// closed. Shard does not accept any operation in this mode.
+	ModeMaintenance
 )
 
 func (m Mode) String() string {
@@ -31,23 +51,88 @@ func (m Mode) String() string {
 		return "READ_WRITE"
 	case ModeReadOnly:
 		return "READ_ONLY"
+	case ModeDegraded:
+		return "DEGRADED"
+	case ModeMaintenance:
+		return "MAINTENANCE"
+	}
+}
+
+// metabaseOpened returns true if the metabase is opened in the mode.
+func (m Mode) metabaseOpened() bool {
+	return m == ModeReadWrite || m == ModeReadOnly
+}
+
+// blobStorOpened returns true if the BLOB storage and the write-cache
+// are opened in the mode.
+func (m Mode) blobStorOpened() bool {
+	return m != ModeMaintenance
+}
+
+// writeError returns an error which must be returned
+// by the modifying operation in the mode, nil if the
+// operation is allowed.
+func (m Mode) writeError() error {
+	switch m {
+	default:
+		return nil
+	case ModeReadOnly:
+		return ErrReadOnlyMode
+	case ModeDegraded:
+		return ErrDegradedMode
+	case ModeMaintenance:
+		return ErrMaintenanceMode
+	}
+}
+
+// metabaseError returns an error which must be returned by the operation
+// that requires the metabase in the mode, nil if the operation is allowed.
+func (m Mode) metabaseError() error {
+	switch m {
+	default:
+		return nil
+	case ModeDegraded:
+		return ErrDegradedMode
+	case ModeMaintenance:
+		return ErrMaintenanceMode
 	}
 }
 
 // SetMode sets mode of the shard.
 //
+// Shard's components are closed or reopened if the new
+// mode requires it: metabase is opened in read-only mode in
+// ModeDegraded, all components are closed in ModeMaintenance.
+// Mode is switched after all the operations in progress
+// are completed.
+//
 // Returns any error encountered that did not allow
 // setting shard mode.
 func (s *Shard) SetMode(m Mode) error {
 	s.m.Lock()
 	defer s.m.Unlock()
 
-	if s.hasWriteCache() {
-		switch m {
-		case ModeReadOnly:
-			s.writeCache.SetMode(writecache.ModeReadOnly)
-		case ModeReadWrite:
+	old := s.info.Mode
+
+	// write-cache must not flush objects
+	// if the metabase is closed
+	if s.hasWriteCache() && old == ModeReadWrite && m != ModeReadWrite {
+		s.writeCache.SetMode(writecache.ModeReadOnly)
+	}
+
+	if err := s.switchComponents(old, m); err != nil {
+		if s.hasWriteCache() && old == ModeReadWrite {
+			s.writeCache.SetMode(writecache.ModeReadWrite)
+		}
+
+		return err
+	}
+
+	if s.hasWriteCache() && m.blobStorOpened() {
+		if m == ModeReadWrite {
 			s.writeCache.SetMode(writecache.ModeReadWrite)
+		} else {
+			s.writeCache.SetMode(writecache.ModeReadOnly)
 		}
 	}
 
@@ -56,6 +141,81 @@ func (s *Shard) SetMode(m Mode) error {
 	return nil
 }
 
+// switchComponents closes components which are not used in the new mode
+// and opens ones which were closed in the old mode.
+func (s *Shard) switchComponents(old, m Mode) error {
+	if old == ModeDegraded && m != ModeDegraded {
+		if err := s.closeReadOnlyMetabase(); err != nil {
+			return err
+		}
+	}
+
+	if old.blobStorOpened() && !m.blobStorOpened() {
+		if err := s.closeComponents(s.blobStorComponents()); err != nil {
+			return err
+		}
+	}
+
+	if old.metabaseOpened() && !m.metabaseOpened() {
+		if err := s.metaBase.Close(); err != nil {
+			return fmt.Errorf("could not close metabase: %w", err)
+		}
+	}
+
+	if !old.blobStorOpened() && m.blobStorOpened() {
+		if err := s.openComponents(s.blobStorComponents()); err != nil {
+			return err
+		}
+	}
+
+	if !old.metabaseOpened() && m.metabaseOpened() {
+		if err := s.metaBase.Open(); err != nil {
+			return fmt.Errorf("could not open metabase: %w", err)
+		}
+
+		if err := s.metaBase.Init(); err != nil {
+			return fmt.Errorf("could not initialize metabase: %w", err)
+		}
+	}
+
+	if old != ModeDegraded && m == ModeDegraded {
+		s.openReadOnlyMetabase()
+	}
+
+	return nil
+}
+
+// openReadOnlyMetabase opens the metabase in read-only mode, so that
+// removed objects are not read in ModeDegraded. The metabase may be
+// broken in this mode, so the failure is logged and objects are read
+// without the check.
+func (s *Shard) openReadOnlyMetabase() {
+	if err := s.metaBase.OpenReadOnly(); err != nil {
+		s.log.Warn("could not open metabase in read-only mode, removed objects are not checked",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	s.readOnlyMeta = true
+}
+
+// closeReadOnlyMetabase closes the metabase opened by openReadOnlyMetabase.
+func (s *Shard) closeReadOnlyMetabase() error {
+	if !s.readOnlyMeta {
+		return nil
+	}
+
+	if err := s.metaBase.Close(); err != nil {
+		return fmt.Errorf("could not close read-only metabase: %w", err)
+	}
+
+	s.readOnlyMeta = false
+
+	return nil
+}
+
 // GetMode returns mode of the shard.
 func (s *Shard) GetMode() Mode {
 	s.m.RLock()
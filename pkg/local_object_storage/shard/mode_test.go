@@ -0,0 +1,104 @@
+package shard_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_SetMode(t *testing.T) {
+	t.Run("without write cache", func(t *testing.T) {
+		testShardSetMode(t, false)
+	})
+
+	t.Run("with write cache", func(t *testing.T) {
+		testShardSetMode(t, true)
+	})
+}
+
+func testShardSetMode(t *testing.T, hasWriteCache bool) {
+	sh := newShard(t, hasWriteCache)
+	defer releaseShard(sh, t)
+
+	obj := generateObject(t)
+	addPayload(obj, 1<<5)
+
+	_, err := sh.Put(new(shard.PutPrm).WithObject(obj))
+	require.NoError(t, err)
+
+	removed := generateObject(t)
+
+	_, err = sh.Put(new(shard.PutPrm).WithObject(removed))
+	require.NoError(t, err)
+
+	_, err = sh.Inhume(new(shard.InhumePrm).WithTarget(object.AddressOf(generateObject(t)), object.AddressOf(removed)))
+	require.NoError(t, err)
+
+	addr := object.AddressOf(obj)
+	selectPrm := new(shard.SelectPrm).
+		WithContainerID(addr.ContainerID()).
+		WithFilters(objectSDK.NewSearchFilters())
+
+	t.Run("degraded", func(t *testing.T) {
+		require.NoError(t, sh.SetMode(shard.ModeDegraded))
+		require.Equal(t, shard.ModeDegraded, sh.GetMode())
+
+		res, err := sh.Get(new(shard.GetPrm).WithAddress(addr))
+		require.NoError(t, err)
+		require.Equal(t, obj, res.Object())
+		require.False(t, res.HasMeta())
+
+		hRes, err := sh.Head(new(shard.HeadPrm).WithAddress(addr))
+		require.NoError(t, err)
+		require.Equal(t, obj.CutPayload(), hRes.Object())
+
+		eRes, err := sh.Exists(new(shard.ExistsPrm).WithAddress(addr))
+		require.NoError(t, err)
+		require.True(t, eRes.Exists())
+
+		_, err = sh.Get(new(shard.GetPrm).WithAddress(object.AddressOf(removed)))
+		require.True(t, shard.IsErrRemoved(err), "got: %v", err)
+
+		_, err = sh.Head(new(shard.HeadPrm).WithAddress(object.AddressOf(removed)))
+		require.True(t, shard.IsErrRemoved(err), "got: %v", err)
+
+		_, err = sh.Select(selectPrm)
+		require.True(t, errors.Is(err, shard.ErrDegradedMode), "got: %v", err)
+
+		_, err = sh.Put(new(shard.PutPrm).WithObject(generateObject(t)))
+		require.True(t, errors.Is(err, shard.ErrDegradedMode), "got: %v", err)
+	})
+
+	t.Run("maintenance", func(t *testing.T) {
+		require.NoError(t, sh.SetMode(shard.ModeMaintenance))
+		require.Equal(t, shard.ModeMaintenance, sh.GetMode())
+
+		_, err := sh.Get(new(shard.GetPrm).WithAddress(addr))
+		require.True(t, errors.Is(err, shard.ErrMaintenanceMode), "got: %v", err)
+
+		_, err = sh.Head(new(shard.HeadPrm).WithAddress(addr))
+		require.True(t, errors.Is(err, shard.ErrMaintenanceMode), "got: %v", err)
+
+		_, err = sh.Select(selectPrm)
+		require.True(t, errors.Is(err, shard.ErrMaintenanceMode), "got: %v", err)
+	})
+
+	t.Run("read-write", func(t *testing.T) {
+		require.NoError(t, sh.SetMode(shard.ModeReadWrite))
+
+		res, err := sh.Get(new(shard.GetPrm).WithAddress(addr))
+		require.NoError(t, err)
+		require.Equal(t, obj, res.Object())
+
+		sRes, err := sh.Select(selectPrm)
+		require.NoError(t, err)
+		require.Len(t, sRes.AddressList(), 1)
+
+		_, err = sh.Put(new(shard.PutPrm).WithObject(generateObject(t)))
+		require.NoError(t, err)
+	})
+}
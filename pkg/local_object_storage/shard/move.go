@@ -27,8 +27,11 @@ func (p *ToMoveItPrm) WithAddress(addr *addressSDK.Address) *ToMoveItPrm {
 // ToMoveIt calls metabase.ToMoveIt method to mark object as relocatable to
 // another shard.
 func (s *Shard) ToMoveIt(prm *ToMoveItPrm) (*ToMoveItRes, error) {
-	if s.GetMode() == ModeReadOnly {
-		return nil, ErrReadOnlyMode
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if err := s.info.Mode.writeError(); err != nil {
+		return nil, err
 	}
 
 	err := meta.ToMoveIt(s.metaBase, prm.addr)
@@ -0,0 +1,132 @@
+package shard
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// probePayloadSize is the payload size of the object
+// written to the BLOB storage during the shard probe.
+const probePayloadSize = 64
+
+var errProbeDataMismatch = errors.New("read probe object differs from the written one")
+
+// Probe checks that the shard components can be read and written:
+// shard identifier is read from the metabase and written back, and
+// a small object is written to the BLOB storage, read and removed.
+//
+// Probe object is never registered in the metabase, so it is not
+// visible to the shard users.
+//
+// Returns ErrDegradedMode or ErrMaintenanceMode if the shard
+// is in the corresponding mode.
+func (s *Shard) Probe() error {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	switch s.info.Mode {
+	case ModeDegraded:
+		return ErrDegradedMode
+	case ModeMaintenance:
+		return ErrMaintenanceMode
+	}
+
+	id, err := s.metaBase.ReadShardID()
+	if err != nil {
+		return fmt.Errorf("could not read shard ID from metabase: %w", err)
+	}
+
+	if err := s.metaBase.WriteShardID(id); err != nil {
+		return fmt.Errorf("could not write shard ID to metabase: %w", err)
+	}
+
+	obj, err := newProbeObject()
+	if err != nil {
+		return err
+	}
+
+	addr := object.AddressOf(obj)
+
+	data, err := obj.Marshal()
+	if err != nil {
+		return fmt.Errorf("could not marshal probe object: %w", err)
+	}
+
+	// probe object is saved uncompressed, so it is not
+	// accounted in the compression statistics
+	putRes, err := s.blobStor.PutRaw(addr, data, false, "")
+	if err != nil {
+		return fmt.Errorf("could not write probe object to blobstor: %w", err)
+	}
+
+	blzID := putRes.BlobovniczaID()
+
+	read, getErr := s.getFromBlobStor(addr, blzID)
+	delErr := s.removeProbeObject(addr, blzID)
+
+	if getErr != nil {
+		return fmt.Errorf("could not read probe object from blobstor: %w", getErr)
+	}
+
+	if delErr != nil {
+		return fmt.Errorf("could not remove probe object from blobstor: %w", delErr)
+	}
+
+	if !bytes.Equal(read.Payload(), obj.Payload()) {
+		return errProbeDataMismatch
+	}
+
+	return nil
+}
+
+// removeProbeObject removes the probe object from the blobovnicza
+// if blzID is set, and from the shallow dir otherwise.
+func (s *Shard) removeProbeObject(addr *addressSDK.Address, blzID *blobovnicza.ID) error {
+	if blzID != nil {
+		delPrm := new(blobstor.DeleteSmallPrm)
+		delPrm.SetAddress(addr)
+		delPrm.SetBlobovniczaID(blzID)
+
+		_, err := s.blobStor.DeleteSmall(delPrm)
+		return err
+	}
+
+	delPrm := new(blobstor.DeleteBigPrm)
+	delPrm.SetAddress(addr)
+
+	_, err := s.blobStor.DeleteBig(delPrm)
+	return err
+}
+
+// newProbeObject returns the object with random address and payload.
+func newProbeObject() (*objectSDK.Object, error) {
+	payload := make([]byte, probePayloadSize)
+	if _, err := rand.Read(payload); err != nil {
+		return nil, fmt.Errorf("could not generate probe payload: %w", err)
+	}
+
+	cnr := cid.New()
+	cnr.SetSHA256(sha256.Sum256(payload))
+
+	id := oidSDK.NewID()
+	id.SetSHA256(sha256.Sum256(cnr.ToV2().GetValue()))
+
+	obj := objectSDK.New()
+	obj.SetContainerID(cnr)
+	obj.SetID(id)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(probePayloadSize)
+
+	return obj, nil
+}
@@ -0,0 +1,51 @@
+package shard_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_Probe(t *testing.T) {
+	sh := newShard(t, true)
+	defer releaseShard(sh, t)
+
+	require.NoError(t, sh.Probe())
+
+	res, err := sh.List()
+	require.NoError(t, err)
+	require.Empty(t, res.AddressList())
+
+	require.NoError(t, sh.SetMode(shard.ModeReadOnly))
+	require.NoError(t, sh.Probe())
+
+	require.NoError(t, sh.SetMode(shard.ModeDegraded))
+	err = sh.Probe()
+	require.True(t, errors.Is(err, shard.ErrDegradedMode), "got: %v", err)
+
+	require.NoError(t, sh.SetMode(shard.ModeMaintenance))
+	err = sh.Probe()
+	require.True(t, errors.Is(err, shard.ErrMaintenanceMode), "got: %v", err)
+}
+
+func TestShard_Probe_Compression(t *testing.T) {
+	m := &testCompressionMetrics{skipped: make(map[string]int)}
+
+	sh := newCustomShard(t, t.TempDir(), false, nil,
+		[]blobstor.Option{
+			blobstor.WithCompressObjects(true),
+			blobstor.WithMetrics(m),
+		})
+	defer releaseShard(sh, t)
+
+	require.NoError(t, sh.Probe())
+
+	// probe object is not compressed, so it does not
+	// affect the compression statistics
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	require.Empty(t, m.skipped)
+}
@@ -31,10 +31,26 @@ func (p *PutPrm) WithObject(obj *object.Object) *PutPrm {
 // Returns any error encountered that
 // did not allow to completely save the object.
 //
-// Returns ErrReadOnlyMode error if shard is in "read-only" mode.
+// Returns ErrReadOnlyMode, ErrDegradedMode or ErrMaintenanceMode error
+// if shard is not in "read-write" mode.
+// Returns ErrShardFull error if shard disk usage exceeds the high watermark.
 func (s *Shard) Put(prm *PutPrm) (*PutRes, error) {
-	if s.GetMode() == ModeReadOnly {
-		return nil, ErrReadOnlyMode
+	defer s.foreground()()
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	return s.put(prm)
+}
+
+// put saves the object in shard. The caller must hold the shard's read lock.
+func (s *Shard) put(prm *PutPrm) (*PutRes, error) {
+	if err := s.info.Mode.writeError(); err != nil {
+		return nil, err
+	}
+
+	if s.isFull() {
+		return nil, ErrShardFull
 	}
 
 	putPrm := new(blobstor.PutPrm) // form Put parameters
@@ -71,7 +71,13 @@ func (r *RngRes) HasMeta() bool {
 // Returns ErrRangeOutOfBounds if requested object range is out of bounds.
 // Returns an error of type apistatus.ObjectNotFound if requested object is missing.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if requested object has been marked as removed in shard.
+// Returns ErrMaintenanceMode error if shard is in "maintenance" mode.
 func (s *Shard) GetRange(prm *RngPrm) (*RngRes, error) {
+	defer s.foreground()()
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
 	var big, small storFetcher
 
 	rng := object.NewRange()
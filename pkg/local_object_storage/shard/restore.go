@@ -1,23 +1,25 @@
 package shard
 
 import (
-	"bytes"
-	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/dump"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 )
 
 // ErrInvalidMagic is returned when dump format is invalid.
-var ErrInvalidMagic = errors.New("invalid magic")
+var ErrInvalidMagic = dump.ErrInvalidMagic
 
 // RestorePrm groups the parameters of Restore operation.
 type RestorePrm struct {
-	path         string
-	stream       io.Reader
-	ignoreErrors bool
+	path          string
+	stream        io.Reader
+	ignoreErrors  bool
+	maxObjectSize uint32
+	tmpDir        string
 }
 
 // WithPath is a Restore option to set the destination path.
@@ -28,6 +30,9 @@ func (p *RestorePrm) WithPath(path string) *RestorePrm {
 
 // WithStream is a Restore option to set the stream to read objects from.
 // It takes priority over `WithPath` option.
+//
+// The stream is read twice if it implements io.Seeker, otherwise
+// it is copied to the temporary file while being verified.
 func (p *RestorePrm) WithStream(r io.Reader) *RestorePrm {
 	p.stream = r
 	return p
@@ -40,6 +45,23 @@ func (p *RestorePrm) WithIgnoreErrors(ignore bool) *RestorePrm {
 	return p
 }
 
+// WithMaxObjectSize is a Restore option to set the maximum size of the
+// marshaled object in the dump. Dump with bigger objects is rejected.
+//
+// dump.DefaultMaxRecordSize is used by default.
+func (p *RestorePrm) WithMaxObjectSize(sz uint32) *RestorePrm {
+	p.maxObjectSize = sz
+	return p
+}
+
+// WithTempDir is a Restore option to set the directory of the temporary
+// file which the non-seekable stream is copied to. The default directory
+// for temporary files is used by default.
+func (p *RestorePrm) WithTempDir(dir string) *RestorePrm {
+	p.tmpDir = dir
+	return p
+}
+
 // RestoreRes groups the result fields of Restore operation.
 type RestoreRes struct {
 	count  int
@@ -56,20 +78,25 @@ func (r *RestoreRes) FailCount() int {
 	return r.failed
 }
 
-// Restore restores objects from the dump prepared by Dump.
+// Restore restores objects from the dump prepared by Dump. The whole dump
+// is verified before the first object is stored: checksums of the records
+// and the dump trailer are checked, so the corrupted or truncated dump
+// is not restored partially. Corrupted records are skipped if errors
+// are ignored.
 //
 // Returns any error encountered.
 func (s *Shard) Restore(prm *RestorePrm) (*RestoreRes, error) {
-	// Disallow changing mode during restore.
 	s.m.RLock()
-	defer s.m.RUnlock()
+	err := s.info.Mode.writeError()
+	s.m.RUnlock()
 
-	if s.info.Mode != ModeReadWrite {
-		return nil, ErrReadOnlyMode
+	if err != nil {
+		return nil, err
 	}
 
-	r := prm.stream
-	if r == nil {
+	var r io.ReadSeeker
+
+	if prm.stream == nil {
 		f, err := os.OpenFile(prm.path, os.O_RDONLY, os.ModeExclusive)
 		if err != nil {
 			return nil, err
@@ -77,57 +104,141 @@ func (s *Shard) Restore(prm *RestorePrm) (*RestoreRes, error) {
 		defer f.Close()
 
 		r = f
+	} else if rs, ok := prm.stream.(io.ReadSeeker); ok {
+		r = rs
 	}
 
-	var m [4]byte
-	_, _ = io.ReadFull(r, m[:])
-	if !bytes.Equal(m[:], dumpMagic) {
-		return nil, ErrInvalidMagic
-	}
-
-	var count, failCount int
-	var data []byte
-	var size [4]byte
-	for {
-		// If there are less than 4 bytes left, `Read` returns nil error instead of
-		// io.ErrUnexpectedEOF, thus `ReadFull` is used.
-		_, err := io.ReadFull(r, size[:])
+	// The dump is verified without the lock since it can take a while.
+	if r != nil {
+		start, err := r.Seek(0, io.SeekCurrent)
 		if err != nil {
-			if errors.Is(err, io.EOF) {
-				break
-			}
 			return nil, err
 		}
 
-		sz := binary.LittleEndian.Uint32(size[:])
-		if uint32(cap(data)) < sz {
-			data = make([]byte, sz)
-		} else {
-			data = data[:sz]
+		if _, err := readDump(r, prm, nil); err != nil {
+			return nil, err
 		}
 
-		_, err = r.Read(data)
+		if _, err := r.Seek(start, io.SeekStart); err != nil {
+			return nil, err
+		}
+	} else {
+		f, err := stageDump(prm)
 		if err != nil {
 			return nil, err
 		}
+		defer func() {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}()
+
+		r = f
+	}
+
+	// Disallow changing mode during restore.
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if err := s.info.Mode.writeError(); err != nil {
+		return nil, err
+	}
 
+	var count, failCount int
+
+	corrupted, err := readDump(r, prm, func(data []byte) error {
 		obj := object.New()
-		err = obj.Unmarshal(data)
-		if err != nil {
+		if err := obj.Unmarshal(data); err != nil {
 			if prm.ignoreErrors {
 				failCount++
-				continue
+				return nil
 			}
-			return nil, err
+			return err
 		}
 
-		_, err = s.Put(new(PutPrm).WithObject(obj))
-		if err != nil {
-			return nil, err
+		if _, err := s.put(new(PutPrm).WithObject(obj)); err != nil {
+			return err
 		}
 
 		count++
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return &RestoreRes{count: count, failed: failCount}, nil
+	return &RestoreRes{count: count, failed: failCount + corrupted}, nil
+}
+
+// stageDump copies the stream of the dump to the temporary file
+// verifying it on the fly. Returns the file positioned at the beginning.
+func stageDump(prm *RestorePrm) (*os.File, error) {
+	f, err := os.CreateTemp(prm.tmpDir, "neofs-restore-*")
+	if err != nil {
+		return nil, fmt.Errorf("could not create temporary file: %w", err)
+	}
+
+	r := io.TeeReader(prm.stream, f)
+
+	_, err = readDump(r, prm, nil)
+	if err == nil {
+		// the decompressor may stop reading before the end of the stream
+		_, err = io.Copy(io.Discard, r)
+	}
+
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+
+	if err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// readDump reads the dump from r and passes the data of valid records
+// to f if it is not nil. Returns the number of skipped corrupted records.
+func readDump(r io.Reader, prm *RestorePrm, f func([]byte) error) (int, error) {
+	rd, err := dump.NewReader(r)
+	if err != nil {
+		return 0, err
+	}
+	defer rd.Close()
+
+	if prm.maxObjectSize != 0 {
+		rd.SetMaxRecordSize(prm.maxObjectSize)
+	}
+
+	var corrupted int
+	for {
+		data, err := rd.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return corrupted, nil
+			}
+
+			if prm.ignoreErrors {
+				if errors.Is(err, dump.ErrChecksumMismatch) {
+					corrupted++
+					continue
+				}
+
+				// corrupted records have already been counted as failed
+				if errors.Is(err, dump.ErrHashMismatch) && corrupted != 0 {
+					return corrupted, nil
+				}
+			}
+
+			return 0, err
+		}
+
+		if f != nil {
+			if err := f(data); err != nil {
+				return 0, err
+			}
+		}
+	}
 }
@@ -7,17 +7,22 @@ import (
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
 // SelectPrm groups the parameters of Select operation.
 type SelectPrm struct {
 	cid     *cid.ID
 	filters object.SearchFilters
+
+	count  uint32
+	cursor *oidSDK.ID
 }
 
 // SelectRes groups resulting values of Select operation.
 type SelectRes struct {
 	addrList []*addressSDK.Address
+	cursor   *oidSDK.ID
 }
 
 // WithContainerID is a Select option to set the container id to search in.
@@ -38,22 +43,63 @@ func (p *SelectPrm) WithFilters(fs object.SearchFilters) *SelectPrm {
 	return p
 }
 
+// WithCount is a Select option to set the maximum number of the selected
+// objects. See meta.SelectPrm.WithCount for details.
+func (p *SelectPrm) WithCount(count uint32) *SelectPrm {
+	if p != nil {
+		p.count = count
+	}
+
+	return p
+}
+
+// WithCursor is a Select option to continue selection after the object with
+// the given identifier. See meta.SelectPrm.WithCursor for details.
+func (p *SelectPrm) WithCursor(cursor *oidSDK.ID) *SelectPrm {
+	if p != nil {
+		p.cursor = cursor
+	}
+
+	return p
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r *SelectRes) AddressList() []*addressSDK.Address {
 	return r.addrList
 }
 
+// Cursor returns cursor for consecutive select requests.
+// See meta.SelectRes.Cursor for details.
+func (r *SelectRes) Cursor() *oidSDK.ID {
+	return r.cursor
+}
+
 // Select selects the objects from shard that match select parameters.
 //
 // Returns any error encountered that
 // did not allow to completely select the objects.
 func (s *Shard) Select(prm *SelectPrm) (*SelectRes, error) {
-	addrList, err := meta.Select(s.metaBase, prm.cid, prm.filters)
+	defer s.foreground()()
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if err := s.info.Mode.metabaseError(); err != nil {
+		return nil, err
+	}
+
+	res, err := s.metaBase.Select(new(meta.SelectPrm).
+		WithContainerID(prm.cid).
+		WithFilters(prm.filters).
+		WithCount(prm.count).
+		WithCursor(prm.cursor),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("could not select objects from metabase: %w", err)
 	}
 
 	return &SelectRes{
-		addrList: addrList,
+		addrList: res.AddressList(),
+		cursor:   res.Cursor(),
 	}, nil
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/util"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -25,6 +26,14 @@ type Shard struct {
 	blobStor *blobstor.BlobStor
 
 	metaBase *meta.DB
+
+	// metabase is opened in read-only mode in ModeDegraded
+	readOnlyMeta bool
+
+	weightStop chan struct{}
+
+	// number of the foreground operations in progress
+	fgOps atomic.Int32
 }
 
 // Option represents Shard's constructor option.
@@ -57,13 +66,24 @@ type cfg struct {
 	expiredTombstonesCallback ExpiredObjectsCallback
 
 	expiredLocksCallback ExpiredObjectsCallback
+
+	weightUpdateInterval time.Duration
+
+	usageHighWatermark uint32
+
+	// samples disk usage of the file system by path
+	diskUsage func(string) (diskUsage, error)
 }
 
+const defaultWeightUpdateInterval = time.Minute
+
 func defaultCfg() *cfg {
 	return &cfg{
-		rmBatchSize: 100,
-		log:         zap.L(),
-		gcCfg:       defaultGCCfg(),
+		rmBatchSize:          100,
+		log:                  zap.L(),
+		gcCfg:                defaultGCCfg(),
+		weightUpdateInterval: defaultWeightUpdateInterval,
+		diskUsage:            diskUsageOf,
 	}
 }
 
@@ -183,6 +203,46 @@ func WithGCRemoverSleepInterval(dur time.Duration) Option {
 	}
 }
 
+// WithGCRemoverMaxSleepInterval returns option to specify the upper limit
+// of the interval between object remover executions. The interval grows
+// up to this limit while the remover backs off due to the foreground load.
+//
+// Non-positive values are ignored.
+func WithGCRemoverMaxSleepInterval(dur time.Duration) Option {
+	return func(c *cfg) {
+		if dur > 0 {
+			c.gcCfg.removerMaxInterval = dur
+		}
+	}
+}
+
+// WithGCLoadThreshold returns option to specify the number of the foreground
+// operations in progress at which the GC backs off.
+//
+// Zero value disables the back-off.
+func WithGCLoadThreshold(v uint32) Option {
+	return func(c *cfg) {
+		c.gcCfg.loadThreshold = v
+	}
+}
+
+// WithGCQuietHours returns option to specify the daily time window
+// in which the GC does not remove objects.
+//
+// Nil value disables the quiet hours.
+func WithGCQuietHours(q *QuietHours) Option {
+	return func(c *cfg) {
+		c.gcCfg.quietHours = q
+	}
+}
+
+// WithMetrics returns option to specify Shard's metric register.
+func WithMetrics(m MetricRegister) Option {
+	return func(c *cfg) {
+		c.gcCfg.metrics = m
+	}
+}
+
 // WithExpiredTombstonesCallback returns option to specify callback
 // of the expired tombstones handler.
 func WithExpiredTombstonesCallback(cb ExpiredObjectsCallback) Option {
@@ -208,13 +268,37 @@ func WithRefillMetabase(v bool) Option {
 
 // WithMode returns option to set shard's mode. Mode must be one of the predefined:
 //	- ModeReadWrite;
-//	- ModeReadOnly.
+//	- ModeReadOnly;
+//	- ModeDegraded;
+//	- ModeMaintenance.
 func WithMode(v Mode) Option {
 	return func(c *cfg) {
 		c.info.Mode = v
 	}
 }
 
+// WithWeightUpdateInterval returns option to specify interval between
+// samplings of the disk space used by the shard components.
+//
+// Non-positive values are ignored.
+func WithWeightUpdateInterval(d time.Duration) Option {
+	return func(c *cfg) {
+		if d > 0 {
+			c.weightUpdateInterval = d
+		}
+	}
+}
+
+// WithUsageHighWatermark returns option to specify the percentage of used
+// disk space after which the shard stops accepting new objects.
+//
+// Zero value disables the check.
+func WithUsageHighWatermark(v uint32) Option {
+	return func(c *cfg) {
+		c.usageHighWatermark = v
+	}
+}
+
 func (s *Shard) fillInfo() {
 	s.cfg.info.MetaBaseInfo = s.metaBase.DumpInfo()
 	s.cfg.info.BlobStorInfo = s.blobStor.DumpInfo()
@@ -1,12 +1,166 @@
 package shard
 
-// WeightValues groups values of Shard weight parameters.
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// WeightValues groups values of Shard weight parameters. Disk space
+// values are taken from the most used file system of the Shard's components.
 type WeightValues struct {
 	// Amount of free disk space. Measured in kilobytes.
 	FreeSpace uint64
+
+	// Amount of used disk space. Measured in kilobytes.
+	UsedSpace uint64
+
+	// Total amount of disk space. Measured in kilobytes.
+	TotalSpace uint64
+}
+
+// ErrShardFull is returned when it is impossible to save an object
+// in the shard since its disk usage exceeds the configured high watermark.
+var ErrShardFull = errors.New("shard disk usage exceeds high watermark")
+
+// Usage returns the percentage of used disk space.
+//
+// Returns 0 if disk space was not sampled.
+func (v WeightValues) Usage() float64 {
+	if v.TotalSpace == 0 {
+		return 0
+	}
+
+	return float64(v.UsedSpace) / float64(v.TotalSpace) * 100
 }
 
 // WeightValues returns current weight values of the Shard.
 func (s *Shard) WeightValues() WeightValues {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
 	return s.info.WeightValues
 }
+
+// IsFull returns true if disk usage of the shard reaches
+// the configured high watermark.
+func (s *Shard) IsFull() bool {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	return s.isFull()
+}
+
+// isFull is IsFull without locking, the caller must hold the shard's read lock.
+func (s *Shard) isFull() bool {
+	if s.usageHighWatermark == 0 {
+		return false
+	}
+
+	return s.info.WeightValues.Usage() >= float64(s.usageHighWatermark)
+}
+
+// updateWeightValues samples the disk space of all file systems
+// which contain Shard's components.
+//
+// Weight values are taken from the file system with the highest usage,
+// since it is the one which fails the writes first.
+func (s *Shard) updateWeightValues() {
+	var (
+		wv    WeightValues
+		usage float64 = -1
+	)
+
+	for _, p := range s.componentPaths() {
+		du, err := s.diskUsage(p)
+		if err != nil {
+			s.log.Debug("could not sample disk usage",
+				zap.String("path", p),
+				zap.String("error", err.Error()),
+			)
+
+			continue
+		}
+
+		v := WeightValues{
+			FreeSpace:  du.free / 1024,
+			UsedSpace:  (du.total - du.free) / 1024,
+			TotalSpace: du.total / 1024,
+		}
+
+		if u := v.Usage(); u > usage {
+			wv, usage = v, u
+		}
+	}
+
+	s.m.Lock()
+	s.info.WeightValues = wv
+	s.m.Unlock()
+}
+
+// componentPaths returns paths of the Shard's components on the file system.
+func (s *Shard) componentPaths() []string {
+	paths := []string{
+		s.blobStor.DumpInfo().RootPath,
+		s.metaBase.DumpInfo().Path,
+	}
+
+	if s.hasWriteCache() {
+		paths = append(paths, s.writeCache.DumpInfo().Path)
+	}
+
+	return paths
+}
+
+// updateWeightValuesLoop periodically updates Shard's weight values
+// until stop channel is closed.
+func (s *Shard) updateWeightValuesLoop(stop <-chan struct{}) {
+	t := time.NewTicker(s.weightUpdateInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+			s.updateWeightValues()
+		}
+	}
+}
+
+type diskUsage struct {
+	total uint64
+	free  uint64
+}
+
+// diskUsageOf returns disk usage of the file system containing p.
+// If p does not exist yet, the closest existing parent directory is used.
+func diskUsageOf(p string) (diskUsage, error) {
+	p, err := filepath.Abs(p)
+	if err != nil {
+		return diskUsage{}, err
+	}
+
+	for {
+		_, err = os.Stat(p)
+		if err == nil || !os.IsNotExist(err) {
+			break
+		}
+
+		parent := filepath.Dir(p)
+		if parent == p {
+			break
+		}
+
+		p = parent
+	}
+
+	if err != nil {
+		return diskUsage{}, err
+	}
+
+	return statDiskUsage(p)
+}
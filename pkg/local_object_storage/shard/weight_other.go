@@ -0,0 +1,14 @@
+//go:build !linux && !darwin && !freebsd && !dragonfly
+// +build !linux,!darwin,!freebsd,!dragonfly
+
+package shard
+
+import (
+	"errors"
+)
+
+// statDiskUsage is not supported on the current platform,
+// shards are weighted equally.
+func statDiskUsage(string) (diskUsage, error) {
+	return diskUsage{}, errors.New("disk usage sampling is not supported on this platform")
+}
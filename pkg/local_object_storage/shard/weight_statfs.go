@@ -0,0 +1,22 @@
+//go:build linux || darwin || freebsd || dragonfly
+// +build linux darwin freebsd dragonfly
+
+package shard
+
+import (
+	"syscall"
+)
+
+// statDiskUsage returns disk usage of the file system containing existing path p.
+func statDiskUsage(p string) (diskUsage, error) {
+	var st syscall.Statfs_t
+
+	if err := syscall.Statfs(p, &st); err != nil {
+		return diskUsage{}, err
+	}
+
+	return diskUsage{
+		total: uint64(st.Blocks) * uint64(st.Bsize),
+		free:  uint64(st.Bavail) * uint64(st.Bsize),
+	}, nil
+}
@@ -0,0 +1,77 @@
+package shard
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_WeightValues(t *testing.T) {
+	p := t.TempDir()
+
+	sh := New(
+		WithBlobStorOptions(
+			blobstor.WithRootPath(filepath.Join(p, "blob")),
+			blobstor.WithBlobovniczaShallowWidth(1),
+			blobstor.WithBlobovniczaShallowDepth(1),
+		),
+		WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(p, "meta")),
+		),
+		WithUsageHighWatermark(90),
+	)
+
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+	t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+	wv := sh.WeightValues()
+	require.NotZero(t, wv.TotalSpace)
+	require.LessOrEqual(t, wv.FreeSpace, wv.TotalSpace)
+
+	// all components are on the same file system
+	var free uint64
+
+	sh.diskUsage = func(string) (diskUsage, error) {
+		return diskUsage{total: 100 << 10, free: free << 10}, nil
+	}
+
+	free = 5
+	sh.updateWeightValues()
+
+	require.Equal(t, WeightValues{FreeSpace: 5, UsedSpace: 95, TotalSpace: 100}, sh.WeightValues())
+	require.True(t, sh.IsFull())
+
+	putPrm := new(PutPrm).WithObject(objecttest.Object())
+
+	_, err := sh.Put(putPrm)
+	require.True(t, errors.Is(err, ErrShardFull), err)
+
+	free = 50
+	sh.updateWeightValues()
+
+	require.False(t, sh.IsFull())
+
+	_, err = sh.Put(putPrm)
+	require.NoError(t, err)
+
+	// the most used file system is taken into account
+	metaPath := filepath.Join(p, "meta")
+
+	sh.diskUsage = func(path string) (diskUsage, error) {
+		if path == metaPath {
+			return diskUsage{total: 100 << 10, free: 1 << 10}, nil
+		}
+
+		return diskUsage{total: 200 << 10, free: 180 << 10}, nil
+	}
+	sh.updateWeightValues()
+
+	require.Equal(t, WeightValues{FreeSpace: 1, UsedSpace: 99, TotalSpace: 100}, sh.WeightValues())
+	require.True(t, sh.IsFull())
+}
@@ -0,0 +1,120 @@
+package shard
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// IterateWriteCachePrm groups the parameters of IterateWriteCache operation.
+type IterateWriteCachePrm struct {
+	handler      func(*objectSDK.Object) error
+	ignoreErrors bool
+}
+
+// WithHandler is an IterateWriteCache option to set the callback
+// which is called on every object stored in the write-cache.
+func (p *IterateWriteCachePrm) WithHandler(f func(*objectSDK.Object) error) *IterateWriteCachePrm {
+	p.handler = f
+	return p
+}
+
+// WithIgnoreErrors is an IterateWriteCache option to skip objects
+// which can not be read or decoded.
+func (p *IterateWriteCachePrm) WithIgnoreErrors(ignore bool) *IterateWriteCachePrm {
+	p.ignoreErrors = ignore
+	return p
+}
+
+// IterateWriteCache iterates over objects stored in the write-cache
+// and not flushed to the main storage yet. Does nothing if the
+// write-cache is disabled.
+//
+// Shard must be in "read-only" or "degraded" mode.
+func (s *Shard) IterateWriteCache(prm *IterateWriteCachePrm) error {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	switch s.info.Mode {
+	case ModeReadOnly, ModeDegraded:
+	case ModeMaintenance:
+		return ErrMaintenanceMode
+	default:
+		return ErrMustBeReadOnly
+	}
+
+	if !s.hasWriteCache() {
+		return nil
+	}
+
+	return s.writeCache.Iterate(new(writecache.IterationPrm).WithHandler(func(data []byte) error {
+		obj := objectSDK.New()
+		if err := obj.Unmarshal(data); err != nil {
+			if prm.ignoreErrors {
+				return nil
+			}
+
+			return fmt.Errorf("could not unmarshal object: %w", err)
+		}
+
+		return prm.handler(obj)
+	}).WithIgnoreErrors(prm.ignoreErrors))
+}
+
+// FlushWriteCache writes all objects stored in the write-cache
+// to the main storage. Does nothing if the write-cache is disabled.
+//
+// Shard lock is not held during the flush, so it does not block mode
+// switches: write-cache is flushed in batches and the flush is interrupted
+// if the shard leaves "read-write" mode.
+//
+// Shard must be in "read-write" mode.
+func (s *Shard) FlushWriteCache() error {
+	if err := s.writeModeError(); err != nil {
+		return err
+	}
+
+	if !s.hasWriteCache() {
+		return nil
+	}
+
+	err := s.writeCache.Flush()
+	if errors.Is(err, writecache.ErrReadOnly) {
+		// shard mode has been switched during the flush
+		if modeErr := s.writeModeError(); modeErr != nil {
+			return modeErr
+		}
+	}
+
+	return err
+}
+
+// writeModeError returns an error if the current shard's mode
+// does not allow modifications.
+func (s *Shard) writeModeError() error {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	return s.info.Mode.writeError()
+}
+
+// WriteCacheStats returns the statistics of the write-cache.
+// Returns nil if the write-cache is disabled.
+func (s *Shard) WriteCacheStats() (*writecache.Stats, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if !s.info.Mode.blobStorOpened() {
+		return nil, ErrMaintenanceMode
+	}
+
+	if !s.hasWriteCache() {
+		return nil, nil
+	}
+
+	stats := s.writeCache.Stats()
+
+	return &stats, nil
+}
@@ -0,0 +1,144 @@
+package shard_test
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlushWriteCache(t *testing.T) {
+	const objCount, bigObjCount = 10, 3
+
+	sh := newCustomShard(t, t.TempDir(), true,
+		[]writecache.Option{writecache.WithSmallObjectSize(1024)},
+		nil)
+	defer releaseShard(sh, t)
+
+	objects := make([]*objectSDK.Object, objCount)
+	for i := range objects {
+		objects[i] = generateObjectWithPayload(cidtest.ID(), make([]byte, 100))
+
+		_, err := sh.Put(new(shard.PutPrm).WithObject(objects[i]))
+		require.NoError(t, err)
+	}
+
+	// metadata of big objects is written by the flush workers
+	for i := 0; i < bigObjCount; i++ {
+		obj := generateObjectWithPayload(cidtest.ID(), make([]byte, 2048))
+
+		_, err := sh.Put(new(shard.PutPrm).WithObject(obj))
+		require.NoError(t, err)
+
+		objects = append(objects, obj)
+	}
+
+	stats, err := sh.WriteCacheStats()
+	require.NoError(t, err)
+	require.Equal(t, uint64(objCount), stats.MemCount+stats.DBCount)
+	require.Equal(t, uint64(bigObjCount), stats.FSCount)
+
+	require.NoError(t, sh.FlushWriteCache())
+
+	stats, err = sh.WriteCacheStats()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), stats.MemCount)
+	require.Equal(t, uint64(0), stats.MemSize)
+	require.Equal(t, uint64(objCount), stats.DBCount)
+
+	for i := range objects {
+		res, err := sh.Exists(new(shard.ExistsPrm).WithAddress(object.AddressOf(objects[i])))
+		require.NoError(t, err)
+		require.True(t, res.Exists(), i)
+	}
+
+	t.Run("read-only mode", func(t *testing.T) {
+		require.NoError(t, sh.SetMode(shard.ModeReadOnly))
+		require.ErrorIs(t, sh.FlushWriteCache(), shard.ErrReadOnlyMode)
+	})
+
+	t.Run("without write-cache", func(t *testing.T) {
+		sh := newShard(t, false)
+		defer releaseShard(sh, t)
+
+		require.NoError(t, sh.FlushWriteCache())
+
+		stats, err := sh.WriteCacheStats()
+		require.NoError(t, err)
+		require.Nil(t, stats)
+	})
+}
+
+type testCompressionMetrics struct {
+	mtx     sync.Mutex
+	skipped map[string]int
+}
+
+func (m *testCompressionMetrics) AddCompressionSavedBytes(uint64)      {}
+func (m *testCompressionMetrics) AddCompressionDuration(time.Duration) {}
+
+func (m *testCompressionMetrics) IncCompressionSkipped(reason string) {
+	m.mtx.Lock()
+	m.skipped[reason]++
+	m.mtx.Unlock()
+}
+
+func (m *testCompressionMetrics) skippedBy(reason string) int {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.skipped[reason]
+}
+
+func TestFlushWriteCache_CompressionStats(t *testing.T) {
+	// number of compression attempts after which
+	// the category can be considered incompressible
+	const attempts = 16
+
+	const contentType = "application/x-random"
+
+	m := &testCompressionMetrics{skipped: make(map[string]int)}
+
+	sh := newCustomShard(t, t.TempDir(), true,
+		[]writecache.Option{writecache.WithSmallObjectSize(1024)},
+		[]blobstor.Option{
+			blobstor.WithCompressObjects(true),
+			blobstor.WithMetrics(m),
+		})
+	defer releaseShard(sh, t)
+
+	putBig := func() {
+		payload := make([]byte, 4096)
+		rand.Read(payload)
+
+		// every object is in a new container, so only
+		// the content type statistics can be learned
+		obj := generateObjectWithPayload(cidtest.ID(), payload)
+		addAttribute(obj, objectSDK.AttributeContentType, contentType)
+
+		_, err := sh.Put(new(shard.PutPrm).WithObject(obj))
+		require.NoError(t, err)
+	}
+
+	for i := 0; i < attempts; i++ {
+		putBig()
+	}
+
+	stats, err := sh.WriteCacheStats()
+	require.NoError(t, err)
+	require.Equal(t, uint64(attempts), stats.FSCount)
+
+	require.NoError(t, sh.FlushWriteCache())
+	require.Equal(t, 0, m.skippedBy("category"))
+
+	// incompressible content type is learned from the flushed objects
+	putBig()
+	require.Equal(t, 1, m.skippedBy("category"))
+}
@@ -1,6 +1,7 @@
 package writecache
 
 import (
+	"fmt"
 	"sync"
 	"time"
 
@@ -61,9 +62,6 @@ func (c *cache) flush() {
 	lastKey := []byte{}
 	var m []objectInfo
 	for {
-		m = m[:0]
-		sz := 0
-
 		c.modeMtx.RLock()
 		if c.mode == ModeReadOnly {
 			c.modeMtx.RUnlock()
@@ -72,22 +70,7 @@ func (c *cache) flush() {
 		}
 
 		// We put objects in batches of fixed size to not interfere with main put cycle a lot.
-		_ = c.db.View(func(tx *bbolt.Tx) error {
-			b := tx.Bucket(defaultBucket)
-			cs := b.Cursor()
-			for k, v := cs.Seek(lastKey); k != nil && len(m) < flushBatchSize; k, v = cs.Next() {
-				if _, ok := c.flushed.Peek(string(k)); ok {
-					continue
-				}
-
-				sz += len(k) + len(v)
-				m = append(m, objectInfo{
-					addr: string(k),
-					data: cloneBytes(v),
-				})
-			}
-			return nil
-		})
+		m = c.readFlushBatch(lastKey, m[:0])
 
 		for i := range m {
 			obj := object.New()
@@ -95,9 +78,7 @@ func (c *cache) flush() {
 				continue
 			}
 
-			select {
-			case c.flushCh <- obj:
-			case <-c.closeCh:
+			if !c.sendToWorkers(c.flushCh, obj) {
 				c.modeMtx.RUnlock()
 				return
 			}
@@ -119,6 +100,30 @@ func (c *cache) flush() {
 			break
 		}
 	}
+
+	c.lastFlush.Store(time.Now().UnixNano())
+}
+
+// readFlushBatch appends to m at most flushBatchSize objects from the database
+// which are not flushed yet, starting from the lastKey.
+func (c *cache) readFlushBatch(lastKey []byte, m []objectInfo) []objectInfo {
+	_ = c.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(defaultBucket)
+		cs := b.Cursor()
+		for k, v := cs.Seek(lastKey); k != nil && len(m) < flushBatchSize; k, v = cs.Next() {
+			if _, ok := c.flushed.Peek(string(k)); ok {
+				continue
+			}
+
+			m = append(m, objectInfo{
+				addr: string(k),
+				data: cloneBytes(v),
+			})
+		}
+		return nil
+	})
+
+	return m
 }
 
 func (c *cache) flushBigObjects() {
@@ -126,49 +131,159 @@ func (c *cache) flushBigObjects() {
 	for {
 		select {
 		case <-tick.C:
-			c.modeMtx.RLock()
-			if c.mode == ModeReadOnly {
-				c.modeMtx.RUnlock()
-				break
+			_ = c.flushFSTree(true)
+		case <-c.closeCh:
+			return
+		}
+	}
+}
+
+// flushFSTree writes big objects which are not flushed yet from FSTree
+// to the main storage. If ignoreErrors is true, objects which can not be
+// written are skipped, otherwise the first error is returned.
+//
+// Mode lock is taken for every object, so the flush does not block mode
+// switches. Returns ErrReadOnly if the write-cache is in read-only mode.
+func (c *cache) flushFSTree(ignoreErrors bool) error {
+	return c.fsTree.Iterate(new(fstree.IterationPrm).WithHandler(func(addr *addressSDK.Address, data []byte) error {
+		c.modeMtx.RLock()
+		defer c.modeMtx.RUnlock()
+
+		if c.mode == ModeReadOnly {
+			return ErrReadOnly
+		}
+
+		sAddr := addr.String()
+
+		if _, ok := c.store.flushed.Peek(sAddr); ok {
+			return nil
+		}
+
+		c.mtx.Lock()
+		contentType, compress := c.compressFlags[sAddr]
+		c.mtx.Unlock()
+
+		if _, err := c.blobstor.PutRaw(addr, data, compress, contentType); err != nil {
+			if !ignoreErrors {
+				return fmt.Errorf("could not put object %s to blobstor: %w", sAddr, err)
 			}
 
-			evictNum := 0
-			_ = c.fsTree.Iterate(new(fstree.IterationPrm).WithHandler(func(addr *addressSDK.Address, data []byte) error {
-				sAddr := addr.String()
+			c.log.Error("cant flush object to blobstor", zap.Error(err))
+			return nil
+		}
+
+		if compress {
+			c.mtx.Lock()
+			delete(c.compressFlags, sAddr)
+			c.mtx.Unlock()
+		}
+
+		// evict objects which were successfully written to BlobStor
+		c.evictObjects(1)
+
+		// mark object as flushed
+		c.store.flushed.Add(sAddr, false)
 
-				if _, ok := c.store.flushed.Peek(sAddr); ok {
-					return nil
-				}
+		return nil
+	}))
+}
 
-				c.mtx.Lock()
-				_, compress := c.compressFlags[sAddr]
-				c.mtx.Unlock()
+// Flush writes all objects stored in the write-cache before the call
+// to the main storage. Flushed objects are removed from the write-cache
+// later along with the ones flushed in background.
+//
+// Objects are flushed in batches, so puts and mode switches are not
+// blocked for the whole flush. Returns ErrReadOnly if the write-cache
+// is in read-only mode or has been switched to it during the flush.
+func (c *cache) Flush() error {
+	if err := c.flushMemory(); err != nil {
+		return err
+	}
 
-				if _, err := c.blobstor.PutRaw(addr, data, compress); err != nil {
-					c.log.Error("cant flush object to blobstor", zap.Error(err))
-					return nil
-				}
+	if err := c.flushDB(); err != nil {
+		return fmt.Errorf("could not flush database: %w", err)
+	}
 
-				if compress {
-					c.mtx.Lock()
-					delete(c.compressFlags, sAddr)
-					c.mtx.Unlock()
-				}
+	if err := c.flushFSTree(false); err != nil {
+		return fmt.Errorf("could not flush FSTree: %w", err)
+	}
 
-				// mark object as flushed
-				c.store.flushed.Add(sAddr, false)
+	c.lastFlush.Store(time.Now().UnixNano())
 
-				evictNum++
+	return nil
+}
 
-				return nil
-			}))
+// flushMemory persists objects cached in memory and waits until the flush
+// workers write all objects sent to them, including metadata of the big
+// objects.
+func (c *cache) flushMemory() error {
+	// Exclusive lock suspends producers of the workers' queues,
+	// so no new objects are sent while waiting.
+	c.modeMtx.Lock()
+	defer c.modeMtx.Unlock()
+
+	if c.mode == ModeReadOnly {
+		return ErrReadOnly
+	}
 
-			// evict objects which were successfully written to BlobStor
-			c.evictObjects(evictNum)
-			c.modeMtx.RUnlock()
-		case <-c.closeCh:
+	c.persistMemoryCache()
+	c.pending.Wait()
+
+	return nil
+}
+
+// flushDB writes objects which are not flushed yet from the database
+// directly to the main storage.
+func (c *cache) flushDB() error {
+	lastKey := []byte{}
+	var m []objectInfo
+	for {
+		var err error
+
+		m, err = c.flushDBBatch(lastKey, m[:0])
+		if err != nil || len(m) == 0 {
+			return err
+		}
+
+		lastKey = append([]byte(m[len(m)-1].addr), 0)
+	}
+}
+
+// flushDBBatch writes the next batch of objects from the database starting
+// from the lastKey. Returns the objects of the batch which is empty if
+// there is nothing left to flush.
+func (c *cache) flushDBBatch(lastKey []byte, m []objectInfo) ([]objectInfo, error) {
+	c.modeMtx.RLock()
+	defer c.modeMtx.RUnlock()
+
+	if c.mode == ModeReadOnly {
+		return nil, ErrReadOnly
+	}
+
+	m = c.readFlushBatch(lastKey, m)
+	if len(m) == 0 {
+		return m, nil
+	}
+
+	c.evictObjects(len(m))
+
+	for i := range m {
+		obj := object.New()
+		if err := obj.Unmarshal(m[i].data); err != nil {
+			c.log.Error("can't unmarshal object from write-cache database",
+				zap.String("address", m[i].addr),
+				zap.Error(err))
+			continue
+		}
+
+		if err := c.writeObject(obj, false); err != nil {
+			return nil, fmt.Errorf("could not write object %s: %w", m[i].addr, err)
 		}
+
+		c.flushed.Add(m[i].addr, true)
 	}
+
+	return m, nil
 }
 
 // flushWorker runs in a separate goroutine and write objects to the main storage.
@@ -207,6 +322,24 @@ func (c *cache) flushWorker(num int) {
 		if err != nil {
 			c.log.Error("can't flush object to the main storage", zap.Error(err))
 		}
+
+		c.pending.Done()
+	}
+}
+
+// sendToWorkers sends obj to the flush workers through ch.
+// Returns false if the write-cache has been closed.
+//
+// Mode lock must be taken.
+func (c *cache) sendToWorkers(ch chan<- *object.Object, obj *object.Object) bool {
+	c.pending.Add(1)
+
+	select {
+	case ch <- obj:
+		return true
+	case <-c.closeCh:
+		c.pending.Done()
+		return false
 	}
 }
 
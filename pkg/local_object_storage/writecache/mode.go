@@ -1,9 +1,6 @@
 package writecache
 
-import (
-	"errors"
-	"time"
-)
+import "errors"
 
 // Mode represents write-cache mode of operation.
 type Mode uint32
@@ -39,14 +36,11 @@ func (c *cache) SetMode(m Mode) {
 	// 1. Persist objects already in memory on disk.
 	c.persistMemoryCache()
 
-	// 2. Suspend producers to ensure there are channel send operations in fly.
+	// 2. Wait until the flush workers write all objects sent to them.
 	// metaCh and directCh can be populated either during Put or in background memory persist thread.
 	// Former possibility is eliminated by taking `modeMtx` mutex and
 	// latter by explicit persist in the previous step.
 	// flushCh is populated by `flush` with `modeMtx` is also taken.
-	// Thus all producers are shutdown and we only need to wait until all channels are empty.
-	for len(c.metaCh) != 0 || len(c.directCh) != 0 || len(c.flushCh) != 0 {
-		c.log.Info("waiting for channels to flush")
-		time.Sleep(time.Second)
-	}
+	// Thus all producers are shutdown and we only need to wait for the workers.
+	c.pending.Wait()
 }
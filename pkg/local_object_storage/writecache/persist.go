@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	storagelog "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/internal/log"
 	"go.etcd.io/bbolt"
 	"go.uber.org/zap"
@@ -118,7 +119,7 @@ func (c *cache) persistBigObject(objInfo objectInfo) {
 			metaIndex = 1
 			if c.blobstor.NeedsCompression(objInfo.obj) {
 				c.mtx.Lock()
-				c.compressFlags[objInfo.addr] = struct{}{}
+				c.compressFlags[objInfo.addr] = blobstor.ContentType(objInfo.obj)
 				c.mtx.Unlock()
 			}
 			c.objCounters.IncFS()
@@ -132,16 +133,12 @@ func (c *cache) persistBigObject(objInfo objectInfo) {
 // For objects below metaIndex only meta information will be flushed.
 func (c *cache) addToFlushQueue(objs []objectInfo, metaIndex int) {
 	for i := 0; i < metaIndex; i++ {
-		select {
-		case c.metaCh <- objs[i].obj:
-		case <-c.closeCh:
+		if !c.sendToWorkers(c.metaCh, objs[i].obj) {
 			return
 		}
 	}
 	for i := metaIndex; i < len(objs); i++ {
-		select {
-		case c.directCh <- objs[i].obj:
-		case <-c.closeCh:
+		if !c.sendToWorkers(c.directCh, objs[i].obj) {
 			return
 		}
 	}
@@ -0,0 +1,36 @@
+package writecache
+
+import "time"
+
+// Stats groups the statistics of the write-cache.
+type Stats struct {
+	// Number of objects cached in memory.
+	MemCount uint64
+	// Total size of the objects cached in memory.
+	MemSize uint64
+	// Number of objects stored in the database.
+	DBCount uint64
+	// Number of objects stored in FSTree.
+	FSCount uint64
+	// Time elapsed since the last completed flush.
+	FlushLag time.Duration
+}
+
+// Stats returns the current statistics of the write-cache.
+//
+// Database and FSTree counters include objects which are already
+// flushed to the main storage, but not removed from the write-cache yet.
+func (c *cache) Stats() Stats {
+	c.mtx.RLock()
+	s := Stats{
+		MemCount: uint64(len(c.mem)),
+		MemSize:  c.curMemSize,
+	}
+	c.mtx.RUnlock()
+
+	s.DBCount = c.objCounters.DB()
+	s.FSCount = c.objCounters.FS()
+	s.FlushLag = time.Since(time.Unix(0, c.lastFlush.Load()))
+
+	return s
+}
@@ -2,10 +2,12 @@ package writecache
 
 import (
 	"sync"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -24,6 +26,8 @@ type Cache interface {
 	Put(*object.Object) error
 	SetMode(Mode)
 	DumpInfo() Info
+	Flush() error
+	Stats() Stats
 
 	Init() error
 	Open() error
@@ -40,9 +44,10 @@ type cache struct {
 	mode    Mode
 	modeMtx sync.RWMutex
 
-	// compressFlags maps address of a big object to boolean value indicating
-	// whether object should be compressed.
-	compressFlags map[string]struct{}
+	// compressFlags maps address of a big object which should be compressed
+	// to its MIME Content-Type, so compression statistics of the content type
+	// are updated when the object is flushed.
+	compressFlags map[string]string
 
 	// curMemSize is the current size of all objects cached in memory.
 	curMemSize uint64
@@ -54,6 +59,10 @@ type cache struct {
 	directCh chan *object.Object
 	// metaCh is a channel with objects for which only metadata needs to be written.
 	metaCh chan *object.Object
+	// pending counts objects sent to the flush workers and not written
+	// to the main storage yet. Objects are sent with modeMtx taken, so
+	// it is waited with modeMtx exclusively locked.
+	pending sync.WaitGroup
 	// closeCh is close channel.
 	closeCh chan struct{}
 	evictCh chan []byte
@@ -61,6 +70,9 @@ type cache struct {
 	store
 	// fsTree contains big files stored directly on file-system.
 	fsTree *fstree.FSTree
+
+	// lastFlush is the time of the last completed flush in Unix nanoseconds.
+	lastFlush atomic.Int64
 }
 
 type objectInfo struct {
@@ -90,7 +102,7 @@ func New(opts ...Option) Cache {
 		evictCh:  make(chan []byte),
 		mode:     ModeReadWrite,
 
-		compressFlags: make(map[string]struct{}),
+		compressFlags: make(map[string]string),
 		options: options{
 			log:             zap.NewNop(),
 			maxMemSize:      maxInMemorySizeBytes,
@@ -115,17 +127,26 @@ func (c *cache) DumpInfo() Info {
 }
 
 // Open opens and initializes database. Reads object counters from the ObjectCounters instance.
+//
+// Write-cache can be reopened after Close.
 func (c *cache) Open() error {
 	err := c.openStore()
 	if err != nil {
 		return err
 	}
 
+	// write-cache can be reopened after Close
+	c.closeCh = make(chan struct{})
+
 	if c.objCounters == nil {
 		c.objCounters = &counters{
 			db: c.db,
 			fs: c.fsTree,
 		}
+	} else if cnt, ok := c.objCounters.(*counters); ok {
+		// storage has been reopened
+		cnt.db = c.db
+		cnt.fs = c.fsTree
 	}
 
 	return c.objCounters.Read()
@@ -133,6 +154,8 @@ func (c *cache) Open() error {
 
 // Init runs necessary services.
 func (c *cache) Init() error {
+	c.lastFlush.Store(time.Now().UnixNano())
+
 	go c.persistLoop()
 	go c.flushLoop()
 	return nil
@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type blobstorMetrics struct {
+	compressionSavedBytes prometheus.Counter
+	compressionDuration   prometheus.Counter
+	compressionSkipped    *prometheus.CounterVec
+}
+
+const blobstorSubsystem = "blobstor"
+
+func newBlobstorMetrics() blobstorMetrics {
+	var (
+		compressionSavedBytes = prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: blobstorSubsystem,
+			Name:      "compression_saved_bytes",
+			Help:      "Accumulated number of bytes saved by object compression",
+		})
+
+		compressionDuration = prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: blobstorSubsystem,
+			Name:      "compression_duration",
+			Help:      "Accumulated duration of object compression",
+		})
+
+		compressionSkipped = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: blobstorSubsystem,
+			Name:      "compression_skipped",
+			Help:      "Number of objects saved without compression",
+		}, []string{"reason"})
+	)
+
+	return blobstorMetrics{
+		compressionSavedBytes: compressionSavedBytes,
+		compressionDuration:   compressionDuration,
+		compressionSkipped:    compressionSkipped,
+	}
+}
+
+func (m blobstorMetrics) register() {
+	prometheus.MustRegister(m.compressionSavedBytes)
+	prometheus.MustRegister(m.compressionDuration)
+	prometheus.MustRegister(m.compressionSkipped)
+}
+
+func (m blobstorMetrics) AddCompressionSavedBytes(n uint64) {
+	m.compressionSavedBytes.Add(float64(n))
+}
+
+func (m blobstorMetrics) AddCompressionDuration(d time.Duration) {
+	m.compressionDuration.Add(float64(d))
+}
+
+func (m blobstorMetrics) IncCompressionSkipped(reason string) {
+	m.compressionSkipped.WithLabelValues(reason).Inc()
+}
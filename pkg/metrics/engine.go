@@ -19,10 +19,17 @@ type (
 		rangeDuration                 prometheus.Counter
 		searchDuration                prometheus.Counter
 		listObjectsDuration           prometheus.Counter
+		shardErrorCount               *prometheus.GaugeVec
+		readCacheHits                 prometheus.Counter
+		readCacheMisses               prometheus.Counter
+		readCacheSize                 prometheus.Gauge
 	}
 )
 
-const engineSubsystem = "engine"
+const (
+	engineSubsystem = "engine"
+	shardIDLabel    = "shard"
+)
 
 func newEngineMetrics() engineMetrics {
 	var (
@@ -102,6 +109,34 @@ func newEngineMetrics() engineMetrics {
 			Name:      "list_objects_duration",
 			Help:      "Accumulated duration of engine list objects operations",
 		})
+
+		shardErrorCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "shard_error_count",
+			Help:      "Number of errors occurred in the shard since the last reset of the error counter",
+		}, []string{shardIDLabel})
+
+		readCacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "read_cache_hits",
+			Help:      "Number of engine get and head operations served from the read cache",
+		})
+
+		readCacheMisses = prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "read_cache_misses",
+			Help:      "Number of engine get and head operations not found in the read cache",
+		})
+
+		readCacheSize = prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "read_cache_size",
+			Help:      "Total size of the objects in the read cache",
+		})
 	)
 
 	return engineMetrics{
@@ -116,6 +151,10 @@ func newEngineMetrics() engineMetrics {
 		rangeDuration:                 rangeDuration,
 		searchDuration:                searchDuration,
 		listObjectsDuration:           listObjectsDuration,
+		shardErrorCount:               shardErrorCount,
+		readCacheHits:                 readCacheHits,
+		readCacheMisses:               readCacheMisses,
+		readCacheSize:                 readCacheSize,
 	}
 }
 
@@ -131,6 +170,10 @@ func (m engineMetrics) register() {
 	prometheus.MustRegister(m.rangeDuration)
 	prometheus.MustRegister(m.searchDuration)
 	prometheus.MustRegister(m.listObjectsDuration)
+	prometheus.MustRegister(m.shardErrorCount)
+	prometheus.MustRegister(m.readCacheHits)
+	prometheus.MustRegister(m.readCacheMisses)
+	prometheus.MustRegister(m.readCacheSize)
 }
 
 func (m engineMetrics) AddListContainersDuration(d time.Duration) {
@@ -176,3 +219,19 @@ func (m engineMetrics) AddSearchDuration(d time.Duration) {
 func (m engineMetrics) AddListObjectsDuration(d time.Duration) {
 	m.listObjectsDuration.Add(float64(d))
 }
+
+func (m engineMetrics) SetShardErrorCount(shardID string, count uint32) {
+	m.shardErrorCount.With(prometheus.Labels{shardIDLabel: shardID}).Set(float64(count))
+}
+
+func (m engineMetrics) IncReadCacheHits() {
+	m.readCacheHits.Inc()
+}
+
+func (m engineMetrics) IncReadCacheMisses() {
+	m.readCacheMisses.Inc()
+}
+
+func (m engineMetrics) SetReadCacheSize(size uint64) {
+	m.readCacheSize.Set(float64(size))
+}
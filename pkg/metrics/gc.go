@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type gcMetrics struct {
+	graveyardSize  *prometheus.GaugeVec
+	pendingGarbage *prometheus.GaugeVec
+	removedObjects *prometheus.CounterVec
+}
+
+const gcSubsystem = "gc"
+
+func newGCMetrics() gcMetrics {
+	var (
+		graveyardSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: gcSubsystem,
+			Name:      "graveyard_size",
+			Help:      "Number of objects in the shard graveyard",
+		}, []string{shardIDLabel})
+
+		pendingGarbage = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: gcSubsystem,
+			Name:      "pending_garbage",
+			Help:      "Number of objects marked as garbage and waiting to be removed from the shard",
+		}, []string{shardIDLabel})
+
+		removedObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: gcSubsystem,
+			Name:      "removed_objects",
+			Help:      "Accumulated number of objects removed from the shard by the garbage collector",
+		}, []string{shardIDLabel})
+	)
+
+	return gcMetrics{
+		graveyardSize:  graveyardSize,
+		pendingGarbage: pendingGarbage,
+		removedObjects: removedObjects,
+	}
+}
+
+func (m gcMetrics) register() {
+	prometheus.MustRegister(m.graveyardSize)
+	prometheus.MustRegister(m.pendingGarbage)
+	prometheus.MustRegister(m.removedObjects)
+}
+
+func (m gcMetrics) SetGCGraveyardSize(shardID string, n uint64) {
+	m.graveyardSize.With(prometheus.Labels{shardIDLabel: shardID}).Set(float64(n))
+}
+
+func (m gcMetrics) SetGCPendingGarbage(shardID string, n uint64) {
+	m.pendingGarbage.With(prometheus.Labels{shardIDLabel: shardID}).Set(float64(n))
+}
+
+func (m gcMetrics) AddGCRemovedObjects(shardID string, n uint64) {
+	m.removedObjects.With(prometheus.Labels{shardIDLabel: shardID}).Add(float64(n))
+}
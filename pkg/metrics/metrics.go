@@ -6,7 +6,12 @@ const namespace = "neofs_node"
 
 type StorageMetrics struct {
 	objectServiceMetrics
+	objectLimitMetrics
 	engineMetrics
+	blobstorMetrics
+	gcMetrics
+	replicatorMetrics
+	policerMetrics
 	epoch prometheus.Gauge
 }
 
@@ -14,9 +19,24 @@ func NewStorageMetrics() *StorageMetrics {
 	objectService := newObjectServiceMetrics()
 	objectService.register()
 
+	objectLimits := newObjectLimitMetrics()
+	objectLimits.register()
+
 	engine := newEngineMetrics()
 	engine.register()
 
+	blobstor := newBlobstorMetrics()
+	blobstor.register()
+
+	gc := newGCMetrics()
+	gc.register()
+
+	replicator := newReplicatorMetrics()
+	replicator.register()
+
+	policer := newPolicerMetrics()
+	policer.register()
+
 	epoch := prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: namespace,
 		Subsystem: innerRingSubsystem,
@@ -27,7 +47,12 @@ func NewStorageMetrics() *StorageMetrics {
 
 	return &StorageMetrics{
 		objectServiceMetrics: objectService,
+		objectLimitMetrics:   objectLimits,
 		engineMetrics:        engine,
+		blobstorMetrics:      blobstor,
+		gcMetrics:            gc,
+		replicatorMetrics:    replicator,
+		policerMetrics:       policer,
 		epoch:                epoch,
 	}
 }
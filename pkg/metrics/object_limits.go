@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type objectLimitMetrics struct {
+	rateLimits      *prometheus.GaugeVec
+	limitedRequests *prometheus.CounterVec
+}
+
+const (
+	methodLabel = "method"
+	limitLabel  = "limit"
+)
+
+func newObjectLimitMetrics() objectLimitMetrics {
+	var (
+		rateLimits = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: objectSubsystem,
+			Name:      "request_rate_limit",
+			Help:      "Configured number of requests per second, zero means no limit",
+		}, []string{limitLabel})
+
+		limitedRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: objectSubsystem,
+			Name:      "limited_requests",
+			Help:      "Number of requests rejected due to the exceeded rate limit",
+		}, []string{methodLabel, limitLabel})
+	)
+
+	return objectLimitMetrics{
+		rateLimits:      rateLimits,
+		limitedRequests: limitedRequests,
+	}
+}
+
+func (m objectLimitMetrics) register() {
+	prometheus.MustRegister(m.rateLimits)
+	prometheus.MustRegister(m.limitedRequests)
+}
+
+func (m objectLimitMetrics) SetObjectRequestRateLimit(limit string, rate float64) {
+	m.rateLimits.With(prometheus.Labels{limitLabel: limit}).Set(rate)
+}
+
+func (m objectLimitMetrics) IncObjectLimitedRequests(method, limit string) {
+	m.limitedRequests.With(prometheus.Labels{
+		methodLabel: method,
+		limitLabel:  limit,
+	}).Inc()
+}
@@ -0,0 +1,104 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type policerMetrics struct {
+	checkedObjects  prometheus.Counter
+	shortages       prometheus.Counter
+	redundantCopies prometheus.Counter
+	removedCopies   prometheus.Counter
+	unmarkedCopies  prometheus.Counter
+	completedScans  prometheus.Counter
+}
+
+const policerSubsystem = "policer"
+
+func newPolicerMetrics() policerMetrics {
+	var (
+		checkedObjects = prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: policerSubsystem,
+			Name:      "checked_objects",
+			Help:      "Number of local objects which placement has been checked",
+		})
+
+		shortages = prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: policerSubsystem,
+			Name:      "shortages",
+			Help:      "Number of checked objects with the shortage of copies",
+		})
+
+		redundantCopies = prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: policerSubsystem,
+			Name:      "redundant_copies",
+			Help:      "Number of detected redundant local object copies",
+		})
+
+		removedCopies = prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: policerSubsystem,
+			Name:      "removed_copies",
+			Help:      "Number of redundant local object copies passed for removal",
+		})
+
+		unmarkedCopies = prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: policerSubsystem,
+			Name:      "unmarked_copies",
+			Help:      "Number of local object copies which turned out to be required while waiting for removal confirmation",
+		})
+
+		completedScans = prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: policerSubsystem,
+			Name:      "completed_scans",
+			Help:      "Number of completed scans of all local objects",
+		})
+	)
+
+	return policerMetrics{
+		checkedObjects:  checkedObjects,
+		shortages:       shortages,
+		redundantCopies: redundantCopies,
+		removedCopies:   removedCopies,
+		unmarkedCopies:  unmarkedCopies,
+		completedScans:  completedScans,
+	}
+}
+
+func (m policerMetrics) register() {
+	prometheus.MustRegister(m.checkedObjects)
+	prometheus.MustRegister(m.shortages)
+	prometheus.MustRegister(m.redundantCopies)
+	prometheus.MustRegister(m.removedCopies)
+	prometheus.MustRegister(m.unmarkedCopies)
+	prometheus.MustRegister(m.completedScans)
+}
+
+func (m policerMetrics) IncPolicerCheckedObjects() {
+	m.checkedObjects.Inc()
+}
+
+func (m policerMetrics) IncPolicerShortages() {
+	m.shortages.Inc()
+}
+
+func (m policerMetrics) IncPolicerRedundantCopies() {
+	m.redundantCopies.Inc()
+}
+
+func (m policerMetrics) IncPolicerRemovedCopies() {
+	m.removedCopies.Inc()
+}
+
+func (m policerMetrics) IncPolicerUnmarkedCopies() {
+	m.unmarkedCopies.Inc()
+}
+
+func (m policerMetrics) IncPolicerCompletedScans() {
+	m.completedScans.Inc()
+}
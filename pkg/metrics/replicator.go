@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type replicatorMetrics struct {
+	queueDepth *prometheus.GaugeVec
+}
+
+const (
+	replicatorSubsystem = "replicator"
+
+	priorityLabel = "priority"
+)
+
+func newReplicatorMetrics() replicatorMetrics {
+	queueDepth := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: replicatorSubsystem,
+		Name:      "queue_depth",
+		Help:      "Number of objects waiting for replication",
+	}, []string{priorityLabel})
+
+	return replicatorMetrics{
+		queueDepth: queueDepth,
+	}
+}
+
+func (m replicatorMetrics) register() {
+	prometheus.MustRegister(m.queueDepth)
+}
+
+func (m replicatorMetrics) SetReplicatorQueueDepth(priority string, n uint64) {
+	m.queueDepth.With(prometheus.Labels{priorityLabel: priority}).Set(float64(n))
+}
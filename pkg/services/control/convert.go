@@ -166,3 +166,147 @@ func (w *restoreShardResponseWrapper) FromGRPCMessage(m grpc.Message) error {
 	w.RestoreShardResponse = r
 	return nil
 }
+
+type addShardResponseWrapper struct {
+	*AddShardResponse
+}
+
+func (w *addShardResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.AddShardResponse
+}
+
+func (w *addShardResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*AddShardResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*AddShardResponse)(nil))
+	}
+
+	w.AddShardResponse = r
+	return nil
+}
+
+type detachShardResponseWrapper struct {
+	*DetachShardResponse
+}
+
+func (w *detachShardResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.DetachShardResponse
+}
+
+func (w *detachShardResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*DetachShardResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*DetachShardResponse)(nil))
+	}
+
+	w.DetachShardResponse = r
+	return nil
+}
+
+type evacuateShardResponseWrapper struct {
+	*EvacuateShardResponse
+}
+
+func (w *evacuateShardResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.EvacuateShardResponse
+}
+
+func (w *evacuateShardResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*EvacuateShardResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*EvacuateShardResponse)(nil))
+	}
+
+	w.EvacuateShardResponse = r
+	return nil
+}
+
+type flushWriteCacheResponseWrapper struct {
+	*FlushWriteCacheResponse
+}
+
+func (w *flushWriteCacheResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.FlushWriteCacheResponse
+}
+
+func (w *flushWriteCacheResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*FlushWriteCacheResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*FlushWriteCacheResponse)(nil))
+	}
+
+	w.FlushWriteCacheResponse = r
+	return nil
+}
+
+type writeCacheStatsResponseWrapper struct {
+	*WriteCacheStatsResponse
+}
+
+func (w *writeCacheStatsResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.WriteCacheStatsResponse
+}
+
+func (w *writeCacheStatsResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*WriteCacheStatsResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*WriteCacheStatsResponse)(nil))
+	}
+
+	w.WriteCacheStatsResponse = r
+	return nil
+}
+
+type compactShardResponseWrapper struct {
+	*CompactShardResponse
+}
+
+func (w *compactShardResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.CompactShardResponse
+}
+
+func (w *compactShardResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*CompactShardResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*CompactShardResponse)(nil))
+	}
+
+	w.CompactShardResponse = r
+	return nil
+}
+
+type gcStatsResponseWrapper struct {
+	*GCStatsResponse
+}
+
+func (w *gcStatsResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GCStatsResponse
+}
+
+func (w *gcStatsResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GCStatsResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GCStatsResponse)(nil))
+	}
+
+	w.GCStatsResponse = r
+	return nil
+}
+
+type checkPlacementResponseWrapper struct {
+	*CheckPlacementResponse
+}
+
+func (w *checkPlacementResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.CheckPlacementResponse
+}
+
+func (w *checkPlacementResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*CheckPlacementResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*CheckPlacementResponse)(nil))
+	}
+
+	w.CheckPlacementResponse = r
+	return nil
+}
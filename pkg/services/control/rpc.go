@@ -16,6 +16,14 @@ const (
 	rpcSetShardMode    = "SetShardMode"
 	rpcDumpShard       = "DumpShard"
 	rpcRestoreShard    = "RestoreShard"
+	rpcAddShard        = "AddShard"
+	rpcDetachShard     = "DetachShard"
+	rpcEvacuateShard   = "EvacuateShard"
+	rpcFlushWriteCache = "FlushWriteCache"
+	rpcWriteCacheStats = "WriteCacheStats"
+	rpcCompactShard    = "CompactShard"
+	rpcGCStats         = "GCStats"
+	rpcCheckPlacement  = "CheckPlacement"
 )
 
 // HealthCheck executes ControlService.HealthCheck RPC.
@@ -172,3 +180,107 @@ func RestoreShard(cli *client.Client, req *RestoreShardRequest, opts ...client.C
 
 	return wResp.RestoreShardResponse, nil
 }
+
+// AddShard executes ControlService.AddShard RPC.
+func AddShard(cli *client.Client, req *AddShardRequest, opts ...client.CallOption) (*AddShardResponse, error) {
+	wResp := &addShardResponseWrapper{new(AddShardResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcAddShard), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.AddShardResponse, nil
+}
+
+// DetachShard executes ControlService.DetachShard RPC.
+func DetachShard(cli *client.Client, req *DetachShardRequest, opts ...client.CallOption) (*DetachShardResponse, error) {
+	wResp := &detachShardResponseWrapper{new(DetachShardResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcDetachShard), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.DetachShardResponse, nil
+}
+
+// EvacuateShard executes ControlService.EvacuateShard RPC.
+func EvacuateShard(cli *client.Client, req *EvacuateShardRequest, opts ...client.CallOption) (*EvacuateShardResponse, error) {
+	wResp := &evacuateShardResponseWrapper{new(EvacuateShardResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcEvacuateShard), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.EvacuateShardResponse, nil
+}
+
+// FlushWriteCache executes ControlService.FlushWriteCache RPC.
+func FlushWriteCache(cli *client.Client, req *FlushWriteCacheRequest, opts ...client.CallOption) (*FlushWriteCacheResponse, error) {
+	wResp := &flushWriteCacheResponseWrapper{new(FlushWriteCacheResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcFlushWriteCache), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.FlushWriteCacheResponse, nil
+}
+
+// WriteCacheStats executes ControlService.WriteCacheStats RPC.
+func WriteCacheStats(cli *client.Client, req *WriteCacheStatsRequest, opts ...client.CallOption) (*WriteCacheStatsResponse, error) {
+	wResp := &writeCacheStatsResponseWrapper{new(WriteCacheStatsResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcWriteCacheStats), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.WriteCacheStatsResponse, nil
+}
+
+// CompactShard executes ControlService.CompactShard RPC.
+func CompactShard(cli *client.Client, req *CompactShardRequest, opts ...client.CallOption) (*CompactShardResponse, error) {
+	wResp := &compactShardResponseWrapper{new(CompactShardResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcCompactShard), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.CompactShardResponse, nil
+}
+
+// GCStats executes ControlService.GCStats RPC.
+func GCStats(cli *client.Client, req *GCStatsRequest, opts ...client.CallOption) (*GCStatsResponse, error) {
+	wResp := &gcStatsResponseWrapper{new(GCStatsResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGCStats), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GCStatsResponse, nil
+}
+
+// CheckPlacement executes ControlService.CheckPlacement RPC.
+func CheckPlacement(cli *client.Client, req *CheckPlacementRequest, opts ...client.CallOption) (*CheckPlacementResponse, error) {
+	wResp := &checkPlacementResponseWrapper{new(CheckPlacementResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcCheckPlacement), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.CheckPlacementResponse, nil
+}
@@ -0,0 +1,49 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) AddShard(_ context.Context, req *control.AddShardRequest) (*control.AddShardResponse, error) {
+	// verify request
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	body := req.GetBody()
+
+	if body.GetMetabasePath() == "" || body.GetBlobstorPath() == "" {
+		return nil, status.Error(codes.InvalidArgument, "metabase and blobstor paths must be specified")
+	}
+
+	mode, err := shardModeFromGRPC(body.GetMode())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, err := s.shardAttacher.AttachShard(mode, body.GetMetabasePath(), body.GetBlobstorPath(), body.GetWritecachePath())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// create and fill response
+	resp := new(control.AddShardResponse)
+
+	respBody := new(control.AddShardResponse_Body)
+	respBody.SetShardID(*id)
+
+	resp.SetBody(respBody)
+
+	// sign the response
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,111 @@
+package control
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	v2refs "github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PlacementChecker is an interface of the component which
+// checks placement of the local objects out of turn.
+type PlacementChecker interface {
+	// CheckContainer must queue placement check of all local objects
+	// of the container and return the number of queued objects.
+	// Must return false if the queue is full and not all objects are queued.
+	CheckContainer(*cid.ID) (uint32, bool, error)
+
+	// CheckObjects must queue placement check of the local objects
+	// and return the number of queued objects.
+	// Must return false if the queue is full and not all objects are queued.
+	CheckObjects([]*addressSDK.Address) (uint32, bool)
+}
+
+// CheckPlacement queues out-of-turn placement check of the local objects
+// of the container and of the objects with the specified addresses.
+//
+// If the queue is full and not all objects are queued, resource
+// exhausted error with the number of the queued objects returns.
+//
+// If request is unsigned or signed by disallowed key, permission error returns.
+func (s *Server) CheckPlacement(_ context.Context, req *control.CheckPlacementRequest) (*control.CheckPlacementResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	binCID := req.GetBody().GetContainerId()
+	binAddrList := req.GetBody().GetAddressList()
+
+	if len(binCID) == 0 && len(binAddrList) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "container ID or object addresses must be specified")
+	}
+
+	var id *cid.ID
+
+	if len(binCID) != 0 {
+		if len(binCID) != sha256.Size {
+			return nil, status.Error(codes.InvalidArgument,
+				fmt.Sprintf("invalid container ID length %d", len(binCID)),
+			)
+		}
+
+		v2 := new(v2refs.ContainerID)
+		v2.SetValue(binCID)
+
+		id = cid.NewFromV2(v2)
+	}
+
+	addrList := make([]*addressSDK.Address, 0, len(binAddrList))
+
+	for i := range binAddrList {
+		a := addressSDK.NewAddress()
+
+		err := a.Unmarshal(binAddrList[i])
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument,
+				fmt.Sprintf("invalid binary object address: %v", err),
+			)
+		}
+
+		addrList = append(addrList, a)
+	}
+
+	queued, ok := s.placementChecker.CheckObjects(addrList)
+
+	if ok && id != nil {
+		var n uint32
+
+		n, ok, err = s.placementChecker.CheckContainer(id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		queued += n
+	}
+
+	if !ok {
+		return nil, status.Error(codes.ResourceExhausted,
+			fmt.Sprintf("placement check queue is full, %d objects queued, try again later", queued),
+		)
+	}
+
+	body := new(control.CheckPlacementResponse_Body)
+	body.SetQueued(queued)
+
+	resp := new(control.CheckPlacementResponse)
+	resp.SetBody(body)
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,40 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CompactShard compacts sparse blobovniczas of the shard.
+func (s *Server) CompactShard(_ context.Context, req *control.CompactShardRequest) (*control.CompactShardResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	res, err := s.s.CompactShard(
+		shard.NewIDFromBytes(req.GetBody().GetShard_ID()),
+		new(shard.CompactPrm).WithFillPercent(req.GetBody().GetFillPercent()),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	body := new(control.CompactShardResponse_Body)
+	body.SetCompacted(res.Compacted())
+	body.SetMoved(res.Moved())
+	body.SetReclaimed(res.Reclaimed())
+
+	resp := new(control.CompactShardResponse)
+	resp.SetBody(body)
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -0,0 +1,47 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) DetachShard(_ context.Context, req *control.DetachShardRequest) (*control.DetachShardResponse, error) {
+	// verify request
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	rawIDs := req.GetBody().GetShard_ID()
+	if len(rawIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no shard IDs specified")
+	}
+
+	ids := make([]*shard.ID, 0, len(rawIDs))
+	for i := range rawIDs {
+		ids = append(ids, shard.NewIDFromBytes(rawIDs[i]))
+	}
+
+	err = s.shardAttacher.DetachShards(ids)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// create and fill response
+	resp := new(control.DetachShardResponse)
+
+	body := new(control.DetachShardResponse_Body)
+	resp.SetBody(body)
+
+	// sign the response
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
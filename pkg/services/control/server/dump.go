@@ -3,8 +3,10 @@ package control
 import (
 	"context"
 
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -15,19 +17,42 @@ func (s *Server) DumpShard(_ context.Context, req *control.DumpShardRequest) (*c
 		return nil, status.Error(codes.PermissionDenied, err.Error())
 	}
 
-	shardID := shard.NewIDFromBytes(req.GetBody().GetShard_ID())
+	body := req.GetBody()
+
+	shardID := shard.NewIDFromBytes(body.GetShard_ID())
+
+	rawCIDs := body.GetContainer_ID()
+	cnrs := make([]*cid.ID, 0, len(rawCIDs))
+
+	for i := range rawCIDs {
+		v2 := new(refs.ContainerID)
+		v2.SetValue(rawCIDs[i])
+
+		cnrs = append(cnrs, cid.NewFromV2(v2))
+	}
 
 	prm := new(shard.DumpPrm)
-	prm.WithPath(req.GetBody().GetFilepath())
-	prm.WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
+	prm.WithPath(body.GetFilepath())
+	prm.WithIgnoreErrors(body.GetIgnoreErrors())
+	prm.WithCompression(body.GetCompress())
+	prm.WithContainers(cnrs)
+	prm.WithEpochRange(body.GetEpochFrom(), body.GetEpochTo())
+
+	if body.GetIncremental() {
+		prm.WithCheckpoint(body.GetCheckpoint())
+	}
 
-	err = s.s.DumpShard(shardID, prm)
+	res, err := s.s.DumpShard(shardID, prm)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	respBody := new(control.DumpShardResponse_Body)
+	respBody.SetCount(uint64(res.Count()))
+	respBody.SetCheckpoint(res.Checkpoint())
+
 	resp := new(control.DumpShardResponse)
-	resp.SetBody(new(control.DumpShardResponse_Body))
+	resp.SetBody(respBody)
 
 	err = SignMessage(s.key, resp)
 	if err != nil {
@@ -0,0 +1,58 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReplicateObjectHandler is a handler of objects which can not be
+// evacuated to any other shard of the node. It must save the object
+// on the remote container nodes.
+type ReplicateObjectHandler func(*addressSDK.Address, *objectSDK.Object) error
+
+// EvacuateShard moves all objects from the shard to other shards of the node.
+//
+// Objects which can not be put to any other shard are replicated
+// via replication handler, if it is set. Evacuation is interrupted
+// when the request context is done, the next request resumes it.
+func (s *Server) EvacuateShard(ctx context.Context, req *control.EvacuateShardRequest) (*control.EvacuateShardResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	prm := new(engine.EvacuateShardPrm).
+		WithContext(ctx).
+		WithShardID(shard.NewIDFromBytes(req.GetBody().GetShard_ID())).
+		WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
+
+	if s.replicateHandler != nil {
+		prm.WithFaultHandler(s.replicateHandler)
+	}
+
+	res, err := s.s.Evacuate(prm)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	body := new(control.EvacuateShardResponse_Body)
+	body.SetCount(res.Count())
+	body.SetReplicated(res.Replicated())
+	body.SetFailed(res.Failed())
+
+	resp := new(control.EvacuateShardResponse)
+	resp.SetBody(body)
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -0,0 +1,71 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GCStats returns statistics of the garbage collector of the shards.
+// If no shard IDs are specified, statistics of all shards which
+// use the metabase in the current mode are returned.
+func (s *Server) GCStats(_ context.Context, req *control.GCStatsRequest) (*control.GCStatsResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	rawIDs := req.GetBody().GetShard_ID()
+
+	ids := make([]*shard.ID, 0, len(rawIDs))
+	for i := range rawIDs {
+		ids = append(ids, shard.NewIDFromBytes(rawIDs[i]))
+	}
+
+	if len(ids) == 0 {
+		for _, sh := range s.s.DumpInfo().Shards {
+			if sh.Mode != shard.ModeDegraded && sh.Mode != shard.ModeMaintenance {
+				ids = append(ids, sh.ID)
+			}
+		}
+	}
+
+	stats := make([]*control.ShardGCStats, 0, len(ids))
+
+	for i := range ids {
+		st, err := s.s.GCStats(ids[i])
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		gcs := new(control.ShardGCStats)
+		gcs.SetID(*ids[i])
+		gcs.SetGraveyardSize(st.GraveyardSize)
+		gcs.SetPendingGarbage(st.PendingGarbage)
+		gcs.SetLastRemoved(st.LastRemoved)
+		gcs.SetTotalRemoved(st.TotalRemoved)
+		gcs.SetSkippedRuns(st.SkippedRuns)
+
+		if !st.LastRun.IsZero() {
+			gcs.SetLastRun(uint64(st.LastRun.Unix()))
+		}
+
+		stats = append(stats, gcs)
+	}
+
+	body := new(control.GCStatsResponse_Body)
+	body.SetStats(stats)
+
+	resp := new(control.GCStatsResponse)
+	resp.SetBody(body)
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/ecdsa"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
@@ -36,6 +37,19 @@ type NodeState interface {
 	SetNetmapStatus(control.NetmapStatus) error
 }
 
+// ShardAttacher is an interface of the component which
+// attaches and detaches storage engine shards at runtime.
+type ShardAttacher interface {
+	// AttachShard must create the shard with the provided paths, open and
+	// initialize it in the provided mode and add it to the storage engine.
+	//
+	// Empty write-cache path means that the write-cache is disabled.
+	AttachShard(mode shard.Mode, metabasePath, blobstorPath, writeCachePath string) (*shard.ID, error)
+
+	// DetachShards must remove the shards from the storage engine and close them.
+	DetachShards(ids []*shard.ID) error
+}
+
 // Option of the Server's constructor.
 type Option func(*cfg)
 
@@ -53,6 +67,12 @@ type cfg struct {
 	delObjHandler DeletedObjectHandler
 
 	s *engine.StorageEngine
+
+	shardAttacher ShardAttacher
+
+	replicateHandler ReplicateObjectHandler
+
+	placementChecker PlacementChecker
 }
 
 func defaultCfg() *cfg {
@@ -125,3 +145,27 @@ func WithLocalStorage(engine *engine.StorageEngine) Option {
 		c.s = engine
 	}
 }
+
+// WithShardAttacher returns option to set component
+// which attaches and detaches shards at runtime.
+func WithShardAttacher(a ShardAttacher) Option {
+	return func(c *cfg) {
+		c.shardAttacher = a
+	}
+}
+
+// WithReplicateObjectHandler returns option to set the handler
+// of objects which can not be evacuated to any other shard.
+func WithReplicateObjectHandler(h ReplicateObjectHandler) Option {
+	return func(c *cfg) {
+		c.replicateHandler = h
+	}
+}
+
+// WithPlacementChecker returns option to set component
+// which checks placement of the local objects out of turn.
+func WithPlacementChecker(c PlacementChecker) Option {
+	return func(cfg *cfg) {
+		cfg.placementChecker = c
+	}
+}
@@ -17,20 +17,11 @@ func (s *Server) SetShardMode(_ context.Context, req *control.SetShardModeReques
 		return nil, status.Error(codes.PermissionDenied, err.Error())
 	}
 
-	var (
-		mode shard.Mode
+	requestedShard := shard.NewIDFromBytes(req.Body.GetShard_ID())
 
-		requestedMode  = req.GetBody().GetMode()
-		requestedShard = shard.NewIDFromBytes(req.Body.GetShard_ID())
-	)
-
-	switch requestedMode {
-	case control.ShardMode_READ_WRITE:
-		mode = shard.ModeReadWrite
-	case control.ShardMode_READ_ONLY:
-		mode = shard.ModeReadOnly
-	default:
-		return nil, status.Error(codes.Internal, fmt.Sprintf("unknown shard mode: %s", requestedMode))
+	mode, err := shardModeFromGRPC(req.GetBody().GetMode())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	err = s.s.SetShardMode(requestedShard, mode, false)
@@ -52,3 +43,18 @@ func (s *Server) SetShardMode(_ context.Context, req *control.SetShardModeReques
 
 	return resp, nil
 }
+
+func shardModeFromGRPC(m control.ShardMode) (shard.Mode, error) {
+	switch m {
+	case control.ShardMode_READ_WRITE:
+		return shard.ModeReadWrite, nil
+	case control.ShardMode_READ_ONLY:
+		return shard.ModeReadOnly, nil
+	case control.ShardMode_DEGRADED:
+		return shard.ModeDegraded, nil
+	case control.ShardMode_SHARD_MAINTENANCE:
+		return shard.ModeMaintenance, nil
+	default:
+		return 0, fmt.Errorf("unknown shard mode: %s", m)
+	}
+}
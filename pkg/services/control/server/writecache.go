@@ -0,0 +1,102 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FlushWriteCache writes all objects from the write-cache
+// of the shards to their main storage.
+func (s *Server) FlushWriteCache(_ context.Context, req *control.FlushWriteCacheRequest) (*control.FlushWriteCacheResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	rawIDs := req.GetBody().GetShard_ID()
+	if len(rawIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no shard IDs specified")
+	}
+
+	for i := range rawIDs {
+		err = s.s.FlushWriteCache(shard.NewIDFromBytes(rawIDs[i]))
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	resp := new(control.FlushWriteCacheResponse)
+	resp.SetBody(new(control.FlushWriteCacheResponse_Body))
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// WriteCacheStats returns statistics of the write-cache of the shards.
+// If no shard IDs are specified, statistics of all shards which are not
+// in maintenance mode are returned.
+func (s *Server) WriteCacheStats(_ context.Context, req *control.WriteCacheStatsRequest) (*control.WriteCacheStatsResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	rawIDs := req.GetBody().GetShard_ID()
+
+	ids := make([]*shard.ID, 0, len(rawIDs))
+	for i := range rawIDs {
+		ids = append(ids, shard.NewIDFromBytes(rawIDs[i]))
+	}
+
+	if len(ids) == 0 {
+		for _, sh := range s.s.DumpInfo().Shards {
+			if sh.Mode != shard.ModeMaintenance {
+				ids = append(ids, sh.ID)
+			}
+		}
+	}
+
+	stats := make([]*control.ShardWriteCacheStats, 0, len(ids))
+
+	for i := range ids {
+		st, err := s.s.WriteCacheStats(ids[i])
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if st == nil {
+			continue
+		}
+
+		wcs := new(control.ShardWriteCacheStats)
+		wcs.SetID(*ids[i])
+		wcs.SetMemCount(st.MemCount)
+		wcs.SetMemSize(st.MemSize)
+		wcs.SetDBCount(st.DBCount)
+		wcs.SetFSCount(st.FSCount)
+		wcs.SetFlushLag(uint64(st.FlushLag.Milliseconds()))
+
+		stats = append(stats, wcs)
+	}
+
+	body := new(control.WriteCacheStatsResponse_Body)
+	body.SetStats(stats)
+
+	resp := new(control.WriteCacheStatsResponse)
+	resp.SetBody(body)
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
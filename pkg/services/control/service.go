@@ -940,11 +940,47 @@ func (x *DumpShardRequest_Body) SetIgnoreErrors(ignore bool) {
 	x.IgnoreErrors = ignore
 }
 
+// SetContainerIDList sets list of container IDs for the dump shard request.
+func (x *DumpShardRequest_Body) SetContainerIDList(ids [][]byte) {
+	x.Container_ID = ids
+}
+
+// SetEpochFrom sets minimal object creation epoch for the dump shard request.
+func (x *DumpShardRequest_Body) SetEpochFrom(epoch uint64) {
+	x.EpochFrom = epoch
+}
+
+// SetEpochTo sets maximal object creation epoch for the dump shard request.
+func (x *DumpShardRequest_Body) SetEpochTo(epoch uint64) {
+	x.EpochTo = epoch
+}
+
+// SetIncremental sets incremental dump flag for the dump shard request.
+func (x *DumpShardRequest_Body) SetIncremental(incremental bool) {
+	x.Incremental = incremental
+}
+
+// SetCheckpoint sets checkpoint of the previous dump for the dump shard request.
+func (x *DumpShardRequest_Body) SetCheckpoint(checkpoint uint64) {
+	x.Checkpoint = checkpoint
+}
+
+// SetCompress sets compression flag for the dump shard request.
+func (x *DumpShardRequest_Body) SetCompress(compress bool) {
+	x.Compress = compress
+}
+
 const (
 	_ = iota
 	dumpShardReqBodyShardIDFNum
 	dumpShardReqBodyFilepathFNum
 	dumpShardReqBodyIgnoreErrorsFNum
+	dumpShardReqBodyContainerIDFNum
+	dumpShardReqBodyEpochFromFNum
+	dumpShardReqBodyEpochToFNum
+	dumpShardReqBodyIncrementalFNum
+	dumpShardReqBodyCheckpointFNum
+	dumpShardReqBodyCompressFNum
 )
 
 // StableMarshal reads binary representation of request body binary format.
@@ -983,7 +1019,49 @@ func (x *DumpShardRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
 
 	offset += n
 
-	_, err = proto.BoolMarshal(dumpShardReqBodyIgnoreErrorsFNum, buf[offset:], x.IgnoreErrors)
+	n, err = proto.BoolMarshal(dumpShardReqBodyIgnoreErrorsFNum, buf[offset:], x.IgnoreErrors)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.RepeatedBytesMarshal(dumpShardReqBodyContainerIDFNum, buf[offset:], x.Container_ID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(dumpShardReqBodyEpochFromFNum, buf[offset:], x.EpochFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(dumpShardReqBodyEpochToFNum, buf[offset:], x.EpochTo)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.BoolMarshal(dumpShardReqBodyIncrementalFNum, buf[offset:], x.Incremental)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(dumpShardReqBodyCheckpointFNum, buf[offset:], x.Checkpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.BoolMarshal(dumpShardReqBodyCompressFNum, buf[offset:], x.Compress)
 	if err != nil {
 		return nil, err
 	}
@@ -1004,6 +1082,12 @@ func (x *DumpShardRequest_Body) StableSize() int {
 	size += proto.BytesSize(dumpShardReqBodyShardIDFNum, x.Shard_ID)
 	size += proto.StringSize(dumpShardReqBodyFilepathFNum, x.Filepath)
 	size += proto.BoolSize(dumpShardReqBodyIgnoreErrorsFNum, x.IgnoreErrors)
+	size += proto.RepeatedBytesSize(dumpShardReqBodyContainerIDFNum, x.Container_ID)
+	size += proto.UInt64Size(dumpShardReqBodyEpochFromFNum, x.EpochFrom)
+	size += proto.UInt64Size(dumpShardReqBodyEpochToFNum, x.EpochTo)
+	size += proto.BoolSize(dumpShardReqBodyIncrementalFNum, x.Incremental)
+	size += proto.UInt64Size(dumpShardReqBodyCheckpointFNum, x.Checkpoint)
+	size += proto.BoolSize(dumpShardReqBodyCompressFNum, x.Compress)
 
 	return size
 }
@@ -1041,6 +1125,22 @@ func (x *DumpShardRequest) SignedDataSize() int {
 	return x.GetBody().StableSize()
 }
 
+// SetCount sets number of dumped objects.
+func (x *DumpShardResponse_Body) SetCount(v uint64) {
+	x.Count = v
+}
+
+// SetCheckpoint sets checkpoint of the dump.
+func (x *DumpShardResponse_Body) SetCheckpoint(v uint64) {
+	x.Checkpoint = v
+}
+
+const (
+	_ = iota
+	dumpShardRespBodyCountFNum
+	dumpShardRespBodyCheckpointFNum
+)
+
 // StableMarshal reads binary representation of the response body in protobuf binary format.
 //
 // If buffer length is less than x.StableSize(), new buffer is allocated.
@@ -1050,6 +1150,31 @@ func (x *DumpShardRequest) SignedDataSize() int {
 //
 // Structures with the same field values have the same binary format.
 func (x *DumpShardResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.UInt64Marshal(dumpShardRespBodyCountFNum, buf, x.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.UInt64Marshal(dumpShardRespBodyCheckpointFNum, buf[offset:], x.Checkpoint)
+	if err != nil {
+		return nil, err
+	}
+
 	return buf, nil
 }
 
@@ -1058,7 +1183,16 @@ func (x *DumpShardResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
 //
 // Structures with the same field values have the same binary size.
 func (x *DumpShardResponse_Body) StableSize() int {
-	return 0
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.UInt64Size(dumpShardRespBodyCountFNum, x.Count)
+	size += proto.UInt64Size(dumpShardRespBodyCheckpointFNum, x.Checkpoint)
+
+	return size
 }
 
 // SetBody sets response body.
@@ -1262,3 +1396,1508 @@ func (x *RestoreShardResponse) ReadSignedData(buf []byte) ([]byte, error) {
 func (x *RestoreShardResponse) SignedDataSize() int {
 	return x.GetBody().StableSize()
 }
+
+// SetMetabasePath sets path to the metabase of the new shard.
+func (x *AddShardRequest_Body) SetMetabasePath(v string) {
+	x.MetabasePath = v
+}
+
+// SetBlobstorPath sets path to the BLOB storage of the new shard.
+func (x *AddShardRequest_Body) SetBlobstorPath(v string) {
+	x.BlobstorPath = v
+}
+
+// SetWritecachePath sets path to the write-cache of the new shard.
+func (x *AddShardRequest_Body) SetWritecachePath(v string) {
+	x.WritecachePath = v
+}
+
+// SetMode sets initial mode of the new shard.
+func (x *AddShardRequest_Body) SetMode(v ShardMode) {
+	x.Mode = v
+}
+
+const (
+	_ = iota
+	addShardReqBodyMetabasePathFNum
+	addShardReqBodyBlobstorPathFNum
+	addShardReqBodyWritecachePathFNum
+	addShardReqBodyModeFNum
+)
+
+// StableMarshal reads binary representation of the add shard request body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *AddShardRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.StringMarshal(addShardReqBodyMetabasePathFNum, buf, x.MetabasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.StringMarshal(addShardReqBodyBlobstorPathFNum, buf[offset:], x.BlobstorPath)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.StringMarshal(addShardReqBodyWritecachePathFNum, buf[offset:], x.WritecachePath)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.EnumMarshal(addShardReqBodyModeFNum, buf[offset:], int32(x.Mode))
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the add shard request body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *AddShardRequest_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.StringSize(addShardReqBodyMetabasePathFNum, x.MetabasePath)
+	size += proto.StringSize(addShardReqBodyBlobstorPathFNum, x.BlobstorPath)
+	size += proto.StringSize(addShardReqBodyWritecachePathFNum, x.WritecachePath)
+	size += proto.EnumSize(addShardReqBodyModeFNum, int32(x.Mode))
+
+	return size
+}
+
+// SetBody sets body of the add shard request.
+func (x *AddShardRequest) SetBody(v *AddShardRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the add shard request body.
+func (x *AddShardRequest) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of the add shard request to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *AddShardRequest) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of the add shard request.
+//
+// Structures with the same field values have the same signed data size.
+func (x *AddShardRequest) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetShardID sets ID of the added shard.
+func (x *AddShardResponse_Body) SetShardID(v []byte) {
+	x.Shard_ID = v
+}
+
+const (
+	_ = iota
+	addShardRespBodyShardIDFNum
+)
+
+// StableMarshal reads binary representation of the add shard response body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *AddShardResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	_, err := proto.BytesMarshal(addShardRespBodyShardIDFNum, buf, x.Shard_ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the add shard response body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *AddShardResponse_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.BytesSize(addShardRespBodyShardIDFNum, x.Shard_ID)
+
+	return size
+}
+
+// SetBody sets body of the add shard response.
+func (x *AddShardResponse) SetBody(v *AddShardResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the add shard response body.
+func (x *AddShardResponse) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of the add shard response to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *AddShardResponse) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of the add shard response.
+//
+// Structures with the same field values have the same signed data size.
+func (x *AddShardResponse) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetShardIDList sets IDs of the shards to detach.
+func (x *DetachShardRequest_Body) SetShardIDList(v [][]byte) {
+	x.Shard_ID = v
+}
+
+const (
+	_ = iota
+	detachShardReqBodyShardIDFNum
+)
+
+// StableMarshal reads binary representation of the detach shard request body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *DetachShardRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	_, err := proto.RepeatedBytesMarshal(detachShardReqBodyShardIDFNum, buf, x.Shard_ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the detach shard request body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *DetachShardRequest_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.RepeatedBytesSize(detachShardReqBodyShardIDFNum, x.Shard_ID)
+
+	return size
+}
+
+// SetBody sets body of the detach shard request.
+func (x *DetachShardRequest) SetBody(v *DetachShardRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the detach shard request body.
+func (x *DetachShardRequest) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of the detach shard request to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *DetachShardRequest) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of the detach shard request.
+//
+// Structures with the same field values have the same signed data size.
+func (x *DetachShardRequest) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// StableMarshal reads binary representation of the detach shard response body in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *DetachShardResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	return buf, nil
+}
+
+// StableSize returns binary size of the detach shard response body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *DetachShardResponse_Body) StableSize() int {
+	return 0
+}
+
+// SetBody sets body of the detach shard response.
+func (x *DetachShardResponse) SetBody(v *DetachShardResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the detach shard response body.
+func (x *DetachShardResponse) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of the detach shard response to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *DetachShardResponse) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of the detach shard response.
+//
+// Structures with the same field values have the same signed data size.
+func (x *DetachShardResponse) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetShardID sets ID of the shard to evacuate.
+func (x *EvacuateShardRequest_Body) SetShardID(v []byte) {
+	x.Shard_ID = v
+}
+
+// SetIgnoreErrors sets flag indicating whether object read errors should be ignored.
+func (x *EvacuateShardRequest_Body) SetIgnoreErrors(v bool) {
+	x.IgnoreErrors = v
+}
+
+const (
+	_ = iota
+	evacuateShardReqBodyShardIDFNum
+	evacuateShardReqBodyIgnoreErrorsFNum
+)
+
+// StableMarshal reads binary representation of the evacuate shard request body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *EvacuateShardRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.BytesMarshal(evacuateShardReqBodyShardIDFNum, buf, x.Shard_ID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.BoolMarshal(evacuateShardReqBodyIgnoreErrorsFNum, buf[offset:], x.IgnoreErrors)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the evacuate shard request body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *EvacuateShardRequest_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.BytesSize(evacuateShardReqBodyShardIDFNum, x.Shard_ID)
+	size += proto.BoolSize(evacuateShardReqBodyIgnoreErrorsFNum, x.IgnoreErrors)
+
+	return size
+}
+
+// SetBody sets body of the evacuate shard request.
+func (x *EvacuateShardRequest) SetBody(v *EvacuateShardRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the evacuate shard request body.
+func (x *EvacuateShardRequest) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of the evacuate shard request to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *EvacuateShardRequest) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of the evacuate shard request.
+//
+// Structures with the same field values have the same signed data size.
+func (x *EvacuateShardRequest) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetCount sets number of objects moved to other shards.
+func (x *EvacuateShardResponse_Body) SetCount(v uint64) {
+	x.Count = v
+}
+
+// SetReplicated sets number of objects replicated to other container nodes.
+func (x *EvacuateShardResponse_Body) SetReplicated(v uint64) {
+	x.Replicated = v
+}
+
+// SetFailed sets number of objects which have not been evacuated due to errors.
+func (x *EvacuateShardResponse_Body) SetFailed(v uint64) {
+	x.Failed = v
+}
+
+const (
+	_ = iota
+	evacuateShardRespBodyCountFNum
+	evacuateShardRespBodyReplicatedFNum
+	evacuateShardRespBodyFailedFNum
+)
+
+// StableMarshal reads binary representation of the evacuate shard response body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *EvacuateShardResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.UInt64Marshal(evacuateShardRespBodyCountFNum, buf, x.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(evacuateShardRespBodyReplicatedFNum, buf[offset:], x.Replicated)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.UInt64Marshal(evacuateShardRespBodyFailedFNum, buf[offset:], x.Failed)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the evacuate shard response body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *EvacuateShardResponse_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.UInt64Size(evacuateShardRespBodyCountFNum, x.Count)
+	size += proto.UInt64Size(evacuateShardRespBodyReplicatedFNum, x.Replicated)
+	size += proto.UInt64Size(evacuateShardRespBodyFailedFNum, x.Failed)
+
+	return size
+}
+
+// SetBody sets body of the evacuate shard response.
+func (x *EvacuateShardResponse) SetBody(v *EvacuateShardResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the evacuate shard response body.
+func (x *EvacuateShardResponse) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of the evacuate shard response to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *EvacuateShardResponse) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of the evacuate shard response.
+//
+// Structures with the same field values have the same signed data size.
+func (x *EvacuateShardResponse) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetShardIDList sets list of IDs of the shards.
+func (x *FlushWriteCacheRequest_Body) SetShardIDList(v [][]byte) {
+	x.Shard_ID = v
+}
+
+const (
+	_ = iota
+	flushWriteCacheReqBodyShardIDFNum
+)
+
+// StableMarshal reads binary representation of the flush write-cache request body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *FlushWriteCacheRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	_, err := proto.RepeatedBytesMarshal(flushWriteCacheReqBodyShardIDFNum, buf, x.Shard_ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the flush write-cache request body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *FlushWriteCacheRequest_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.RepeatedBytesSize(flushWriteCacheReqBodyShardIDFNum, x.Shard_ID)
+
+	return size
+}
+
+// SetBody sets body of the flush write-cache request.
+func (x *FlushWriteCacheRequest) SetBody(v *FlushWriteCacheRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the flush write-cache request body.
+func (x *FlushWriteCacheRequest) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of the flush write-cache request to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *FlushWriteCacheRequest) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of the flush write-cache request.
+//
+// Structures with the same field values have the same signed data size.
+func (x *FlushWriteCacheRequest) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// StableMarshal reads binary representation of the flush write-cache response body in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *FlushWriteCacheResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	return buf, nil
+}
+
+// StableSize returns binary size of the flush write-cache response body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *FlushWriteCacheResponse_Body) StableSize() int {
+	return 0
+}
+
+// SetBody sets body of the flush write-cache response.
+func (x *FlushWriteCacheResponse) SetBody(v *FlushWriteCacheResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the flush write-cache response body.
+func (x *FlushWriteCacheResponse) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of the flush write-cache response to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *FlushWriteCacheResponse) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of the flush write-cache response.
+//
+// Structures with the same field values have the same signed data size.
+func (x *FlushWriteCacheResponse) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetShardIDList sets list of IDs of the shards.
+func (x *WriteCacheStatsRequest_Body) SetShardIDList(v [][]byte) {
+	x.Shard_ID = v
+}
+
+const (
+	_ = iota
+	writeCacheStatsReqBodyShardIDFNum
+)
+
+// StableMarshal reads binary representation of the write-cache stats request body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *WriteCacheStatsRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	_, err := proto.RepeatedBytesMarshal(writeCacheStatsReqBodyShardIDFNum, buf, x.Shard_ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the write-cache stats request body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *WriteCacheStatsRequest_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.RepeatedBytesSize(writeCacheStatsReqBodyShardIDFNum, x.Shard_ID)
+
+	return size
+}
+
+// SetBody sets body of the write-cache stats request.
+func (x *WriteCacheStatsRequest) SetBody(v *WriteCacheStatsRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the write-cache stats request body.
+func (x *WriteCacheStatsRequest) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of the write-cache stats request to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *WriteCacheStatsRequest) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of the write-cache stats request.
+//
+// Structures with the same field values have the same signed data size.
+func (x *WriteCacheStatsRequest) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetStats sets statistics of the write-cache of the shards.
+func (x *WriteCacheStatsResponse_Body) SetStats(v []*ShardWriteCacheStats) {
+	x.Stats = v
+}
+
+const (
+	_ = iota
+	writeCacheStatsRespBodyStatsFNum
+)
+
+// StableMarshal reads binary representation of the write-cache stats response body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *WriteCacheStatsResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	for i := range x.Stats {
+		n, err = proto.NestedStructureMarshal(writeCacheStatsRespBodyStatsFNum, buf[offset:], x.Stats[i])
+		if err != nil {
+			return nil, err
+		}
+
+		offset += n
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the write-cache stats response body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *WriteCacheStatsResponse_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	for i := range x.Stats {
+		size += proto.NestedStructureSize(writeCacheStatsRespBodyStatsFNum, x.Stats[i])
+	}
+
+	return size
+}
+
+// SetBody sets body of the write-cache stats response.
+func (x *WriteCacheStatsResponse) SetBody(v *WriteCacheStatsResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the write-cache stats response body.
+func (x *WriteCacheStatsResponse) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of the write-cache stats response to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *WriteCacheStatsResponse) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of the write-cache stats response.
+//
+// Structures with the same field values have the same signed data size.
+func (x *WriteCacheStatsResponse) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetShardID sets ID of the shard to compact.
+func (x *CompactShardRequest_Body) SetShardID(v []byte) {
+	x.Shard_ID = v
+}
+
+// SetFillPercent sets percent of the blobovnicza file size occupied by
+// the stored objects below which blobovnicza is compacted.
+func (x *CompactShardRequest_Body) SetFillPercent(v uint32) {
+	x.FillPercent = v
+}
+
+const (
+	_ = iota
+	compactShardReqBodyShardIDFNum
+	compactShardReqBodyFillPercentFNum
+)
+
+// StableMarshal reads binary representation of the compact shard request body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *CompactShardRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.BytesMarshal(compactShardReqBodyShardIDFNum, buf, x.Shard_ID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.UInt32Marshal(compactShardReqBodyFillPercentFNum, buf[offset:], x.FillPercent)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the compact shard request body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *CompactShardRequest_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.BytesSize(compactShardReqBodyShardIDFNum, x.Shard_ID)
+	size += proto.UInt32Size(compactShardReqBodyFillPercentFNum, x.FillPercent)
+
+	return size
+}
+
+// SetBody sets body of the compact shard request.
+func (x *CompactShardRequest) SetBody(v *CompactShardRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the compact shard request body.
+func (x *CompactShardRequest) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of the compact shard request to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *CompactShardRequest) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of the compact shard request.
+//
+// Structures with the same field values have the same signed data size.
+func (x *CompactShardRequest) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetCompacted sets number of compacted blobovniczas.
+func (x *CompactShardResponse_Body) SetCompacted(v uint64) {
+	x.Compacted = v
+}
+
+// SetMoved sets number of objects moved to other blobovniczas.
+func (x *CompactShardResponse_Body) SetMoved(v uint64) {
+	x.Moved = v
+}
+
+// SetReclaimed sets number of bytes freed on the disk.
+func (x *CompactShardResponse_Body) SetReclaimed(v uint64) {
+	x.Reclaimed = v
+}
+
+const (
+	_ = iota
+	compactShardRespBodyCompactedFNum
+	compactShardRespBodyMovedFNum
+	compactShardRespBodyReclaimedFNum
+)
+
+// StableMarshal reads binary representation of the compact shard response body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *CompactShardResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.UInt64Marshal(compactShardRespBodyCompactedFNum, buf, x.Compacted)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(compactShardRespBodyMovedFNum, buf[offset:], x.Moved)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.UInt64Marshal(compactShardRespBodyReclaimedFNum, buf[offset:], x.Reclaimed)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the compact shard response body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *CompactShardResponse_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.UInt64Size(compactShardRespBodyCompactedFNum, x.Compacted)
+	size += proto.UInt64Size(compactShardRespBodyMovedFNum, x.Moved)
+	size += proto.UInt64Size(compactShardRespBodyReclaimedFNum, x.Reclaimed)
+
+	return size
+}
+
+// SetBody sets body of the compact shard response.
+func (x *CompactShardResponse) SetBody(v *CompactShardResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the compact shard response body.
+func (x *CompactShardResponse) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of the compact shard response to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *CompactShardResponse) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of the compact shard response.
+//
+// Structures with the same field values have the same signed data size.
+func (x *CompactShardResponse) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetShardIDList sets list of IDs of the shards.
+func (x *GCStatsRequest_Body) SetShardIDList(v [][]byte) {
+	x.Shard_ID = v
+}
+
+const (
+	_ = iota
+	gcStatsReqBodyShardIDFNum
+)
+
+// StableMarshal reads binary representation of the GC stats request body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *GCStatsRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	_, err := proto.RepeatedBytesMarshal(gcStatsReqBodyShardIDFNum, buf, x.Shard_ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the GC stats request body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *GCStatsRequest_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.RepeatedBytesSize(gcStatsReqBodyShardIDFNum, x.Shard_ID)
+
+	return size
+}
+
+// SetBody sets body of the GC stats request.
+func (x *GCStatsRequest) SetBody(v *GCStatsRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the GC stats request body.
+func (x *GCStatsRequest) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of the GC stats request to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *GCStatsRequest) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of the GC stats request.
+//
+// Structures with the same field values have the same signed data size.
+func (x *GCStatsRequest) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetStats sets statistics of the garbage collector of the shards.
+func (x *GCStatsResponse_Body) SetStats(v []*ShardGCStats) {
+	x.Stats = v
+}
+
+const (
+	_ = iota
+	gcStatsRespBodyStatsFNum
+)
+
+// StableMarshal reads binary representation of the GC stats response body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *GCStatsResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	for i := range x.Stats {
+		n, err = proto.NestedStructureMarshal(gcStatsRespBodyStatsFNum, buf[offset:], x.Stats[i])
+		if err != nil {
+			return nil, err
+		}
+
+		offset += n
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the GC stats response body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *GCStatsResponse_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	for i := range x.Stats {
+		size += proto.NestedStructureSize(gcStatsRespBodyStatsFNum, x.Stats[i])
+	}
+
+	return size
+}
+
+// SetBody sets body of the GC stats response.
+func (x *GCStatsResponse) SetBody(v *GCStatsResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the GC stats response body.
+func (x *GCStatsResponse) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of the GC stats response to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *GCStatsResponse) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of the GC stats response.
+//
+// Structures with the same field values have the same signed data size.
+func (x *GCStatsResponse) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetContainerID sets ID of the container to check.
+func (x *CheckPlacementRequest_Body) SetContainerID(v []byte) {
+	x.ContainerId = v
+}
+
+// SetAddressList sets list of addresses of the objects to check.
+func (x *CheckPlacementRequest_Body) SetAddressList(v [][]byte) {
+	x.AddressList = v
+}
+
+const (
+	_ = iota
+	checkPlacementReqBodyContainerIDFNum
+	checkPlacementReqBodyAddressListFNum
+)
+
+// StableMarshal reads binary representation of the check placement request body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *CheckPlacementRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.BytesMarshal(checkPlacementReqBodyContainerIDFNum, buf, x.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.RepeatedBytesMarshal(checkPlacementReqBodyAddressListFNum, buf[offset:], x.AddressList)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the check placement request body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *CheckPlacementRequest_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.BytesSize(checkPlacementReqBodyContainerIDFNum, x.ContainerId)
+	size += proto.RepeatedBytesSize(checkPlacementReqBodyAddressListFNum, x.AddressList)
+
+	return size
+}
+
+// SetBody sets body of the check placement request.
+func (x *CheckPlacementRequest) SetBody(v *CheckPlacementRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the check placement request body.
+func (x *CheckPlacementRequest) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of the check placement request to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *CheckPlacementRequest) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of the check placement request.
+//
+// Structures with the same field values have the same signed data size.
+func (x *CheckPlacementRequest) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetQueued sets number of objects queued for the check.
+func (x *CheckPlacementResponse_Body) SetQueued(v uint32) {
+	x.Queued = v
+}
+
+const (
+	_ = iota
+	checkPlacementRespBodyQueuedFNum
+)
+
+// StableMarshal reads binary representation of the check placement response body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *CheckPlacementResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	_, err := proto.UInt32Marshal(checkPlacementRespBodyQueuedFNum, buf, x.Queued)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the check placement response body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *CheckPlacementResponse_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.UInt32Size(checkPlacementRespBodyQueuedFNum, x.Queued)
+
+	return size
+}
+
+// SetBody sets body of the check placement response.
+func (x *CheckPlacementResponse) SetBody(v *CheckPlacementResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the check placement response body.
+func (x *CheckPlacementResponse) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of the check placement response to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *CheckPlacementResponse) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of the check placement response.
+//
+// Structures with the same field values have the same signed data size.
+func (x *CheckPlacementResponse) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
@@ -149,3 +149,365 @@ func equalSetShardModeRequestBodies(b1, b2 *control.SetShardModeRequest_Body) bo
 
 	return true
 }
+
+func TestAddShardRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateAddShardRequestBody(),
+		new(control.AddShardRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			return equalAddShardRequestBodies(
+				m1.(*control.AddShardRequest_Body),
+				m2.(*control.AddShardRequest_Body),
+			)
+		},
+	)
+}
+
+func generateAddShardRequestBody() *control.AddShardRequest_Body {
+	body := new(control.AddShardRequest_Body)
+	body.SetMetabasePath("/path/to/metabase")
+	body.SetBlobstorPath("/path/to/blobstor")
+	body.SetWritecachePath("/path/to/writecache")
+	body.SetMode(control.ShardMode_DEGRADED)
+
+	return body
+}
+
+func equalAddShardRequestBodies(b1, b2 *control.AddShardRequest_Body) bool {
+	return b1.GetMetabasePath() == b2.GetMetabasePath() &&
+		b1.GetBlobstorPath() == b2.GetBlobstorPath() &&
+		b1.GetWritecachePath() == b2.GetWritecachePath() &&
+		b1.GetMode() == b2.GetMode()
+}
+
+func TestDetachShardRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateDetachShardRequestBody(),
+		new(control.DetachShardRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			return equalDetachShardRequestBodies(
+				m1.(*control.DetachShardRequest_Body),
+				m2.(*control.DetachShardRequest_Body),
+			)
+		},
+	)
+}
+
+func generateDetachShardRequestBody() *control.DetachShardRequest_Body {
+	body := new(control.DetachShardRequest_Body)
+	body.SetShardIDList([][]byte{{0, 1, 2}, {3, 4, 5}})
+
+	return body
+}
+
+func equalDetachShardRequestBodies(b1, b2 *control.DetachShardRequest_Body) bool {
+	if len(b1.GetShard_ID()) != len(b2.GetShard_ID()) {
+		return false
+	}
+
+	for i := range b1.GetShard_ID() {
+		if !bytes.Equal(b1.GetShard_ID()[i], b2.GetShard_ID()[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestEvacuateShardResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateEvacuateShardResponseBody(),
+		new(control.EvacuateShardResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return equalEvacuateShardResponseBodies(
+				m1.(*control.EvacuateShardResponse_Body),
+				m2.(*control.EvacuateShardResponse_Body),
+			)
+		},
+	)
+}
+
+func generateEvacuateShardResponseBody() *control.EvacuateShardResponse_Body {
+	body := new(control.EvacuateShardResponse_Body)
+	body.SetCount(100)
+	body.SetReplicated(10)
+	body.SetFailed(1)
+
+	return body
+}
+
+func equalEvacuateShardResponseBodies(b1, b2 *control.EvacuateShardResponse_Body) bool {
+	return b1.GetCount() == b2.GetCount() &&
+		b1.GetReplicated() == b2.GetReplicated() &&
+		b1.GetFailed() == b2.GetFailed()
+}
+
+func TestDumpShardRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateDumpShardRequestBody(),
+		new(control.DumpShardRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			return equalDumpShardRequestBodies(
+				m1.(*control.DumpShardRequest_Body),
+				m2.(*control.DumpShardRequest_Body),
+			)
+		},
+	)
+}
+
+func generateDumpShardRequestBody() *control.DumpShardRequest_Body {
+	body := new(control.DumpShardRequest_Body)
+	body.SetShardID([]byte{0, 1, 2})
+	body.SetFilepath("/path/to/dump")
+	body.SetIgnoreErrors(true)
+	body.SetContainerIDList([][]byte{{3, 4, 5}, {6, 7, 8}})
+	body.SetEpochFrom(10)
+	body.SetEpochTo(20)
+	body.SetIncremental(true)
+	body.SetCheckpoint(100)
+	body.SetCompress(true)
+
+	return body
+}
+
+func equalDumpShardRequestBodies(b1, b2 *control.DumpShardRequest_Body) bool {
+	if !bytes.Equal(b1.GetShard_ID(), b2.GetShard_ID()) ||
+		b1.GetFilepath() != b2.GetFilepath() ||
+		b1.GetIgnoreErrors() != b2.GetIgnoreErrors() ||
+		b1.GetEpochFrom() != b2.GetEpochFrom() ||
+		b1.GetEpochTo() != b2.GetEpochTo() ||
+		b1.GetIncremental() != b2.GetIncremental() ||
+		b1.GetCheckpoint() != b2.GetCheckpoint() ||
+		b1.GetCompress() != b2.GetCompress() ||
+		len(b1.GetContainer_ID()) != len(b2.GetContainer_ID()) {
+		return false
+	}
+
+	for i := range b1.GetContainer_ID() {
+		if !bytes.Equal(b1.GetContainer_ID()[i], b2.GetContainer_ID()[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestDumpShardResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateDumpShardResponseBody(),
+		new(control.DumpShardResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return equalDumpShardResponseBodies(
+				m1.(*control.DumpShardResponse_Body),
+				m2.(*control.DumpShardResponse_Body),
+			)
+		},
+	)
+}
+
+func generateDumpShardResponseBody() *control.DumpShardResponse_Body {
+	body := new(control.DumpShardResponse_Body)
+	body.SetCount(100)
+	body.SetCheckpoint(1000)
+
+	return body
+}
+
+func equalDumpShardResponseBodies(b1, b2 *control.DumpShardResponse_Body) bool {
+	return b1.GetCount() == b2.GetCount() &&
+		b1.GetCheckpoint() == b2.GetCheckpoint()
+}
+
+func TestWriteCacheStatsResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateWriteCacheStatsResponseBody(),
+		new(control.WriteCacheStatsResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return equalWriteCacheStatsResponseBodies(
+				m1.(*control.WriteCacheStatsResponse_Body),
+				m2.(*control.WriteCacheStatsResponse_Body),
+			)
+		},
+	)
+}
+
+func generateWriteCacheStatsResponseBody() *control.WriteCacheStatsResponse_Body {
+	stats := make([]*control.ShardWriteCacheStats, 2)
+	for i := range stats {
+		stats[i] = new(control.ShardWriteCacheStats)
+		stats[i].SetID([]byte{byte(i), 1, 2})
+		stats[i].SetMemCount(10)
+		stats[i].SetMemSize(1024)
+		stats[i].SetDBCount(100)
+		stats[i].SetFSCount(5)
+		stats[i].SetFlushLag(1500)
+	}
+
+	body := new(control.WriteCacheStatsResponse_Body)
+	body.SetStats(stats)
+
+	return body
+}
+
+func equalWriteCacheStatsResponseBodies(b1, b2 *control.WriteCacheStatsResponse_Body) bool {
+	if len(b1.GetStats()) != len(b2.GetStats()) {
+		return false
+	}
+
+	for i := range b1.GetStats() {
+		s1, s2 := b1.GetStats()[i], b2.GetStats()[i]
+		if !bytes.Equal(s1.GetShard_ID(), s2.GetShard_ID()) ||
+			s1.GetMemCount() != s2.GetMemCount() ||
+			s1.GetMemSize() != s2.GetMemSize() ||
+			s1.GetDbCount() != s2.GetDbCount() ||
+			s1.GetFsCount() != s2.GetFsCount() ||
+			s1.GetFlushLag() != s2.GetFlushLag() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestCompactShardRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateCompactShardRequestBody(),
+		new(control.CompactShardRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			return equalCompactShardRequestBodies(
+				m1.(*control.CompactShardRequest_Body),
+				m2.(*control.CompactShardRequest_Body),
+			)
+		},
+	)
+}
+
+func generateCompactShardRequestBody() *control.CompactShardRequest_Body {
+	body := new(control.CompactShardRequest_Body)
+	body.SetShardID([]byte{1, 2, 3, 4, 5})
+	body.SetFillPercent(30)
+
+	return body
+}
+
+func equalCompactShardRequestBodies(b1, b2 *control.CompactShardRequest_Body) bool {
+	return bytes.Equal(b1.GetShard_ID(), b2.GetShard_ID()) &&
+		b1.GetFillPercent() == b2.GetFillPercent()
+}
+
+func TestCompactShardResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateCompactShardResponseBody(),
+		new(control.CompactShardResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return equalCompactShardResponseBodies(
+				m1.(*control.CompactShardResponse_Body),
+				m2.(*control.CompactShardResponse_Body),
+			)
+		},
+	)
+}
+
+func generateCompactShardResponseBody() *control.CompactShardResponse_Body {
+	body := new(control.CompactShardResponse_Body)
+	body.SetCompacted(3)
+	body.SetMoved(100)
+	body.SetReclaimed(1 << 20)
+
+	return body
+}
+
+func equalCompactShardResponseBodies(b1, b2 *control.CompactShardResponse_Body) bool {
+	return b1.GetCompacted() == b2.GetCompacted() &&
+		b1.GetMoved() == b2.GetMoved() &&
+		b1.GetReclaimed() == b2.GetReclaimed()
+}
+
+func TestGCStatsResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateGCStatsResponseBody(),
+		new(control.GCStatsResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return equalGCStatsResponseBodies(
+				m1.(*control.GCStatsResponse_Body),
+				m2.(*control.GCStatsResponse_Body),
+			)
+		},
+	)
+}
+
+func generateGCStatsResponseBody() *control.GCStatsResponse_Body {
+	stats := make([]*control.ShardGCStats, 2)
+	for i := range stats {
+		stats[i] = new(control.ShardGCStats)
+		stats[i].SetID([]byte{byte(i), 1, 2})
+		stats[i].SetGraveyardSize(1000)
+		stats[i].SetPendingGarbage(200)
+		stats[i].SetLastRemoved(100)
+		stats[i].SetTotalRemoved(5000)
+		stats[i].SetSkippedRuns(3)
+		stats[i].SetLastRun(1650000000)
+	}
+
+	body := new(control.GCStatsResponse_Body)
+	body.SetStats(stats)
+
+	return body
+}
+
+func equalGCStatsResponseBodies(b1, b2 *control.GCStatsResponse_Body) bool {
+	if len(b1.GetStats()) != len(b2.GetStats()) {
+		return false
+	}
+
+	for i := range b1.GetStats() {
+		s1, s2 := b1.GetStats()[i], b2.GetStats()[i]
+		if !bytes.Equal(s1.GetShard_ID(), s2.GetShard_ID()) ||
+			s1.GetGraveyardSize() != s2.GetGraveyardSize() ||
+			s1.GetPendingGarbage() != s2.GetPendingGarbage() ||
+			s1.GetLastRemoved() != s2.GetLastRemoved() ||
+			s1.GetTotalRemoved() != s2.GetTotalRemoved() ||
+			s1.GetSkippedRuns() != s2.GetSkippedRuns() ||
+			s1.GetLastRun() != s2.GetLastRun() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestCheckPlacementRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateCheckPlacementRequestBody(),
+		new(control.CheckPlacementRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			return equalCheckPlacementRequestBodies(
+				m1.(*control.CheckPlacementRequest_Body),
+				m2.(*control.CheckPlacementRequest_Body),
+			)
+		},
+	)
+}
+
+func generateCheckPlacementRequestBody() *control.CheckPlacementRequest_Body {
+	body := new(control.CheckPlacementRequest_Body)
+	body.SetContainerID([]byte{1, 2, 3})
+	body.SetAddressList([][]byte{{4, 5, 6}, {7, 8, 9}})
+
+	return body
+}
+
+func equalCheckPlacementRequestBodies(b1, b2 *control.CheckPlacementRequest_Body) bool {
+	if !bytes.Equal(b1.GetContainerId(), b2.GetContainerId()) ||
+		len(b1.GetAddressList()) != len(b2.GetAddressList()) {
+		return false
+	}
+
+	for i := range b1.GetAddressList() {
+		if !bytes.Equal(b1.GetAddressList()[i], b2.GetAddressList()[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -443,3 +443,274 @@ func (x *ShardInfo) StableMarshal(buf []byte) ([]byte, error) {
 
 	return buf, nil
 }
+
+// SetID sets identificator of the shard.
+func (x *ShardWriteCacheStats) SetID(v []byte) {
+	x.Shard_ID = v
+}
+
+// SetMemCount sets number of objects cached in memory.
+func (x *ShardWriteCacheStats) SetMemCount(v uint64) {
+	x.MemCount = v
+}
+
+// SetMemSize sets total size of the objects cached in memory.
+func (x *ShardWriteCacheStats) SetMemSize(v uint64) {
+	x.MemSize = v
+}
+
+// SetDBCount sets number of objects stored in the write-cache database.
+func (x *ShardWriteCacheStats) SetDBCount(v uint64) {
+	x.DbCount = v
+}
+
+// SetFSCount sets number of objects stored in the write-cache FSTree.
+func (x *ShardWriteCacheStats) SetFSCount(v uint64) {
+	x.FsCount = v
+}
+
+// SetFlushLag sets time in milliseconds elapsed since the last completed flush.
+func (x *ShardWriteCacheStats) SetFlushLag(v uint64) {
+	x.FlushLag = v
+}
+
+const (
+	_ = iota
+	writeCacheStatsIDFNum
+	writeCacheStatsMemCountFNum
+	writeCacheStatsMemSizeFNum
+	writeCacheStatsDbCountFNum
+	writeCacheStatsFsCountFNum
+	writeCacheStatsFlushLagFNum
+)
+
+// StableMarshal reads binary representation of the write-cache statistics
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *ShardWriteCacheStats) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.BytesMarshal(writeCacheStatsIDFNum, buf, x.Shard_ID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(writeCacheStatsMemCountFNum, buf[offset:], x.MemCount)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(writeCacheStatsMemSizeFNum, buf[offset:], x.MemSize)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(writeCacheStatsDbCountFNum, buf[offset:], x.DbCount)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(writeCacheStatsFsCountFNum, buf[offset:], x.FsCount)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.UInt64Marshal(writeCacheStatsFlushLagFNum, buf[offset:], x.FlushLag)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the write-cache statistics
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *ShardWriteCacheStats) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.BytesSize(writeCacheStatsIDFNum, x.Shard_ID)
+	size += proto.UInt64Size(writeCacheStatsMemCountFNum, x.MemCount)
+	size += proto.UInt64Size(writeCacheStatsMemSizeFNum, x.MemSize)
+	size += proto.UInt64Size(writeCacheStatsDbCountFNum, x.DbCount)
+	size += proto.UInt64Size(writeCacheStatsFsCountFNum, x.FsCount)
+	size += proto.UInt64Size(writeCacheStatsFlushLagFNum, x.FlushLag)
+
+	return size
+}
+
+// SetID sets identificator of the shard.
+func (x *ShardGCStats) SetID(v []byte) {
+	x.Shard_ID = v
+}
+
+// SetGraveyardSize sets number of objects in the graveyard.
+func (x *ShardGCStats) SetGraveyardSize(v uint64) {
+	x.GraveyardSize = v
+}
+
+// SetPendingGarbage sets number of objects marked as garbage
+// and waiting to be removed.
+func (x *ShardGCStats) SetPendingGarbage(v uint64) {
+	x.PendingGarbage = v
+}
+
+// SetLastRemoved sets number of objects removed during the last remover run.
+func (x *ShardGCStats) SetLastRemoved(v uint64) {
+	x.LastRemoved = v
+}
+
+// SetTotalRemoved sets total number of objects removed
+// since the shard initialization.
+func (x *ShardGCStats) SetTotalRemoved(v uint64) {
+	x.TotalRemoved = v
+}
+
+// SetSkippedRuns sets number of remover runs skipped because
+// of the foreground load or quiet hours.
+func (x *ShardGCStats) SetSkippedRuns(v uint64) {
+	x.SkippedRuns = v
+}
+
+// SetLastRun sets Unix timestamp of the last remover run in seconds.
+func (x *ShardGCStats) SetLastRun(v uint64) {
+	x.LastRun = v
+}
+
+const (
+	_ = iota
+	gcStatsIDFNum
+	gcStatsGraveyardSizeFNum
+	gcStatsPendingGarbageFNum
+	gcStatsLastRemovedFNum
+	gcStatsTotalRemovedFNum
+	gcStatsSkippedRunsFNum
+	gcStatsLastRunFNum
+)
+
+// StableMarshal reads binary representation of the GC statistics
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *ShardGCStats) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.BytesMarshal(gcStatsIDFNum, buf, x.Shard_ID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(gcStatsGraveyardSizeFNum, buf[offset:], x.GraveyardSize)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(gcStatsPendingGarbageFNum, buf[offset:], x.PendingGarbage)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(gcStatsLastRemovedFNum, buf[offset:], x.LastRemoved)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(gcStatsTotalRemovedFNum, buf[offset:], x.TotalRemoved)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(gcStatsSkippedRunsFNum, buf[offset:], x.SkippedRuns)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.UInt64Marshal(gcStatsLastRunFNum, buf[offset:], x.LastRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the GC statistics
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *ShardGCStats) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.BytesSize(gcStatsIDFNum, x.Shard_ID)
+	size += proto.UInt64Size(gcStatsGraveyardSizeFNum, x.GraveyardSize)
+	size += proto.UInt64Size(gcStatsPendingGarbageFNum, x.PendingGarbage)
+	size += proto.UInt64Size(gcStatsLastRemovedFNum, x.LastRemoved)
+	size += proto.UInt64Size(gcStatsTotalRemovedFNum, x.TotalRemoved)
+	size += proto.UInt64Size(gcStatsSkippedRunsFNum, x.SkippedRuns)
+	size += proto.UInt64Size(gcStatsLastRunFNum, x.LastRun)
+
+	return size
+}
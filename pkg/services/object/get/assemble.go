@@ -1,6 +1,8 @@
 package getsvc
 
 import (
+	"context"
+
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
@@ -123,6 +125,16 @@ func (exec *execCtx) initFromChild(id *oidSDK.ID) (prev *oidSDK.ID, children []o
 func (exec *execCtx) overtakePayloadDirectly(children []oidSDK.ID, rngs []objectSDK.Range, checkRight bool) {
 	withRng := len(rngs) > 0 && exec.ctxRange() != nil
 
+	if exec.svc.prefetchWindow > 1 && len(children) > 1 {
+		if !withRng {
+			rngs = nil
+		}
+
+		exec.overtakePayloadInParallel(children, rngs, !withRng && checkRight)
+
+		return
+	}
+
 	for i := range children {
 		var r *objectSDK.Range
 		if withRng {
@@ -143,6 +155,119 @@ func (exec *execCtx) overtakePayloadDirectly(children []oidSDK.ID, rngs []object
 	exec.err = nil
 }
 
+type childResult struct {
+	obj *objectSDK.Object
+
+	statusError
+}
+
+// prefetchWindow returns the number of children read in parallel so that
+// their total payload size fits the size limit. Zero limit or child size
+// means no size limit. At least one child is read.
+func prefetchWindow(window int, limit, childSize uint64) int {
+	if limit == 0 || childSize == 0 {
+		return window
+	}
+
+	if n := limit / childSize; n < uint64(window) {
+		if n == 0 {
+			return 1
+		}
+
+		return int(n)
+	}
+
+	return window
+}
+
+// overtakePayloadInParallel reads the children concurrently and writes
+// their payloads in order. No more than prefetch window children are
+// being read or wait for the writing at the same time. Reading is aborted
+// on the first failure.
+//
+// Window is reduced to fit the prefetch size by the payload size of the
+// child: all children of the split object except the last one have the
+// same size, so it is taken from the first child read alone. If ranges
+// are requested, the longest one is used.
+func (exec *execCtx) overtakePayloadInParallel(children []oidSDK.ID, rngs []objectSDK.Range, withHdr bool) {
+	ctx, cancel := context.WithCancel(exec.context())
+	defer cancel()
+
+	// set once since common parameters are shared between the readers
+	exec.prm.common = exec.prm.common.WithLocalOnly(false)
+
+	var childSize uint64
+
+	if len(rngs) > 0 {
+		for i := range rngs {
+			if ln := rngs[i].GetLength(); ln > childSize {
+				childSize = ln
+			}
+		}
+	} else if exec.svc.prefetchSize > 0 {
+		obj, res := exec.fetchChild(ctx, &children[0], nil)
+
+		if ok := exec.applyChild(obj, res, withHdr); !ok {
+			return
+		}
+
+		if ok := exec.writeObjectPayload(obj); !ok {
+			return
+		}
+
+		childSize = obj.PayloadSize()
+		children = children[1:]
+	}
+
+	window := make(chan struct{}, prefetchWindow(exec.svc.prefetchWindow, exec.svc.prefetchSize, childSize))
+
+	results := make([]chan childResult, len(children))
+	for i := range results {
+		results[i] = make(chan childResult, 1)
+	}
+
+	go func() {
+		for i := range children {
+			select {
+			case <-ctx.Done():
+				return
+			case window <- struct{}{}:
+			}
+
+			var rng *objectSDK.Range
+			if len(rngs) > 0 {
+				rng = &rngs[i]
+			}
+
+			go func(i int, rng *objectSDK.Range) {
+				obj, res := exec.fetchChild(ctx, &children[i], rng)
+
+				results[i] <- childResult{
+					obj:         obj,
+					statusError: res,
+				}
+			}(i, rng)
+		}
+	}()
+
+	for i := range results {
+		res := <-results[i]
+
+		if ok := exec.applyChild(res.obj, res.statusError, withHdr); !ok {
+			return
+		}
+
+		if ok := exec.writeObjectPayload(res.obj); !ok {
+			return
+		}
+
+		<-window
+	}
+
+	exec.status = statusOK
+	exec.err = nil
+}
+
 func (exec *execCtx) overtakePayloadInReverse(prev *oidSDK.ID) bool {
 	chain, rngs, ok := exec.buildChainInReverse(prev)
 	if !ok {
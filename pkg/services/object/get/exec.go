@@ -179,10 +179,20 @@ func (exec *execCtx) generateTraverser(addr *addressSDK.Address) (*placement.Tra
 }
 
 func (exec *execCtx) getChild(id *oidSDK.ID, rng *objectSDK.Range, withHdr bool) (*objectSDK.Object, bool) {
+	exec.prm.common = exec.prm.common.WithLocalOnly(false)
+
+	child, res := exec.fetchChild(exec.context(), id, rng)
+
+	return child, exec.applyChild(child, res, withHdr)
+}
+
+// fetchChild reads the child object from the container. It does not
+// modify the execution context, so it can be called concurrently.
+// Local-only flag of the common parameters must be unset by the caller.
+func (exec *execCtx) fetchChild(ctx context.Context, id *oidSDK.ID, rng *objectSDK.Range) (*objectSDK.Object, statusError) {
 	w := NewSimpleObjectWriter()
 
 	p := exec.prm
-	p.common = p.common.WithLocalOnly(false)
 	p.objWriter = w
 	p.SetRange(rng)
 
@@ -192,9 +202,16 @@ func (exec *execCtx) getChild(id *oidSDK.ID, rng *objectSDK.Range, withHdr bool)
 
 	p.addr = addr
 
-	exec.statusError = exec.svc.get(exec.context(), p.commonPrm, withPayloadRange(rng))
+	res := exec.svc.get(ctx, p.commonPrm, withPayloadRange(rng))
+
+	return w.Object(), res
+}
+
+// applyChild sets the status of the child reading and checks the parent
+// of the child if withHdr is set.
+func (exec *execCtx) applyChild(child *objectSDK.Object, res statusError, withHdr bool) bool {
+	exec.statusError = res
 
-	child := w.Object()
 	ok := exec.status == statusOK
 
 	if ok && withHdr && !exec.isChild(child) {
@@ -204,7 +221,7 @@ func (exec *execCtx) getChild(id *oidSDK.ID, rng *objectSDK.Range, withHdr bool)
 		exec.log.Debug("parent address in child object differs")
 	}
 
-	return child, ok
+	return ok
 }
 
 func (exec *execCtx) headChild(id *oidSDK.ID) (*objectSDK.Object, bool) {
@@ -901,6 +901,126 @@ func TestGetRemoteSmall(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, payload[off:off+ln], w.Object().Payload())
 			})
+
+			t.Run("parallel", func(t *testing.T) {
+				addr := generateAddress()
+				addr.SetContainerID(cid)
+				addr.SetObjectID(generateID())
+
+				srcObj := generateObject(addr, nil, nil)
+
+				ns, as := testNodeMatrix(t, []int{2})
+
+				splitInfo := objectSDK.NewSplitInfo()
+				splitInfo.SetLink(generateID())
+
+				children, childIDs, payload := generateChain(5, cid)
+				srcObj.SetPayload(payload)
+				srcObj.SetPayloadSize(uint64(len(payload)))
+				children[len(children)-1].SetParent(srcObj)
+
+				linkAddr := addressSDK.NewAddress()
+				linkAddr.SetContainerID(cid)
+				linkAddr.SetObjectID(splitInfo.Link())
+
+				linkingObj := generateObject(linkAddr, nil, nil, childIDs...)
+				linkingObj.SetParentID(addr.ObjectID())
+				linkingObj.SetParent(srcObj)
+
+				c1 := newTestClient()
+				c1.addResult(addr, nil, errors.New("any error"))
+				c1.addResult(linkAddr, nil, errors.New("any error"))
+
+				c2 := newTestClient()
+				c2.addResult(addr, nil, objectSDK.NewSplitInfoError(splitInfo))
+				c2.addResult(linkAddr, linkingObj, nil)
+
+				builder := &testPlacementBuilder{
+					vectors: map[string][]netmap.Nodes{
+						addr.String():     ns,
+						linkAddr.String(): ns,
+					},
+				}
+
+				childAddrs := make([]*addressSDK.Address, len(childIDs))
+
+				for i := range childIDs {
+					childAddrs[i] = addressSDK.NewAddress()
+					childAddrs[i].SetContainerID(cid)
+					childAddrs[i].SetObjectID(&childIDs[i])
+
+					c1.addResult(childAddrs[i], nil, errors.New("any error"))
+					c2.addResult(childAddrs[i], children[i], nil)
+
+					builder.vectors[childAddrs[i].String()] = ns
+				}
+
+				svc := newSvc(builder, &testClientCache{
+					clients: map[string]*testClient{
+						as[0][0]: c1,
+						as[0][1]: c2,
+					},
+				})
+				svc.prefetchWindow = 2
+
+				testHeadVirtual(svc, addr, splitInfo)
+
+				w := NewSimpleObjectWriter()
+
+				p := newPrm(false, w)
+				p.WithAddress(addr)
+
+				err := svc.Get(ctx, p)
+				require.NoError(t, err)
+				require.Equal(t, srcObj, w.Object())
+
+				w = NewSimpleObjectWriter()
+
+				off := uint64(5)
+				ln := uint64(len(payload)) - 2*off
+
+				rngPrm := newRngPrm(false, w, off, ln)
+				rngPrm.WithAddress(addr)
+
+				err = svc.GetRange(ctx, rngPrm)
+				require.NoError(t, err)
+				require.Equal(t, payload[off:off+ln], w.Object().Payload())
+
+				// window is reduced to fit the prefetch size
+				svc.prefetchSize = 1
+
+				w = NewSimpleObjectWriter()
+
+				p = newPrm(false, w)
+				p.WithAddress(addr)
+
+				err = svc.Get(ctx, p)
+				require.NoError(t, err)
+				require.Equal(t, srcObj, w.Object())
+
+				w = NewSimpleObjectWriter()
+
+				rngPrm = newRngPrm(false, w, off, ln)
+				rngPrm.WithAddress(addr)
+
+				err = svc.GetRange(ctx, rngPrm)
+				require.NoError(t, err)
+				require.Equal(t, payload[off:off+ln], w.Object().Payload())
+
+				svc.prefetchSize = 0
+
+				// payloads before the failed child are written in order
+				c2.addResult(childAddrs[2], nil, apistatus.ObjectNotFound{})
+
+				w = NewSimpleObjectWriter()
+
+				p = newPrm(false, w)
+				p.WithAddress(addr)
+
+				err = svc.Get(ctx, p)
+				require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+				require.Equal(t, payload[:20], w.Object().Payload())
+			})
 		})
 
 		t.Run("right child", func(t *testing.T) {
@@ -1237,3 +1357,21 @@ func TestGetFromPastEpoch(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, obj.CutPayload(), w.Object())
 }
+
+func TestPrefetchWindow(t *testing.T) {
+	for _, tc := range []struct {
+		window    int
+		limit     uint64
+		childSize uint64
+		exp       int
+	}{
+		{window: 4, limit: 0, childSize: 10, exp: 4},
+		{window: 4, limit: 100, childSize: 0, exp: 4},
+		{window: 4, limit: 100, childSize: 10, exp: 4},
+		{window: 4, limit: 30, childSize: 10, exp: 3},
+		{window: 4, limit: 5, childSize: 10, exp: 1},
+	} {
+		require.Equal(t, tc.exp, prefetchWindow(tc.window, tc.limit, tc.childSize),
+			"window %d, limit %d, child size %d", tc.window, tc.limit, tc.childSize)
+	}
+}
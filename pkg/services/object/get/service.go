@@ -30,6 +30,14 @@ type getClient interface {
 type cfg struct {
 	assembly bool
 
+	// maximum number of children read concurrently
+	// during the assembly, sequential reading if <= 1
+	prefetchWindow int
+
+	// maximum total payload size of the children read
+	// concurrently during the assembly, no limit if 0
+	prefetchSize uint64
+
 	log *logger.Logger
 
 	localStorage interface {
@@ -90,6 +98,26 @@ func WithoutAssembly() Option {
 	}
 }
 
+// WithAssemblyPrefetchWindow returns option to set the maximum number of
+// children of the split object read in parallel during the assembly.
+// Payloads of the children are still written in order. Children are
+// read one by one if the value is not greater than 1.
+func WithAssemblyPrefetchWindow(n int) Option {
+	return func(c *cfg) {
+		c.prefetchWindow = n
+	}
+}
+
+// WithAssemblyPrefetchSize returns option to set the maximum total payload
+// size of the children of the split object read in parallel during the
+// assembly. Number of the children read in parallel is reduced to fit the
+// size, but at least one child is read. Zero means no limit.
+func WithAssemblyPrefetchSize(sz uint64) Option {
+	return func(c *cfg) {
+		c.prefetchSize = sz
+	}
+}
+
 // WithLocalStorageEngine returns option to set local storage
 // instance.
 func WithLocalStorageEngine(e *engine.StorageEngine) Option {
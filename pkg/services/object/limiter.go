@@ -0,0 +1,396 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+)
+
+type (
+	// Limits groups request rate limits of the object service. Each limit
+	// is a number of requests per second, zero means no limit.
+	Limits struct {
+		// Limits of the requests of particular type from all senders.
+		Get, Put, Head, Search, Delete, Range, RangeHash float64
+
+		// Limit of the requests of any type from a single sender.
+		Sender float64
+
+		// Limit of the requests of any type to a single container.
+		Container float64
+	}
+
+	// LimitService is an object service that rejects requests
+	// exceeding configured rate limits.
+	LimitService struct {
+		next ServiceServer
+
+		// by request types, nil buckets are unlimited
+		methods map[string]*tokenBucket
+
+		// nil if unlimited
+		senders, containers *bucketSet
+
+		// nil if there are no exempted senders
+		system SystemSenders
+
+		metrics LimitRegister
+	}
+
+	// SystemSenders is an interface of the component which recognizes
+	// the system senders, e.g. container and inner ring nodes. Requests
+	// of the system senders are not limited, since they are the internal
+	// traffic of the network like replication and placement checks.
+	SystemSenders interface {
+		// IsSystemSender must return true if the key belongs to the inner
+		// ring node or to the storage node of the container.
+		IsSystemSender(cnr, key []byte) bool
+	}
+
+	// LimitRegister is an interface of the request limiter metrics.
+	LimitRegister interface {
+		SetObjectRequestRateLimit(limit string, rate float64)
+		IncObjectLimitedRequests(method, limit string)
+	}
+
+	putStreamLimiter struct {
+		stream  PutObjectStream
+		limiter *LimitService
+		checked bool
+	}
+)
+
+const (
+	limitMethodGet       = "get"
+	limitMethodPut       = "put"
+	limitMethodHead      = "head"
+	limitMethodSearch    = "search"
+	limitMethodDelete    = "delete"
+	limitMethodRange     = "range"
+	limitMethodRangeHash = "range_hash"
+
+	limitSender    = "sender"
+	limitContainer = "container"
+)
+
+// limitCacheSize is a maximum number of senders and containers
+// which request rates are tracked at the same time.
+const limitCacheSize = 10000
+
+const limitExceededReasonFmt = "server is busy: %s request rate limit is exceeded (%s)"
+
+// busyErr returns the error of the request exceeding the limit. Since it is
+// not an access denial, it is transmitted as internal server error status
+// with the message describing the exceeded limit, and the request can be
+// repeated later.
+func busyErr(method, limit string) error {
+	var errBusy apistatus.ServerInternal
+	errBusy.SetMessage(fmt.Sprintf(limitExceededReasonFmt, method, limit))
+
+	return errBusy
+}
+
+// NewLimitService returns object service instance that passes requests
+// not exceeding the limits to the next service. Requests of the system
+// senders are not limited, nothing is exempted if system is nil. Metrics
+// are not reported if register is nil.
+func NewLimitService(next ServiceServer, limits Limits, system SystemSenders, register LimitRegister) *LimitService {
+	s := &LimitService{
+		next:       next,
+		senders:    newBucketSet(limits.Sender),
+		containers: newBucketSet(limits.Container),
+		system:     system,
+		metrics:    register,
+		methods: map[string]*tokenBucket{
+			limitMethodGet:       newTokenBucket(limits.Get),
+			limitMethodPut:       newTokenBucket(limits.Put),
+			limitMethodHead:      newTokenBucket(limits.Head),
+			limitMethodSearch:    newTokenBucket(limits.Search),
+			limitMethodDelete:    newTokenBucket(limits.Delete),
+			limitMethodRange:     newTokenBucket(limits.Range),
+			limitMethodRangeHash: newTokenBucket(limits.RangeHash),
+		},
+	}
+
+	if register != nil {
+		for method, b := range s.methods {
+			register.SetObjectRequestRateLimit(method, b.limit())
+		}
+
+		register.SetObjectRequestRateLimit(limitSender, limits.Sender)
+		register.SetObjectRequestRateLimit(limitContainer, limits.Container)
+	}
+
+	return s
+}
+
+func (s *LimitService) Get(req *object.GetRequest, stream GetObjectStream) error {
+	err := s.check(limitMethodGet, req.GetVerificationHeader(),
+		req.GetBody().GetAddress().GetContainerID().GetValue())
+	if err != nil {
+		return err
+	}
+
+	return s.next.Get(req, stream)
+}
+
+func (s *LimitService) Put(ctx context.Context) (PutObjectStream, error) {
+	stream, err := s.next.Put(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &putStreamLimiter{
+		stream:  stream,
+		limiter: s,
+	}, nil
+}
+
+func (s *LimitService) Head(ctx context.Context, req *object.HeadRequest) (*object.HeadResponse, error) {
+	err := s.check(limitMethodHead, req.GetVerificationHeader(),
+		req.GetBody().GetAddress().GetContainerID().GetValue())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.next.Head(ctx, req)
+}
+
+func (s *LimitService) Search(req *object.SearchRequest, stream SearchStream) error {
+	err := s.check(limitMethodSearch, req.GetVerificationHeader(),
+		req.GetBody().GetContainerID().GetValue())
+	if err != nil {
+		return err
+	}
+
+	return s.next.Search(req, stream)
+}
+
+func (s *LimitService) Delete(ctx context.Context, req *object.DeleteRequest) (*object.DeleteResponse, error) {
+	err := s.check(limitMethodDelete, req.GetVerificationHeader(),
+		req.GetBody().GetAddress().GetContainerID().GetValue())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.next.Delete(ctx, req)
+}
+
+func (s *LimitService) GetRange(req *object.GetRangeRequest, stream GetObjectRangeStream) error {
+	err := s.check(limitMethodRange, req.GetVerificationHeader(),
+		req.GetBody().GetAddress().GetContainerID().GetValue())
+	if err != nil {
+		return err
+	}
+
+	return s.next.GetRange(req, stream)
+}
+
+func (s *LimitService) GetRangeHash(ctx context.Context, req *object.GetRangeHashRequest) (*object.GetRangeHashResponse, error) {
+	err := s.check(limitMethodRangeHash, req.GetVerificationHeader(),
+		req.GetBody().GetAddress().GetContainerID().GetValue())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.next.GetRangeHash(ctx, req)
+}
+
+// Send checks the limits on the initial part of the object
+// since it carries the container of the object.
+func (s *putStreamLimiter) Send(req *object.PutRequest) error {
+	if !s.checked {
+		s.checked = true
+
+		if init, ok := req.GetBody().GetObjectPart().(*object.PutObjectPartInit); ok {
+			err := s.limiter.check(limitMethodPut, req.GetVerificationHeader(),
+				init.GetHeader().GetContainerID().GetValue())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return s.stream.Send(req)
+}
+
+func (s *putStreamLimiter) CloseAndRecv() (*object.PutResponse, error) {
+	return s.stream.CloseAndRecv()
+}
+
+// check takes a token from each bucket the request falls into. If any of
+// the buckets is empty, tokens are returned and the busy error is returned
+// unless the request is sent by the system sender. System senders are
+// recognized only when the limit is exceeded, since it is expensive.
+func (s *LimitService) check(method string, vh *session.RequestVerificationHeader, cnr []byte) error {
+	now := time.Now()
+	sender := requestSender(vh)
+
+	buckets := [...]struct {
+		limit string
+		b     *tokenBucket
+	}{
+		{method, s.methods[method]},
+		{limitContainer, s.containers.get(cnr, now)},
+		{limitSender, s.senders.get(sender, now)},
+	}
+
+	for i := range buckets {
+		if buckets[i].b == nil || buckets[i].b.take(now) {
+			continue
+		}
+
+		for j := 0; j < i; j++ {
+			if buckets[j].b != nil {
+				buckets[j].b.give()
+			}
+		}
+
+		if s.system != nil && s.system.IsSystemSender(cnr, sender) {
+			return nil
+		}
+
+		if s.metrics != nil {
+			s.metrics.IncObjectLimitedRequests(method, buckets[i].limit)
+		}
+
+		return busyErr(method, buckets[i].limit)
+	}
+
+	return nil
+}
+
+// requestSender returns public key of the original request sender.
+func requestSender(vh *session.RequestVerificationHeader) []byte {
+	for vh.GetOrigin() != nil {
+		vh = vh.GetOrigin()
+	}
+
+	return vh.GetBodySignature().GetKey()
+}
+
+// tokenBucket is a token bucket which is refilled at the rate of the
+// limit per second and holds no more than a second worth of tokens,
+// but at least one token.
+type tokenBucket struct {
+	mtx sync.Mutex
+
+	rate, capacity float64
+
+	tokens float64
+
+	last time.Time
+}
+
+// newTokenBucket returns full token bucket. Returns nil if rate
+// is not positive.
+func newTokenBucket(rate float64) *tokenBucket {
+	if rate <= 0 {
+		return nil
+	}
+
+	capacity := rate
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &tokenBucket{
+		rate:     rate,
+		capacity: capacity,
+		tokens:   capacity,
+		last:     time.Now(),
+	}
+}
+
+// take takes one token from the bucket if there is one.
+func (b *tokenBucket) take(now time.Time) bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.capacity {
+			b.tokens = b.capacity
+		}
+
+		b.last = now
+	}
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+
+	return true
+}
+
+// give returns previously taken token to the bucket.
+func (b *tokenBucket) give() {
+	b.mtx.Lock()
+
+	b.tokens++
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+
+	b.mtx.Unlock()
+}
+
+func (b *tokenBucket) limit() float64 {
+	if b == nil {
+		return 0
+	}
+
+	return b.rate
+}
+
+// bucketSet is a set of token buckets with the same rate by keys.
+// Only recently used buckets are kept.
+type bucketSet struct {
+	rate float64
+
+	buckets *lru.Cache
+}
+
+// newBucketSet returns nil if rate is not positive.
+func newBucketSet(rate float64) *bucketSet {
+	if rate <= 0 {
+		return nil
+	}
+
+	// error is returned only if size is not positive
+	buckets, _ := lru.New(limitCacheSize)
+
+	return &bucketSet{
+		rate:    rate,
+		buckets: buckets,
+	}
+}
+
+// get returns the bucket of the key. Returns nil if the set is nil
+// or the key is empty.
+func (s *bucketSet) get(key []byte, now time.Time) *tokenBucket {
+	if s == nil || len(key) == 0 {
+		return nil
+	}
+
+	if b, ok := s.buckets.Get(string(key)); ok {
+		return b.(*tokenBucket)
+	}
+
+	b := newTokenBucket(s.rate)
+	b.last = now
+
+	if prev, ok, _ := s.buckets.PeekOrAdd(string(key), b); ok {
+		return prev.(*tokenBucket)
+	}
+
+	return b
+}
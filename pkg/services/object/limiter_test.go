@@ -0,0 +1,197 @@
+package object
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-api-go/v2/signature"
+	"github.com/nspcc-dev/neofs-node/pkg/util/test"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/stretchr/testify/require"
+)
+
+type testSystemSenders map[byte]struct{}
+
+func (x testSystemSenders) IsSystemSender(_, key []byte) bool {
+	_, ok := x[key[0]]
+	return ok
+}
+
+type testHeadService struct {
+	ServiceServer
+
+	calls int
+}
+
+func (s *testHeadService) Head(context.Context, *object.HeadRequest) (*object.HeadResponse, error) {
+	s.calls++
+	return new(object.HeadResponse), nil
+}
+
+func testHeadRequest(sender, cnr byte) *object.HeadRequest {
+	cid := new(refs.ContainerID)
+	cid.SetValue([]byte{cnr})
+
+	addr := new(refs.Address)
+	addr.SetContainerID(cid)
+
+	body := new(object.HeadRequestBody)
+	body.SetAddress(addr)
+
+	sig := new(refs.Signature)
+	sig.SetKey([]byte{sender})
+
+	vh := new(session.RequestVerificationHeader)
+	vh.SetBodySignature(sig)
+
+	req := new(object.HeadRequest)
+	req.SetBody(body)
+	req.SetVerificationHeader(vh)
+
+	return req
+}
+
+func TestTokenBucket(t *testing.T) {
+	require.Nil(t, newTokenBucket(0))
+
+	b := newTokenBucket(2)
+	now := b.last
+
+	require.True(t, b.take(now))
+	require.True(t, b.take(now))
+	require.False(t, b.take(now))
+
+	require.True(t, b.take(now.Add(500*time.Millisecond)))
+	require.False(t, b.take(now.Add(500*time.Millisecond)))
+
+	b.give()
+	require.True(t, b.take(now.Add(500*time.Millisecond)))
+
+	// no more than a second worth of tokens is accumulated
+	now = now.Add(time.Hour)
+	require.True(t, b.take(now))
+	require.True(t, b.take(now))
+	require.False(t, b.take(now))
+
+	// at least one token for the low rates
+	b = newTokenBucket(0.5)
+	require.True(t, b.take(b.last))
+	require.False(t, b.take(b.last.Add(time.Second)))
+	require.True(t, b.take(b.last.Add(time.Second)))
+}
+
+func TestLimitService(t *testing.T) {
+	checkLimited := func(t *testing.T, err error) {
+		require.True(t, errors.As(err, new(apistatus.ServerInternal)), err)
+		require.Contains(t, err.Error(), "server is busy")
+	}
+
+	t.Run("no limits", func(t *testing.T) {
+		next := new(testHeadService)
+		s := NewLimitService(next, Limits{}, nil, nil)
+
+		for i := 0; i < 100; i++ {
+			_, err := s.Head(context.Background(), testHeadRequest(1, 1))
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, 100, next.calls)
+	})
+
+	t.Run("request type", func(t *testing.T) {
+		next := new(testHeadService)
+		s := NewLimitService(next, Limits{Head: 2, Get: 1}, nil, nil)
+
+		for i := byte(0); i < 2; i++ {
+			_, err := s.Head(context.Background(), testHeadRequest(i, i))
+			require.NoError(t, err)
+		}
+
+		_, err := s.Head(context.Background(), testHeadRequest(3, 3))
+		checkLimited(t, err)
+		require.Equal(t, 2, next.calls)
+	})
+
+	t.Run("sender", func(t *testing.T) {
+		next := new(testHeadService)
+		s := NewLimitService(next, Limits{Sender: 1}, nil, nil)
+
+		_, err := s.Head(context.Background(), testHeadRequest(1, 1))
+		require.NoError(t, err)
+
+		_, err = s.Head(context.Background(), testHeadRequest(1, 2))
+		checkLimited(t, err)
+
+		_, err = s.Head(context.Background(), testHeadRequest(2, 1))
+		require.NoError(t, err)
+	})
+
+	t.Run("container", func(t *testing.T) {
+		next := new(testHeadService)
+		s := NewLimitService(next, Limits{Container: 1, Head: 2}, nil, nil)
+
+		_, err := s.Head(context.Background(), testHeadRequest(1, 1))
+		require.NoError(t, err)
+
+		_, err = s.Head(context.Background(), testHeadRequest(2, 1))
+		checkLimited(t, err)
+
+		// token of the request type limit is returned
+		_, err = s.Head(context.Background(), testHeadRequest(2, 2))
+		require.NoError(t, err)
+	})
+	t.Run("system sender", func(t *testing.T) {
+		next := new(testHeadService)
+		s := NewLimitService(next, Limits{Head: 1}, testSystemSenders{2: {}}, nil)
+
+		_, err := s.Head(context.Background(), testHeadRequest(1, 1))
+		require.NoError(t, err)
+
+		_, err = s.Head(context.Background(), testHeadRequest(1, 1))
+		checkLimited(t, err)
+
+		for i := 0; i < 10; i++ {
+			_, err = s.Head(context.Background(), testHeadRequest(2, 1))
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, 11, next.calls)
+	})
+	t.Run("response status", func(t *testing.T) {
+		key := test.DecodeKey(-1)
+		s := NewSignService(key, NewLimitService(new(testHeadService), Limits{Head: 1}, nil, nil))
+
+		head := func() *object.HeadResponse {
+			ver := new(refs.Version)
+			ver.SetMajor(2)
+			ver.SetMinor(11)
+
+			meta := new(session.RequestMetaHeader)
+			meta.SetVersion(ver)
+
+			req := testHeadRequest(0, 1)
+			req.SetVerificationHeader(nil)
+			req.SetMetaHeader(meta)
+			require.NoError(t, signature.SignServiceMessage(key, req))
+
+			resp, err := s.Head(context.Background(), req)
+			require.NoError(t, err)
+
+			return resp
+		}
+
+		require.NoError(t, apistatus.ErrFromStatus(apistatus.FromStatusV2(head().GetMetaHeader().GetStatus())))
+
+		// client receives internal server error with the busy message
+		err := apistatus.ErrFromStatus(apistatus.FromStatusV2(head().GetMetaHeader().GetStatus()))
+
+		internal := new(apistatus.ServerInternal)
+		require.True(t, errors.As(err, &internal), err)
+		require.Contains(t, internal.Message(), "server is busy")
+	})
+}
@@ -13,6 +13,11 @@ func (exec *execCtx) executeOnContainer() {
 		return
 	}
 
+	if exec.limitReached() {
+		exec.log.Debug("search limit reached, skip container nodes")
+		return
+	}
+
 	lookupDepth := exec.netmapLookupDepth()
 
 	exec.log.Debug("trying to execute in container...",
@@ -82,6 +87,11 @@ func (exec *execCtx) processCurrentEpoch() bool {
 			client.NodeInfoFromNetmapElement(&info, addrs[i])
 
 			exec.processNode(ctx, info)
+
+			if exec.limitReached() {
+				exec.log.Debug("search limit reached, abort placement iteration")
+				return true
+			}
 		}
 	}
 
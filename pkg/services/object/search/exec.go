@@ -2,6 +2,7 @@ package searchsvc
 
 import (
 	"context"
+	"sort"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
@@ -29,6 +30,15 @@ type execCtx struct {
 	log *logger.Logger
 
 	curProcEpoch uint64
+
+	// first identifiers after the cursor collected
+	// from all nodes in paged mode
+	page []pageItem
+}
+
+type pageItem struct {
+	key string
+	id  oidSDK.ID
 }
 
 const (
@@ -36,9 +46,13 @@ const (
 	statusOK
 )
 
+// maxPageSize is the maximum number of the object identifiers
+// written in paged mode if the limit is not set.
+const maxPageSize = 1000
+
 func (exec *execCtx) prepare() {
 	if _, ok := exec.prm.writer.(*uniqueIDWriter); !ok {
-		exec.prm.writer = newUniqueAddressWriter(exec.prm.writer)
+		exec.prm.writer = newUniqueAddressWriter(exec.prm.writer, exec.limit())
 	}
 }
 
@@ -64,6 +78,16 @@ func (exec *execCtx) containerID() *cid.ID {
 	return exec.prm.cid
 }
 
+// limit returns the maximum number of the written identifiers,
+// zero means no limit.
+func (exec *execCtx) limit() uint32 {
+	if exec.prm.paged && exec.prm.limit == 0 {
+		return maxPageSize
+	}
+
+	return exec.prm.limit
+}
+
 func (exec *execCtx) searchFilters() object.SearchFilters {
 	return exec.prm.filters
 }
@@ -133,7 +157,73 @@ func (exec execCtx) remoteClient(info client.NodeInfo) (searchClient, bool) {
 	return nil, false
 }
 
+// limitReached checks if the limit of the written identifiers has been
+// reached, so the other nodes should not be requested.
+func (exec *execCtx) limitReached() bool {
+	w, ok := exec.prm.writer.(*uniqueIDWriter)
+
+	return !exec.prm.paged && ok && w.full()
+}
+
 func (exec *execCtx) writeIDList(ids []oidSDK.ID) {
+	if exec.prm.paged {
+		// page can be written only when results of all nodes are known
+		exec.addToPage(ids)
+
+		exec.status = statusOK
+		exec.err = nil
+
+		return
+	}
+
+	exec.writeIDs(ids)
+}
+
+// addToPage merges the identifiers following the cursor into the page
+// keeping only the first ones in the order of identifiers. Each node
+// returns the first identifiers after the cursor, so the first ones of
+// the union are the requested page.
+func (exec *execCtx) addToPage(ids []oidSDK.ID) {
+	var after string
+	if exec.prm.cursor != nil {
+		after = exec.prm.cursor.String()
+	}
+
+	added := make(map[string]struct{}, len(exec.page))
+	for i := range exec.page {
+		added[exec.page[i].key] = struct{}{}
+	}
+
+	for i := range ids {
+		key := ids[i].String()
+
+		// nodes which do not support pagination return all objects
+		if _, ok := added[key]; !ok && key > after {
+			added[key] = struct{}{}
+			exec.page = append(exec.page, pageItem{key: key, id: ids[i]})
+		}
+	}
+
+	sort.Slice(exec.page, func(i, j int) bool {
+		return exec.page[i].key < exec.page[j].key
+	})
+
+	if limit := int(exec.limit()); len(exec.page) > limit {
+		exec.page = exec.page[:limit]
+	}
+}
+
+// writePage writes the identifiers collected by addToPage.
+func (exec *execCtx) writePage() {
+	ids := make([]oidSDK.ID, len(exec.page))
+	for i := range exec.page {
+		ids[i] = exec.page[i].id
+	}
+
+	exec.writeIDs(ids)
+}
+
+func (exec *execCtx) writeIDs(ids []oidSDK.ID) {
 	err := exec.prm.writer.WriteIDs(ids)
 
 	switch {
@@ -1,12 +1,50 @@
 package searchsvc
 
 import (
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
 )
 
+// localBatchSize is the maximum number of the object identifiers
+// selected from the local storage at once.
+const localBatchSize = 1000
+
+// executeLocal selects the object identifiers from the local storage in
+// batches of at most localBatchSize identifiers, so the memory usage does
+// not depend on the number of objects in the container even if all objects
+// are requested.
 func (exec *execCtx) executeLocal() {
-	ids, err := exec.svc.localStorage.search(exec)
+	var (
+		limit   = exec.limit()
+		cursor  = exec.prm.cursor
+		written uint32
+	)
+
+	for limit == 0 || written < limit {
+		count := uint32(localBatchSize)
+		if limit > 0 && limit-written < count {
+			count = limit - written
+		}
+
+		ids, next, ok := exec.searchLocal(cursor, count)
+		if !ok {
+			return
+		}
+
+		exec.writeIDList(ids)
+
+		written += uint32(len(ids))
+
+		if next == nil || exec.status != statusOK {
+			return
+		}
+
+		cursor = next
+	}
+}
 
+func (exec *execCtx) searchLocal(cursor *oidSDK.ID, count uint32) ([]oidSDK.ID, *oidSDK.ID, bool) {
+	ids, next, err := exec.svc.localStorage.search(exec, cursor, count)
 	if err != nil {
 		exec.status = statusUndefined
 		exec.err = err
@@ -15,8 +53,8 @@ func (exec *execCtx) executeLocal() {
 			zap.String("error", err.Error()),
 		)
 
-		return
+		return nil, nil, false
 	}
 
-	exec.writeIDList(ids)
+	return ids, next, true
 }
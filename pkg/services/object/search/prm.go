@@ -18,6 +18,11 @@ type Prm struct {
 
 	filters object.SearchFilters
 
+	limit uint32
+
+	paged  bool
+	cursor *oidSDK.ID
+
 	forwarder RequestForwarder
 }
 
@@ -56,3 +61,17 @@ func (p *Prm) WithContainerID(id *cid.ID) {
 func (p *Prm) WithSearchFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
+
+// WithLimit sets the maximum number of the object identifiers to write.
+// Zero value means no limit.
+func (p *Prm) WithLimit(limit uint32) {
+	p.limit = limit
+}
+
+// WithCursor requests the page of object identifiers following the cursor
+// one in the order of identifiers. Nil cursor requests the first page.
+// If the limit is not set, the page contains at most 1000 identifiers.
+func (p *Prm) WithCursor(cursor *oidSDK.ID) {
+	p.paged = true
+	p.cursor = cursor
+}
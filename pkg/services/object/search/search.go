@@ -30,6 +30,10 @@ func (exec *execCtx) execute() {
 	exec.executeLocal()
 
 	exec.analyzeStatus(true)
+
+	if exec.prm.paged && exec.status == statusOK {
+		exec.writePage()
+	}
 }
 
 func (exec *execCtx) analyzeStatus(execCnr bool) {
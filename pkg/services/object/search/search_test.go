@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"testing"
 
@@ -30,6 +31,9 @@ type idsErr struct {
 
 type testStorage struct {
 	items map[string]idsErr
+
+	// counts of the identifiers requested from the local storage
+	counts []uint32
 }
 
 type testTraverserGenerator struct {
@@ -95,13 +99,32 @@ func (c *testClientCache) get(info clientcore.NodeInfo) (searchClient, error) {
 	return v, nil
 }
 
-func (s *testStorage) search(exec *execCtx) ([]oidSDK.ID, error) {
+func (s *testStorage) search(exec *execCtx, cursor *oidSDK.ID, count uint32) ([]oidSDK.ID, *oidSDK.ID, error) {
+	s.counts = append(s.counts, count)
+
 	v, ok := s.items[exec.containerID().String()]
 	if !ok {
-		return nil, nil
+		return nil, nil, nil
 	}
 
-	return v.ids, v.err
+	ids := v.ids
+
+	if cursor != nil {
+		for i := range ids {
+			if ids[i].Equal(cursor) {
+				ids = ids[i+1:]
+				break
+			}
+		}
+	}
+
+	if count == 0 || len(ids) <= int(count) {
+		return ids, nil, v.err
+	}
+
+	ids = ids[:count]
+
+	return ids, &ids[count-1], v.err
 }
 
 func (c *testStorage) searchObjects(exec *execCtx, _ clientcore.NodeInfo) ([]oidSDK.ID, error) {
@@ -185,6 +208,84 @@ func TestGetLocalOnly(t *testing.T) {
 		err := svc.Search(ctx, p)
 		require.True(t, errors.Is(err, testErr))
 	})
+
+	t.Run("several batches", func(t *testing.T) {
+		storage := newTestStorage()
+		svc := newSvc(storage)
+
+		cid := cidtest.ID()
+		ids := generateIDs(2*localBatchSize + 10)
+		storage.addResult(cid, ids, nil)
+
+		w := new(simpleIDWriter)
+		p := newPrm(cid, w)
+
+		err := svc.Search(ctx, p)
+		require.NoError(t, err)
+		require.Equal(t, ids, w.ids)
+
+		// all objects are requested, but they are selected in batches
+		require.Equal(t, []uint32{localBatchSize, localBatchSize, localBatchSize}, storage.counts)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		storage := newTestStorage()
+		svc := newSvc(storage)
+
+		cid := cidtest.ID()
+		ids := generateIDs(10)
+		storage.addResult(cid, ids, nil)
+
+		w := new(simpleIDWriter)
+		p := newPrm(cid, w)
+		p.WithLimit(4)
+
+		err := svc.Search(ctx, p)
+		require.NoError(t, err)
+		require.Equal(t, ids[:4], w.ids)
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		storage := newTestStorage()
+		svc := newSvc(storage)
+
+		cid := cidtest.ID()
+		ids := generateIDs(10)
+		sortIDs(ids)
+		storage.addResult(cid, ids, nil)
+
+		var (
+			cursor *oidSDK.ID
+			res    []oidSDK.ID
+		)
+
+		for {
+			w := new(simpleIDWriter)
+			p := newPrm(cid, w)
+			p.WithLimit(3)
+			p.WithCursor(cursor)
+
+			err := svc.Search(ctx, p)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(w.ids), 3)
+
+			res = append(res, w.ids...)
+
+			if len(w.ids) < 3 {
+				break
+			}
+
+			cursor = &w.ids[len(w.ids)-1]
+		}
+
+		require.Equal(t, ids, res)
+	})
+}
+
+func sortIDs(ids []oidSDK.ID) {
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].String() < ids[j].String()
+	})
 }
 
 func testNodeMatrix(t testing.TB, dim []int) ([]netmap.Nodes, [][]string) {
@@ -304,6 +405,83 @@ func TestGetRemoteSmall(t *testing.T) {
 			require.Contains(t, w.ids, id)
 		}
 	})
+
+	t.Run("limit", func(t *testing.T) {
+		addr := addressSDK.NewAddress()
+		addr.SetContainerID(id)
+
+		ns, as := testNodeMatrix(t, placementDim)
+
+		builder := &testPlacementBuilder{
+			vectors: map[string][]netmap.Nodes{
+				addr.String(): ns,
+			},
+		}
+
+		c1 := newTestStorage()
+		ids1 := generateIDs(10)
+		c1.addResult(id, ids1, nil)
+
+		c2 := newTestStorage()
+		c2.addResult(id, nil, errors.New("second node must not be requested"))
+
+		svc := newSvc(builder, &testClientCache{
+			clients: map[string]*testStorage{
+				as[0][0]: c1,
+				as[0][1]: c2,
+			},
+		})
+
+		w := new(simpleIDWriter)
+
+		p := newPrm(id, w)
+		p.WithLimit(5)
+
+		err := svc.Search(ctx, p)
+		require.NoError(t, err)
+		require.Equal(t, ids1[:5], w.ids)
+	})
+
+	t.Run("page", func(t *testing.T) {
+		addr := addressSDK.NewAddress()
+		addr.SetContainerID(id)
+
+		ns, as := testNodeMatrix(t, placementDim)
+
+		builder := &testPlacementBuilder{
+			vectors: map[string][]netmap.Nodes{
+				addr.String(): ns,
+			},
+		}
+
+		c1 := newTestStorage()
+		ids1 := generateIDs(10)
+		c1.addResult(id, ids1, nil)
+
+		c2 := newTestStorage()
+		ids2 := generateIDs(10)
+		c2.addResult(id, append(ids2, ids1[:5]...), nil)
+
+		svc := newSvc(builder, &testClientCache{
+			clients: map[string]*testStorage{
+				as[0][0]: c1,
+				as[0][1]: c2,
+			},
+		})
+
+		all := append(append([]oidSDK.ID{}, ids1...), ids2...)
+		sortIDs(all)
+
+		w := new(simpleIDWriter)
+
+		p := newPrm(id, w)
+		p.WithLimit(5)
+		p.WithCursor(&all[2])
+
+		err := svc.Search(ctx, p)
+		require.NoError(t, err)
+		require.Equal(t, all[3:8], w.ids)
+	})
 }
 
 func TestGetFromPastEpoch(t *testing.T) {
@@ -412,3 +590,23 @@ func TestGetFromPastEpoch(t *testing.T) {
 	require.NoError(t, err)
 	assertContains(ids11, ids12, ids21, ids22)
 }
+
+func TestUniqueIDWriter(t *testing.T) {
+	w := new(simpleIDWriter)
+	uw := newUniqueAddressWriter(w, 0)
+
+	ids := generateIDs(maxUniqueIDs)
+	require.NoError(t, uw.WriteIDs(append([]oidSDK.ID(nil), ids...)))
+	require.Len(t, w.ids, maxUniqueIDs)
+
+	// duplicates of the remembered identifiers are filtered out
+	require.NoError(t, uw.WriteIDs([]oidSDK.ID{ids[0], ids[maxUniqueIDs-1]}))
+	require.Len(t, w.ids, maxUniqueIDs)
+
+	// identifiers written after the limit is reached are not remembered,
+	// so their duplicates are written again
+	extra := generateIDs(1)
+	require.NoError(t, uw.WriteIDs(append([]oidSDK.ID(nil), extra...)))
+	require.NoError(t, uw.WriteIDs(append([]oidSDK.ID(nil), extra...)))
+	require.Equal(t, []oidSDK.ID{extra[0], extra[0]}, w.ids[maxUniqueIDs:])
+}
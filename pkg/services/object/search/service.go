@@ -36,7 +36,9 @@ type cfg struct {
 	log *logger.Logger
 
 	localStorage interface {
-		search(*execCtx) ([]oidSDK.ID, error)
+		// search returns at most count identifiers following the cursor
+		// and the cursor of the next page if there are more objects.
+		search(exec *execCtx, cursor *oidSDK.ID, count uint32) ([]oidSDK.ID, *oidSDK.ID, error)
 	}
 
 	clientConstructor interface {
@@ -14,11 +14,22 @@ import (
 	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
+// maxUniqueIDs is the maximum number of the identifiers remembered by
+// uniqueIDWriter to filter out the duplicates. Identifiers written after
+// the limit is reached may be written again if several nodes return them.
+const maxUniqueIDs = 1 << 16
+
 type uniqueIDWriter struct {
 	mtx sync.Mutex
 
 	written map[string]struct{}
 
+	// number of the written identifiers
+	count uint32
+
+	// maximum number of the written identifiers, zero means no limit
+	limit uint32
+
 	writer IDListWriter
 }
 
@@ -38,24 +49,43 @@ type nmSrcWrapper struct {
 	nmSrc netmap.Source
 }
 
-func newUniqueAddressWriter(w IDListWriter) IDListWriter {
+func newUniqueAddressWriter(w IDListWriter, limit uint32) IDListWriter {
 	return &uniqueIDWriter{
 		written: make(map[string]struct{}),
+		limit:   limit,
 		writer:  w,
 	}
 }
 
+// full checks if the limit of the written identifiers has been reached.
+func (w *uniqueIDWriter) full() bool {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	return w.limit > 0 && w.count >= w.limit
+}
+
 func (w *uniqueIDWriter) WriteIDs(list []oidSDK.ID) error {
 	w.mtx.Lock()
 
 	for i := 0; i < len(list); i++ { // don't use range, slice mutates in body
+		if w.limit > 0 && w.count >= w.limit {
+			list = list[:i]
+			break
+		}
+
 		s := list[i].String()
 		// standard stringer is quite costly, it is better
 		// to facilitate the calculation of the key
 
 		if _, ok := w.written[s]; !ok {
 			// mark address as processed
-			w.written[s] = struct{}{}
+			if len(w.written) < maxUniqueIDs {
+				w.written[s] = struct{}{}
+			}
+
+			w.count++
+
 			continue
 		}
 
@@ -111,16 +141,18 @@ func (c *clientWrapper) searchObjects(exec *execCtx, info client.NodeInfo) ([]oi
 	return res.IDList(), nil
 }
 
-func (e *storageEngineWrapper) search(exec *execCtx) ([]oidSDK.ID, error) {
+func (e *storageEngineWrapper) search(exec *execCtx, cursor *oidSDK.ID, count uint32) ([]oidSDK.ID, *oidSDK.ID, error) {
 	r, err := (*engine.StorageEngine)(e).Select(new(engine.SelectPrm).
 		WithFilters(exec.searchFilters()).
-		WithContainerID(exec.containerID()),
+		WithContainerID(exec.containerID()).
+		WithCount(count).
+		WithCursor(cursor),
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return idsFromAddresses(r.AddressList()), nil
+	return idsFromAddresses(r.AddressList()), r.Cursor(), nil
 }
 
 func idsFromAddresses(addrs []*addressSDK.Address) []oidSDK.ID {
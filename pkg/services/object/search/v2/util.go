@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 	"sync"
 
 	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
@@ -12,13 +13,13 @@ import (
 	"github.com/nspcc-dev/neofs-api-go/v2/session"
 	"github.com/nspcc-dev/neofs-api-go/v2/signature"
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/network"
 	objectSvc "github.com/nspcc-dev/neofs-node/pkg/services/object"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/internal"
 	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
-	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
@@ -55,6 +56,8 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 				metaHdr.SetTTL(meta.GetTTL() - 1)
 				// TODO: #1165 think how to set the other fields
 				metaHdr.SetOrigin(meta)
+				// pagination parameters must be processed by the remote node
+				metaHdr.SetXHeaders(searchXHeaders(meta))
 
 				req.SetMetaHeader(metaHdr)
 
@@ -114,11 +117,53 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 
 	body := req.GetBody()
 	p.WithContainerID(cid.NewFromV2(body.GetContainerID()))
-	p.WithSearchFilters(object.NewSearchFiltersFromV2(body.GetFilters()))
+	p.WithSearchFilters(objectcore.SearchFiltersFromV2(body.GetFilters()))
+
+	xHdrs := meta.GetXHeaders()
+
+	for i := range xHdrs {
+		switch xHdrs[i].GetKey() {
+		case objectcore.XHeaderSearchLimit:
+			limit, err := strconv.ParseUint(xHdrs[i].GetValue(), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid search limit: %w", err)
+			}
+
+			p.WithLimit(uint32(limit))
+		case objectcore.XHeaderSearchCursor:
+			var cursor *oidSDK.ID
+
+			if v := xHdrs[i].GetValue(); v != "" {
+				cursor = oidSDK.NewID()
+
+				if err := cursor.Parse(v); err != nil {
+					return nil, fmt.Errorf("invalid search cursor: %w", err)
+				}
+			}
+
+			p.WithCursor(cursor)
+		}
+	}
 
 	return p, nil
 }
 
+// searchXHeaders returns search pagination X-headers of the request.
+func searchXHeaders(meta *session.RequestMetaHeader) []session.XHeader {
+	var res []session.XHeader
+
+	xHdrs := meta.GetXHeaders()
+
+	for i := range xHdrs {
+		switch xHdrs[i].GetKey() {
+		case objectcore.XHeaderSearchLimit, objectcore.XHeaderSearchCursor:
+			res = append(res, xHdrs[i])
+		}
+	}
+
+	return res
+}
+
 func groupAddressRequestForwarder(f func(network.Address, client.MultiAddressClient, []byte) ([]oidSDK.ID, error)) searchsvc.RequestForwarder {
 	return func(info client.NodeInfo, c client.MultiAddressClient) ([]oidSDK.ID, error) {
 		var (
@@ -34,6 +34,13 @@ func (p *CommonPrm) TTL() uint32 {
 	return 0
 }
 
+// SetTTL sets TTL for new requests.
+func (p *CommonPrm) SetTTL(v uint32) {
+	if p != nil {
+		p.ttl = v
+	}
+}
+
 // Returns X-Headers for new requests.
 func (p *CommonPrm) XHeaders() []*sessionsdk.XHeader {
 	if p != nil {
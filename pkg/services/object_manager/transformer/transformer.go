@@ -1,7 +1,9 @@
 package transformer
 
 import (
+	"bytes"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"hash"
 	"io"
@@ -30,6 +32,11 @@ type payloadSizeLimiter struct {
 	splitID *object.SplitID
 
 	parAttrs []object.Attribute
+
+	// children stored before, see SplitState
+	stored []oidSDK.ID
+
+	storedChecksums [][]byte
 }
 
 type payloadChecksumHasher struct {
@@ -50,6 +57,37 @@ func NewPayloadSizeLimiter(maxSize uint64, targetInit TargetInitializer) ObjectT
 	}
 }
 
+// SplitState describes the children of the split object which have
+// already been stored, e.g. by the interrupted writing of the object.
+type SplitState struct {
+	// Split ID of the object, must not be nil.
+	SplitID *object.SplitID
+
+	// Identifiers of the stored children in the order of the payload.
+	// Each child must carry exactly max size bytes of the payload.
+	Children []oidSDK.ID
+
+	// SHA-256 checksums of the payload of the stored children, optional.
+	// If set, the written payload of each stored child must match it.
+	Checksums [][]byte
+}
+
+// NewResumedPayloadSizeLimiter is NewPayloadSizeLimiter which continues the
+// writing of the split object from the state.
+//
+// The whole payload of the object must be written. The payload of the stored
+// children is not passed to the targets, it is only used to calculate the
+// checksums of the parent object and to verify the stored children.
+func NewResumedPayloadSizeLimiter(maxSize uint64, state SplitState, targetInit TargetInitializer) ObjectTarget {
+	return &payloadSizeLimiter{
+		maxSize:         maxSize,
+		targetInit:      targetInit,
+		splitID:         state.SplitID,
+		stored:          state.Children,
+		storedChecksums: state.Checksums,
+	}
+}
+
 func (s *payloadSizeLimiter) WriteHeader(hdr *object.Object) error {
 	s.current = fromObject(hdr)
 
@@ -67,6 +105,10 @@ func (s *payloadSizeLimiter) Write(p []byte) (int, error) {
 }
 
 func (s *payloadSizeLimiter) Close() (*AccessIdentifiers, error) {
+	if len(s.previous) < len(s.stored) {
+		return nil, errors.New("payload is shorter than the stored children")
+	}
+
 	return s.release(true)
 }
 
@@ -103,7 +145,19 @@ func fromObject(obj *object.Object) *object.Object {
 
 func (s *payloadSizeLimiter) initializeCurrent() {
 	// initialize current object target
-	s.target = s.targetInit()
+	if ln := len(s.previous); ln < len(s.stored) {
+		stored := &storedTarget{
+			id: &s.stored[ln],
+		}
+
+		if ln < len(s.storedChecksums) {
+			stored.checksum = s.storedChecksums[ln]
+		}
+
+		s.target = stored
+	} else {
+		s.target = s.targetInit()
+	}
 
 	// create payload hashers
 	s.currentHashers = payloadHashersForObject(s.current)
@@ -279,3 +333,28 @@ func (s *payloadSizeLimiter) detachParent() {
 	// return source attributes
 	s.parent.SetAttributes(s.parAttrs...)
 }
+
+// storedTarget is an ObjectTarget of the already stored object. It discards
+// the object and returns the identifier of the stored one.
+type storedTarget struct {
+	id *oidSDK.ID
+
+	// expected payload checksum, not checked if nil
+	checksum []byte
+}
+
+func (t *storedTarget) WriteHeader(hdr *object.Object) error {
+	if t.checksum != nil && !bytes.Equal(t.checksum, hdr.PayloadChecksum().Sum()) {
+		return fmt.Errorf("payload of the stored child %s differs from the written one", t.id)
+	}
+
+	return nil
+}
+
+func (t *storedTarget) Write(p []byte) (int, error) {
+	return len(p), nil
+}
+
+func (t *storedTarget) Close() (*AccessIdentifiers, error) {
+	return new(AccessIdentifiers).WithSelfID(t.id), nil
+}
@@ -0,0 +1,126 @@
+package transformer
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/test"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	ownertest "github.com/nspcc-dev/neofs-sdk-go/owner/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testNetworkState uint64
+
+func (s testNetworkState) CurrentEpoch() uint64 {
+	return uint64(s)
+}
+
+type testTarget struct {
+	objs *[]*object.Object
+
+	obj *object.Object
+
+	payload []byte
+}
+
+func (t *testTarget) WriteHeader(obj *object.Object) error {
+	t.obj = obj
+	return nil
+}
+
+func (t *testTarget) Write(p []byte) (int, error) {
+	t.payload = append(t.payload, p...)
+	return len(p), nil
+}
+
+func (t *testTarget) Close() (*AccessIdentifiers, error) {
+	t.obj.SetPayload(t.payload)
+	*t.objs = append(*t.objs, t.obj)
+
+	return new(AccessIdentifiers).WithSelfID(t.obj.ID()), nil
+}
+
+func TestResumedPayloadSizeLimiter(t *testing.T) {
+	const maxSize = 10
+
+	key := test.DecodeKey(-1)
+
+	payload := make([]byte, 4*maxSize-5)
+	_, _ = rand.Read(payload)
+
+	hdr := object.New()
+	hdr.SetContainerID(cidtest.ID())
+	hdr.SetOwnerID(ownertest.ID())
+
+	splitID := object.NewSplitID()
+
+	write := func(t *testing.T, state SplitState, payload []byte) ([]*object.Object, error) {
+		var objs []*object.Object
+
+		target := NewResumedPayloadSizeLimiter(maxSize, state, func() ObjectTarget {
+			return NewFormatTarget(&FormatterParams{
+				Key:          key,
+				NextTarget:   &testTarget{objs: &objs},
+				NetworkState: testNetworkState(1),
+			})
+		})
+
+		require.NoError(t, target.WriteHeader(hdr))
+
+		if _, err := target.Write(payload); err != nil {
+			return objs, err
+		}
+
+		_, err := target.Close()
+
+		return objs, err
+	}
+
+	full, err := write(t, SplitState{SplitID: splitID}, payload)
+	require.NoError(t, err)
+	require.Len(t, full, 5) // 4 children and the linking object
+
+	stored := []oidSDK.ID{*full[0].ID(), *full[1].ID()}
+
+	t.Run("resume", func(t *testing.T) {
+		objs, err := write(t, SplitState{SplitID: splitID, Children: stored}, payload)
+		require.NoError(t, err)
+		require.Len(t, objs, 3)
+
+		require.Equal(t, full[1].ID(), objs[0].PreviousID())
+		require.Equal(t, full[2].Payload(), objs[0].Payload())
+		require.Equal(t, full[3].Payload(), objs[1].Payload())
+
+		// parent does not depend on the stored children
+		require.Equal(t, full[3].Parent().ID(), objs[1].Parent().ID())
+		require.Equal(t, full[3].Parent().PayloadChecksum(), objs[1].Parent().PayloadChecksum())
+		require.Equal(t, full[3].Parent().PayloadHomomorphicHash(), objs[1].Parent().PayloadHomomorphicHash())
+
+		link := objs[2]
+		require.Equal(t, splitID, link.SplitID())
+		require.Equal(t, []oidSDK.ID{stored[0], stored[1], *objs[0].ID(), *objs[1].ID()}, link.Children())
+	})
+
+	t.Run("checksums", func(t *testing.T) {
+		checksums := [][]byte{full[0].PayloadChecksum().Sum(), full[1].PayloadChecksum().Sum()}
+
+		objs, err := write(t, SplitState{SplitID: splitID, Children: stored, Checksums: checksums}, payload)
+		require.NoError(t, err)
+		require.Len(t, objs, 3)
+
+		changed := make([]byte, len(payload))
+		copy(changed, payload)
+		changed[maxSize]++
+
+		_, err = write(t, SplitState{SplitID: splitID, Children: stored, Checksums: checksums}, changed)
+		require.Error(t, err)
+	})
+
+	t.Run("short payload", func(t *testing.T) {
+		_, err := write(t, SplitState{SplitID: splitID, Children: stored}, payload[:2*maxSize])
+		require.Error(t, err)
+	})
+}
@@ -35,6 +35,17 @@ func (p *Policer) processObject(ctx context.Context, addr *addressSDK.Address) {
 		return
 	}
 
+	// the local copy must be readable to be replicated, unreadable
+	// objects are reported to be repaired by the storage engine
+	if _, err := engine.HeadRaw(p.jobQueue.localStorage, addr, true); err != nil {
+		p.log.Debug("could not read header of the local object",
+			zap.Stringer("address", addr),
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
 	policy := cnr.PlacementPolicy()
 
 	nn, err := p.placementBuilder.BuildPlacement(addr, policy)
@@ -46,8 +57,19 @@ func (p *Policer) processObject(ctx context.Context, addr *addressSDK.Address) {
 		return
 	}
 
+	if p.metrics != nil {
+		p.metrics.IncPolicerCheckedObjects()
+	}
+
 	replicas := policy.Replicas()
 
+	var (
+		tasks        []*replicator.Task
+		remoteCopies uint32
+
+		localRequired, localRedundant bool
+	)
+
 	for i := range nn {
 		select {
 		case <-ctx.Done():
@@ -55,17 +77,69 @@ func (p *Policer) processObject(ctx context.Context, addr *addressSDK.Address) {
 		default:
 		}
 
-		p.processNodes(ctx, addr, nn[i], replicas[i].Count())
+		res := p.processNodes(ctx, addr, nn[i], replicas[i].Count())
+		if res.task != nil {
+			tasks = append(tasks, res.task.WithPlacementVector(uint32(i)))
+		}
+
+		remoteCopies += res.remoteCopies
+		localRequired = localRequired || res.localRequired
+		localRedundant = localRedundant || res.localRedundant
+	}
+
+	if ctx.Err() != nil {
+		// decisions can not be made on the incomplete check
+		return
+	}
+
+	if len(tasks) > 0 && p.metrics != nil {
+		p.metrics.IncPolicerShortages()
+	}
+
+	for i := range tasks {
+		if remoteCopies == 0 {
+			// the only copy of the object is stored locally
+			tasks[i].WithPriority(replicator.PriorityHigh)
+		}
+
+		p.replicator.AddTask(tasks[i])
+	}
+
+	// local copy is redundant only if no placement vector
+	// requires it and there is no shortage of copies
+	if localRedundant && !localRequired && len(tasks) == 0 {
+		p.handleRedundantCopy(addr)
+	} else {
+		p.unmarkRedundantCopy(addr)
 	}
 }
 
-func (p *Policer) processNodes(ctx context.Context, addr *addressSDK.Address, nodes netmap.Nodes, shortage uint32) {
+// nodesCheck is a result of the object placement check
+// within one placement vector.
+type nodesCheck struct {
+	// task to replicate the object, nil if there is no shortage of copies
+	task *replicator.Task
+
+	// number of the found remote copies
+	remoteCopies uint32
+
+	// local copy is one of the required copies
+	localRequired bool
+
+	// local node is in the placement vector, but
+	// required number of copies is stored remotely
+	localRedundant bool
+}
+
+// processNodes checks that the object is stored on the required number of
+// the nodes. Returns the task to replicate the object if there is a
+// shortage of copies.
+func (p *Policer) processNodes(ctx context.Context, addr *addressSDK.Address, nodes netmap.Nodes, shortage uint32) (res nodesCheck) {
 	log := p.log.With(
 		zap.Stringer("object", addr),
 	)
 
 	prm := new(headsvc.RemoteHeadPrm).WithObjectAddress(addr)
-	redundantLocalCopy := false
 
 	for i := 0; i < len(nodes); i++ {
 		select {
@@ -76,13 +150,11 @@ func (p *Policer) processNodes(ctx context.Context, addr *addressSDK.Address, no
 
 		if p.netmapKeys.IsLocalKey(nodes[i].PublicKey()) {
 			if shortage == 0 {
-				// we can call the redundant copy callback
-				// here to slightly improve the performance
-				// instead of readability.
-				redundantLocalCopy = true
+				res.localRedundant = true
 				break
 			} else {
 				shortage--
+				res.localRequired = true
 			}
 		} else if shortage > 0 {
 			callCtx, cancel := context.WithTimeout(ctx, p.headTimeout)
@@ -103,6 +175,7 @@ func (p *Policer) processNodes(ctx context.Context, addr *addressSDK.Address, no
 				}
 			} else {
 				shortage--
+				res.remoteCopies++
 			}
 		}
 
@@ -115,14 +188,11 @@ func (p *Policer) processNodes(ctx context.Context, addr *addressSDK.Address, no
 			zap.Uint32("shortage", shortage),
 		)
 
-		p.replicator.HandleTask(ctx, new(replicator.Task).
+		res.task = new(replicator.Task).
 			WithObjectAddress(addr).
 			WithNodes(nodes).
-			WithCopiesNumber(shortage),
-		)
-	} else if redundantLocalCopy {
-		log.Info("redundant local object copy detected")
-
-		p.cbRedundantCopy(addr)
+			WithCopiesNumber(shortage)
 	}
+
+	return
 }
@@ -0,0 +1,58 @@
+package policer
+
+import (
+	"context"
+	"time"
+)
+
+// rateLimiter spreads the events evenly over time so that their rate
+// does not exceed the limit. It is not safe for concurrent use.
+type rateLimiter struct {
+	// zero if the rate is not limited
+	interval time.Duration
+
+	// time of the next allowed event
+	next time.Time
+}
+
+func newRateLimiter(perSecond uint32) *rateLimiter {
+	l := new(rateLimiter)
+
+	if perSecond > 0 {
+		l.interval = time.Second / time.Duration(perSecond)
+	}
+
+	return l
+}
+
+// wait blocks until the next event is allowed. Returns false
+// if the context is done before.
+func (l *rateLimiter) wait(ctx context.Context) bool {
+	if l.interval == 0 {
+		return true
+	}
+
+	now := time.Now()
+
+	// events which have not happened in the past are not accumulated
+	if l.next.Before(now) {
+		l.next = now
+	}
+
+	d := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+
+	if d == 0 {
+		return true
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
@@ -0,0 +1,43 @@
+package policer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unlimited", func(t *testing.T) {
+		l := newRateLimiter(0)
+
+		start := time.Now()
+		for i := 0; i < 1000; i++ {
+			require.True(t, l.wait(ctx))
+		}
+		require.Less(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("limited", func(t *testing.T) {
+		l := newRateLimiter(100)
+
+		start := time.Now()
+		for i := 0; i < 11; i++ {
+			require.True(t, l.wait(ctx))
+		}
+		require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		l := newRateLimiter(1)
+		require.True(t, l.wait(ctx))
+
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		require.False(t, l.wait(ctx))
+	})
+}
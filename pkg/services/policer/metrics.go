@@ -0,0 +1,24 @@
+package policer
+
+// MetricRegister represents Policer's metric register.
+type MetricRegister interface {
+	// IncPolicerCheckedObjects registers the object which placement has been checked.
+	IncPolicerCheckedObjects()
+
+	// IncPolicerShortages registers the object with the shortage of copies.
+	IncPolicerShortages()
+
+	// IncPolicerRedundantCopies registers the detected redundant local copy of the object.
+	IncPolicerRedundantCopies()
+
+	// IncPolicerRemovedCopies registers the redundant local copy of the object
+	// passed for removal.
+	IncPolicerRemovedCopies()
+
+	// IncPolicerUnmarkedCopies registers the local copy of the object which
+	// turned out to be required while waiting for removal confirmation.
+	IncPolicerUnmarkedCopies()
+
+	// IncPolicerCompletedScans registers the completed scan of all local objects.
+	IncPolicerCompletedScans()
+}
@@ -28,6 +28,14 @@ type Policer struct {
 	*cfg
 
 	cache *lru.Cache
+
+	// epochs of the redundant local copy marks by object addresses
+	redundantMarks *lru.Cache
+
+	limiter *rateLimiter
+
+	// objects to be checked out of turn
+	urgent chan *addressSDK.Address
 }
 
 // Option is an option for Policer constructor.
@@ -65,6 +73,16 @@ type cfg struct {
 	batchSize, cacheSize uint32
 
 	rebalanceFreq, evictDuration time.Duration
+
+	objectsPerSecond uint32
+
+	netState netmap.State
+
+	redundantCopyEpochs uint64
+
+	urgentCap uint32
+
+	metrics MetricRegister
 }
 
 func defaultCfg() *cfg {
@@ -74,6 +92,7 @@ func defaultCfg() *cfg {
 		cacheSize:     200_000, // should not allocate more than 200 MiB
 		rebalanceFreq: 1 * time.Second,
 		evictDuration: 30 * time.Second,
+		urgentCap:     100_000,
 	}
 }
 
@@ -92,9 +111,18 @@ func New(opts ...Option) *Policer {
 		panic(err)
 	}
 
+	// losing the mark only postpones the removal
+	marks, err := lru.New(int(c.cacheSize))
+	if err != nil {
+		panic(err)
+	}
+
 	return &Policer{
-		cfg:   c,
-		cache: cache,
+		cfg:            c,
+		cache:          cache,
+		redundantMarks: marks,
+		limiter:        newRateLimiter(c.objectsPerSecond),
+		urgent:         make(chan *addressSDK.Address, c.urgentCap),
 	}
 }
 
@@ -185,3 +213,41 @@ func WithNodeLoader(l nodeLoader) Option {
 		c.loader = l
 	}
 }
+
+// WithObjectsPerSecond returns option to set the maximum number
+// of the objects checked by Policer per second during the scan
+// of the local storage.
+//
+// Zero value means no limit.
+func WithObjectsPerSecond(v uint32) Option {
+	return func(c *cfg) {
+		c.objectsPerSecond = v
+	}
+}
+
+// WithMetrics returns option to set Policer's metric register.
+func WithMetrics(v MetricRegister) Option {
+	return func(c *cfg) {
+		c.metrics = v
+	}
+}
+
+// WithNetworkState returns option to set source of the current epoch.
+func WithNetworkState(v netmap.State) Option {
+	return func(c *cfg) {
+		c.netState = v
+	}
+}
+
+// WithRedundantCopyEpochs returns option to set the number of epochs
+// during which the local copy of the object must stay redundant before
+// it is removed. Redundant copies are marked and removed only if each
+// subsequent check confirms the redundancy. Requires network state
+// to be set (see WithNetworkState).
+//
+// Zero value means that redundant copies are removed immediately.
+func WithRedundantCopyEpochs(v uint64) Option {
+	return func(c *cfg) {
+		c.redundantCopyEpochs = v
+	}
+}
@@ -16,6 +16,7 @@ func (p *Policer) Run(ctx context.Context) {
 	}()
 
 	go p.poolCapacityWorker(ctx)
+	go p.urgentWorker(ctx)
 	p.shardPolicyWorker(ctx)
 }
 
@@ -36,6 +37,10 @@ func (p *Policer) shardPolicyWorker(ctx context.Context) {
 		addrs, cursor, err = p.jobQueue.Select(cursor, p.batchSize)
 		if err != nil {
 			if errors.Is(err, engine.ErrEndOfListing) {
+				if p.metrics != nil {
+					p.metrics.IncPolicerCompletedScans()
+				}
+
 				time.Sleep(time.Second) // finished whole cycle, sleep a bit
 				continue
 			}
@@ -49,12 +54,17 @@ func (p *Policer) shardPolicyWorker(ctx context.Context) {
 			default:
 				addr := addrs[i]
 				addrStr := addr.String()
-				err = p.taskPool.Submit(func() {
-					v, ok := p.cache.Get(addrStr)
-					if ok && time.Since(v.(time.Time)) < p.evictDuration {
-						return
-					}
 
+				v, ok := p.cache.Get(addrStr)
+				if ok && time.Since(v.(time.Time)) < p.evictDuration {
+					continue
+				}
+
+				if !p.limiter.wait(ctx) {
+					return
+				}
+
+				err = p.taskPool.Submit(func() {
 					p.processObject(ctx, addr)
 					p.cache.Add(addrStr, time.Now())
 				})
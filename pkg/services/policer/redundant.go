@@ -0,0 +1,98 @@
+package policer
+
+import (
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.uber.org/zap"
+)
+
+// handleRedundantCopy processes the redundant local copy of the object.
+//
+// If removal confirmation is disabled, the copy is passed to the redundant
+// copy callback immediately. Otherwise, the copy is marked first and passed
+// to the callback only if it is still redundant after the configured number
+// of epochs. The mark is removed if any check in between finds the local
+// copy required (see unmarkRedundantCopy).
+func (p *Policer) handleRedundantCopy(addr *addressSDK.Address) {
+	log := p.log.With(zap.Stringer("object", addr))
+
+	if p.redundantCopyEpochs == 0 {
+		log.Info("redundant local object copy detected")
+
+		if p.metrics != nil {
+			p.metrics.IncPolicerRedundantCopies()
+			p.metrics.IncPolicerRemovedCopies()
+		}
+
+		p.cbRedundantCopy(addr)
+
+		return
+	}
+
+	epoch := p.netState.CurrentEpoch()
+	key := addr.String()
+
+	v, ok := p.redundantMarks.Get(key)
+	if !ok {
+		p.redundantMarks.Add(key, epoch)
+
+		log.Info("redundant local object copy marked for removal",
+			zap.Uint64("epoch", epoch),
+		)
+
+		if p.metrics != nil {
+			p.metrics.IncPolicerRedundantCopies()
+		}
+
+		return
+	}
+
+	markEpoch := v.(uint64)
+
+	if epoch < markEpoch+p.redundantCopyEpochs {
+		log.Debug("redundant local object copy is waiting for removal confirmation",
+			zap.Uint64("mark epoch", markEpoch),
+			zap.Uint64("epoch", epoch),
+		)
+
+		return
+	}
+
+	p.redundantMarks.Remove(key)
+
+	log.Info("redundant local object copy confirmed, removing",
+		zap.Uint64("mark epoch", markEpoch),
+		zap.Uint64("epoch", epoch),
+	)
+
+	if p.metrics != nil {
+		p.metrics.IncPolicerRemovedCopies()
+	}
+
+	p.cbRedundantCopy(addr)
+}
+
+// unmarkRedundantCopy removes the redundancy mark of the local copy
+// of the object if it is set.
+func (p *Policer) unmarkRedundantCopy(addr *addressSDK.Address) {
+	if p.redundantCopyEpochs == 0 {
+		return
+	}
+
+	key := addr.String()
+
+	v, ok := p.redundantMarks.Peek(key)
+	if !ok {
+		return
+	}
+
+	p.redundantMarks.Remove(key)
+
+	p.log.Info("local object copy is not redundant anymore, removal canceled",
+		zap.Stringer("object", addr),
+		zap.Uint64("mark epoch", v.(uint64)),
+	)
+
+	if p.metrics != nil {
+		p.metrics.IncPolicerUnmarkedCopies()
+	}
+}
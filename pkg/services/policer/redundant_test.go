@@ -0,0 +1,88 @@
+package policer
+
+import (
+	"testing"
+
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	addresstest "github.com/nspcc-dev/neofs-sdk-go/object/address/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testEpochState uint64
+
+func (s *testEpochState) CurrentEpoch() uint64 {
+	return uint64(*s)
+}
+
+func TestPolicer_RedundantCopy(t *testing.T) {
+	var removed []*addressSDK.Address
+
+	cb := func(addr *addressSDK.Address) {
+		removed = append(removed, addr)
+	}
+
+	t.Run("immediate", func(t *testing.T) {
+		removed = nil
+
+		p := New(WithRedundantCopyCallback(cb))
+
+		addr := addresstest.Address()
+		p.handleRedundantCopy(addr)
+		require.Equal(t, []*addressSDK.Address{addr}, removed)
+	})
+
+	t.Run("confirmed", func(t *testing.T) {
+		removed = nil
+
+		epoch := testEpochState(10)
+
+		p := New(
+			WithRedundantCopyCallback(cb),
+			WithNetworkState(&epoch),
+			WithRedundantCopyEpochs(2),
+		)
+
+		addr := addresstest.Address()
+
+		p.handleRedundantCopy(addr)
+		require.Empty(t, removed)
+
+		epoch++
+		p.handleRedundantCopy(addr)
+		require.Empty(t, removed)
+
+		epoch++
+		p.handleRedundantCopy(addr)
+		require.Equal(t, []*addressSDK.Address{addr}, removed)
+
+		_, ok := p.redundantMarks.Get(addr.String())
+		require.False(t, ok)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		removed = nil
+
+		epoch := testEpochState(10)
+
+		p := New(
+			WithRedundantCopyCallback(cb),
+			WithNetworkState(&epoch),
+			WithRedundantCopyEpochs(2),
+		)
+
+		addr := addresstest.Address()
+
+		p.handleRedundantCopy(addr)
+
+		epoch += 2
+		p.unmarkRedundantCopy(addr)
+
+		// the copy is marked again
+		p.handleRedundantCopy(addr)
+		require.Empty(t, removed)
+
+		epoch += 2
+		p.handleRedundantCopy(addr)
+		require.Equal(t, []*addressSDK.Address{addr}, removed)
+	})
+}
@@ -0,0 +1,121 @@
+package policer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"github.com/panjf2000/ants/v2"
+	"go.uber.org/zap"
+)
+
+// urgentSelectBatchSize is the number of the container objects
+// selected from the local storage at once by CheckContainer.
+const urgentSelectBatchSize = 1000
+
+// urgentRetryDelay is the delay before the next submission
+// of the urgent check to the overloaded pool.
+const urgentRetryDelay = 100 * time.Millisecond
+
+// CheckObjects queues out-of-turn placement check of the local objects.
+// Queued objects are checked regardless of the objects-per-second limit
+// and of the time passed since their previous check.
+//
+// Returns the number of queued objects and false if the queue is full
+// and the rest of the objects are not queued. Queued objects are never
+// dropped, so the rest of the objects can be queued again later.
+func (p *Policer) CheckObjects(addrs []*addressSDK.Address) (uint32, bool) {
+	var n uint32
+
+	for i := range addrs {
+		select {
+		case p.urgent <- addrs[i]:
+			n++
+		default:
+			return n, false
+		}
+	}
+
+	return n, true
+}
+
+// CheckContainer queues out-of-turn placement check of all local
+// objects of the container. Objects are selected from the local
+// storage in batches, selection stops when the queue is full.
+// See CheckObjects for details.
+func (p *Policer) CheckContainer(id *cid.ID) (uint32, bool, error) {
+	var (
+		queued uint32
+		prm    = new(engine.SelectPrm).
+			WithContainerID(id).
+			WithFilters(objectSDK.NewSearchFilters()).
+			WithCount(urgentSelectBatchSize)
+	)
+
+	for {
+		res, err := p.jobQueue.localStorage.Select(prm)
+		if err != nil {
+			return queued, true, fmt.Errorf("could not select objects of the container: %w", err)
+		}
+
+		n, ok := p.CheckObjects(res.AddressList())
+		queued += n
+
+		if !ok || res.Cursor() == nil {
+			return queued, ok, nil
+		}
+
+		prm.WithCursor(res.Cursor())
+	}
+}
+
+func (p *Policer) urgentWorker(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case addr := <-p.urgent:
+			if !p.submitUrgent(ctx, addr) {
+				return
+			}
+		}
+	}
+}
+
+// submitUrgent submits the placement check of the queued object to the pool.
+// Submission is retried while the pool is overloaded, so the queued object
+// is not dropped. Returns false if the context is done or the pool is closed.
+func (p *Policer) submitUrgent(ctx context.Context, addr *addressSDK.Address) bool {
+	task := func() {
+		p.processObject(ctx, addr)
+		p.cache.Add(addr.String(), time.Now())
+	}
+
+	for {
+		err := p.taskPool.Submit(task)
+		if err == nil {
+			return true
+		}
+
+		if errors.Is(err, ants.ErrPoolClosed) {
+			p.log.Warn("pool submission", zap.Error(err))
+			return false
+		}
+
+		p.log.Debug("pool is overloaded, urgent placement check is postponed",
+			zap.Stringer("address", addr),
+			zap.Error(err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(urgentRetryDelay):
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package repairer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.uber.org/zap"
+)
+
+// ObjectSource is a source of the object copies stored
+// on the other container nodes.
+type ObjectSource interface {
+	// Get must read the object from the container nodes
+	// other than the local one.
+	Get(context.Context, *addressSDK.Address) (*objectSDK.Object, error)
+}
+
+// Repairer represents the utility that restores unreadable
+// local objects from the other container nodes.
+type Repairer struct {
+	*cfg
+
+	queue chan *addressSDK.Address
+
+	mtx sync.Mutex
+
+	// last repair attempts by object addresses
+	attempts map[string]time.Time
+}
+
+// Option is an option for Repairer constructor.
+type Option func(*cfg)
+
+type cfg struct {
+	log *logger.Logger
+
+	localStorage *engine.StorageEngine
+
+	source ObjectSource
+
+	queueCap uint32
+
+	getTimeout time.Duration
+
+	retryInterval time.Duration
+}
+
+func defaultCfg() *cfg {
+	return &cfg{
+		log:           zap.L(),
+		queueCap:      1000,
+		getTimeout:    time.Minute,
+		retryInterval: 10 * time.Minute,
+	}
+}
+
+// New creates, initializes and returns Repairer instance.
+func New(opts ...Option) *Repairer {
+	c := defaultCfg()
+
+	for i := range opts {
+		opts[i](c)
+	}
+
+	c.log = c.log.With(zap.String("component", "Object Repairer"))
+
+	return &Repairer{
+		cfg:      c,
+		queue:    make(chan *addressSDK.Address, c.queueCap),
+		attempts: make(map[string]time.Time),
+	}
+}
+
+// AddObject queues repair of the unreadable local object. Does not block.
+//
+// Object is not queued if the queue is full or if the repair of
+// the object has been attempted less than retry interval ago.
+func (r *Repairer) AddObject(addr *addressSDK.Address) {
+	key := addr.String()
+	now := time.Now()
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if last, ok := r.attempts[key]; ok && now.Sub(last) < r.retryInterval {
+		return
+	}
+
+	select {
+	case r.queue <- addr:
+		r.attempts[key] = now
+	default:
+		r.log.Debug("repair queue is full, object is skipped",
+			zap.Stringer("object", addr),
+		)
+	}
+}
+
+// Run processes the repair queue until the context is done.
+func (r *Repairer) Run(ctx context.Context) {
+	defer func() {
+		r.log.Info("routine stopped")
+	}()
+
+	ticker := time.NewTicker(r.retryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.dropExpiredAttempts()
+		case addr := <-r.queue:
+			r.repair(ctx, addr)
+		}
+	}
+}
+
+func (r *Repairer) repair(ctx context.Context, addr *addressSDK.Address) {
+	log := r.log.With(zap.Stringer("object", addr))
+
+	getCtx, cancel := context.WithTimeout(ctx, r.getTimeout)
+	obj, err := r.source.Get(getCtx, addr)
+	cancel()
+
+	if err != nil {
+		log.Warn("could not get object copy from container nodes",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	_, err = r.localStorage.Repair(new(engine.RepairPrm).WithObject(obj))
+	if err != nil {
+		log.Warn("could not save object copy to local storage",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	log.Info("local object copy restored from container nodes")
+}
+
+func (r *Repairer) dropExpiredAttempts() {
+	now := time.Now()
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	for key, last := range r.attempts {
+		if now.Sub(last) >= r.retryInterval {
+			delete(r.attempts, key)
+		}
+	}
+}
+
+// WithLogger returns option to set Logger of Repairer.
+func WithLogger(v *logger.Logger) Option {
+	return func(c *cfg) {
+		c.log = v
+	}
+}
+
+// WithLocalStorage returns option to set local object storage of Repairer.
+func WithLocalStorage(v *engine.StorageEngine) Option {
+	return func(c *cfg) {
+		c.localStorage = v
+	}
+}
+
+// WithObjectSource returns option to set source of the object copies.
+func WithObjectSource(v ObjectSource) Option {
+	return func(c *cfg) {
+		c.source = v
+	}
+}
+
+// WithQueueCapacity returns option to set the maximum number
+// of the objects waiting for repair.
+func WithQueueCapacity(v uint32) Option {
+	return func(c *cfg) {
+		c.queueCap = v
+	}
+}
+
+// WithGetTimeout returns option to set the timeout of the object
+// copy reading from the container nodes.
+func WithGetTimeout(v time.Duration) Option {
+	return func(c *cfg) {
+		c.getTimeout = v
+	}
+}
+
+// WithRetryInterval returns option to set the minimal interval
+// between repair attempts of the same object.
+func WithRetryInterval(v time.Duration) Option {
+	return func(c *cfg) {
+		c.retryInterval = v
+	}
+}
@@ -0,0 +1,39 @@
+package repairer
+
+import (
+	"testing"
+	"time"
+
+	addresstest "github.com/nspcc-dev/neofs-sdk-go/object/address/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepairer_AddObject(t *testing.T) {
+	r := New(
+		WithQueueCapacity(2),
+		WithRetryInterval(time.Hour),
+	)
+
+	addr := addresstest.Address()
+
+	r.AddObject(addr)
+	r.AddObject(addr)
+	require.Len(t, r.queue, 1)
+
+	r.AddObject(addresstest.Address())
+	r.AddObject(addresstest.Address()) // queue is full
+	require.Len(t, r.queue, 2)
+	require.Len(t, r.attempts, 2)
+
+	// attempt of the object is not expired yet
+	<-r.queue
+	r.AddObject(addr)
+	require.Len(t, r.queue, 1)
+
+	r.attempts[addr.String()] = time.Now().Add(-time.Hour)
+	r.dropExpiredAttempts()
+	require.Len(t, r.attempts, 1)
+
+	r.AddObject(addr)
+	require.Len(t, r.queue, 2)
+}
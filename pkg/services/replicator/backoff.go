@@ -0,0 +1,108 @@
+package replicator
+
+import (
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+)
+
+// nodeBackoff tracks failures of the replication to the remote nodes.
+// Node is not used for replication until its back-off interval expires.
+// The interval is doubled on each failure in a row.
+type nodeBackoff struct {
+	mtx sync.Mutex
+
+	min, max time.Duration
+
+	// by hex-encoded public keys
+	nodes map[string]*nodeFailures
+}
+
+type nodeFailures struct {
+	count uint32
+
+	until time.Time
+}
+
+func newNodeBackoff(min, max time.Duration) *nodeBackoff {
+	return &nodeBackoff{
+		min:   min,
+		max:   max,
+		nodes: make(map[string]*nodeFailures),
+	}
+}
+
+// ready checks if the node can be used for replication at the moment.
+func (b *nodeBackoff) ready(key string, now time.Time) bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	f, ok := b.nodes[key]
+
+	return !ok || !f.until.After(now)
+}
+
+// failed registers the replication failure and returns
+// the time before which the node is not used.
+func (b *nodeBackoff) failed(key string, now time.Time) time.Time {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	f, ok := b.nodes[key]
+	if !ok {
+		f = new(nodeFailures)
+		b.nodes[key] = f
+	}
+
+	delay := b.max
+	if f.count < 32 {
+		if d := b.min << f.count; d > 0 && d < b.max {
+			delay = d
+		}
+	}
+
+	f.count++
+	f.until = now.Add(delay)
+
+	return f.until
+}
+
+// succeeded resets the failures of the node.
+func (b *nodeBackoff) succeeded(key string) {
+	b.mtx.Lock()
+	delete(b.nodes, key)
+	b.mtx.Unlock()
+}
+
+// nextAttempt returns the earliest time when any of the nodes can be used,
+// but not earlier than the minimal back-off interval after now.
+func (b *nodeBackoff) nextAttempt(nodes netmap.Nodes, now time.Time) time.Time {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	res := now.Add(b.max)
+
+	for i := range nodes {
+		f, ok := b.nodes[nodeKey(nodes[i])]
+		if !ok || !f.until.After(now) {
+			res = now
+			break
+		}
+
+		if f.until.Before(res) {
+			res = f.until
+		}
+	}
+
+	if min := now.Add(b.min); res.Before(min) {
+		res = min
+	}
+
+	return res
+}
+
+func nodeKey(n netmap.Node) string {
+	return hex.EncodeToString(n.PublicKey())
+}
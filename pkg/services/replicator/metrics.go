@@ -0,0 +1,8 @@
+package replicator
+
+// MetricRegister represents Replicator's metric register.
+type MetricRegister interface {
+	// SetReplicatorQueueDepth registers the number of the replication
+	// tasks of the given priority in the queue.
+	SetReplicatorQueueDepth(priority string, n uint64)
+}
@@ -2,7 +2,7 @@ package replicator
 
 import (
 	"context"
-	"encoding/hex"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
@@ -11,18 +11,46 @@ import (
 
 func (p *Replicator) Run(ctx context.Context) {
 	defer func() {
-		close(p.ch)
 		p.log.Info("routine stopped")
 	}()
 
-	p.ch = make(chan *Task, p.taskCap)
-
 	p.log.Info("process routine",
 		zap.Uint32("task queue capacity", p.taskCap),
+		zap.Bool("persistent queue", p.queuePath != ""),
 		zap.Duration("put timeout", p.putTimeout),
 	)
 
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
 	for {
+		t, wait := p.queue.pop(time.Now())
+		if t != nil {
+			err := p.pool.Submit(func() {
+				p.processQueuedTask(ctx, t)
+			})
+			if err != nil {
+				p.log.Warn("could not submit replication task to the pool",
+					zap.String("error", err.Error()),
+				)
+
+				p.finishTask(t, time.Now().Add(p.retryMin))
+			}
+
+			continue
+		}
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+
+		if wait > 0 {
+			timer.Reset(wait)
+		}
+
 		select {
 		case <-ctx.Done():
 			p.log.Warn("context is done",
@@ -30,33 +58,76 @@ func (p *Replicator) Run(ctx context.Context) {
 			)
 
 			return
-		case task, ok := <-p.ch:
-			if !ok {
-				p.log.Warn("trigger channel is closed")
+		case <-p.queue.notify:
+		case <-timer.C:
+		}
+	}
+}
 
-				return
-			}
+// processQueuedTask executes the task from the queue and returns it
+// to the queue if there are still missing replicas.
+func (p *Replicator) processQueuedTask(ctx context.Context, t *queuedTask) {
+	if !p.handleTask(ctx, t.Task) {
+		p.finishTask(t, time.Time{})
+		return
+	}
 
-			p.HandleTask(ctx, task)
-		}
+	if t.quantity == 0 {
+		p.finishTask(t, time.Time{})
+		return
+	}
+
+	if len(t.nodes) == 0 {
+		p.log.Warn("no nodes left to replicate object",
+			zap.Stringer("object", t.addr),
+			zap.Uint32("amount of unfinished replicas", t.quantity),
+		)
+
+		p.finishTask(t, time.Time{})
+
+		return
+	}
+
+	p.finishTask(t, p.backoff.nextAttempt(t.nodes, time.Now()))
+}
+
+func (p *Replicator) finishTask(t *queuedTask, retryAt time.Time) {
+	if err := p.queue.done(t, retryAt); err != nil {
+		p.log.Warn("could not update replication task in the queue",
+			zap.Stringer("object", t.addr),
+			zap.String("error", err.Error()),
+		)
 	}
 }
 
 // HandleTask executes replication task inside invoking goroutine.
+// Nodes which have received the replica are removed from the task.
 func (p *Replicator) HandleTask(ctx context.Context, task *Task) {
+	p.handleTask(ctx, task)
+}
+
+// handleTask executes replication task. Nodes in the back-off state
+// are skipped unless the task ignores back-off. Returns false if the
+// task can not be executed anymore.
+func (p *Replicator) handleTask(ctx context.Context, task *Task) bool {
 	defer func() {
 		p.log.Debug("finish work",
 			zap.Uint32("amount of unfinished replicas", task.quantity),
 		)
 	}()
 
-	obj, err := engine.Get(p.localStorage, task.addr)
-	if err != nil {
-		p.log.Error("could not get object from local storage",
-			zap.Stringer("object", task.addr),
-			zap.Error(err))
+	obj := task.obj
+	if obj == nil {
+		var err error
 
-		return
+		obj, err = engine.Get(p.localStorage, task.addr)
+		if err != nil {
+			p.log.Error("could not get object from local storage",
+				zap.Stringer("object", task.addr),
+				zap.Error(err))
+
+			return false
+		}
 	}
 
 	prm := new(putsvc.RemotePutPrm).
@@ -65,18 +136,24 @@ func (p *Replicator) HandleTask(ctx context.Context, task *Task) {
 	for i := 0; task.quantity > 0 && i < len(task.nodes); i++ {
 		select {
 		case <-ctx.Done():
-			return
+			return true
 		default:
 		}
 
+		key := nodeKey(task.nodes[i])
+
+		if !task.ignoreBackoff && !p.backoff.ready(key, time.Now()) {
+			continue
+		}
+
 		log := p.log.With(
-			zap.String("node", hex.EncodeToString(task.nodes[i].PublicKey())),
+			zap.String("node", key),
 			zap.Stringer("object", task.addr),
 		)
 
 		callCtx, cancel := context.WithTimeout(ctx, p.putTimeout)
 
-		err = p.remoteSender.PutObject(callCtx, prm.WithNodeInfo(task.nodes[i].NodeInfo))
+		err := p.remoteSender.PutObject(callCtx, prm.WithNodeInfo(task.nodes[i].NodeInfo))
 
 		cancel()
 
@@ -84,10 +161,18 @@ func (p *Replicator) HandleTask(ctx context.Context, task *Task) {
 			log.Error("could not replicate object",
 				zap.String("error", err.Error()),
 			)
+
+			p.backoff.failed(key, time.Now())
 		} else {
 			log.Debug("object successfully replicated")
 
+			p.backoff.succeeded(key)
+
 			task.quantity--
+			task.nodes = append(task.nodes[:i], task.nodes[i+1:]...)
+			i--
 		}
 	}
+
+	return true
 }
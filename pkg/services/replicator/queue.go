@@ -0,0 +1,436 @@
+package replicator
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.etcd.io/bbolt"
+)
+
+var errQueueFull = errors.New("task queue is full")
+
+var queueBucket = []byte("tasks")
+
+// queuedTask is a replication task placed in the queue.
+type queuedTask struct {
+	*Task
+
+	// object address and placement vector, unique within the queue
+	key string
+
+	// time before which the task is not executed
+	readyAt time.Time
+
+	// order of the task addition
+	seq uint64
+
+	// index in the heap, negative if the task is being executed
+	index int
+
+	// set if the task has been replaced or removed
+	// from the queue while being executed
+	stale bool
+}
+
+// taskHeap orders the tasks by ready time, then by order of addition.
+type taskHeap []*queuedTask
+
+func (h taskHeap) Len() int { return len(h) }
+
+func (h taskHeap) Less(i, j int) bool {
+	if !h[i].readyAt.Equal(h[j].readyAt) {
+		return h[i].readyAt.Before(h[j].readyAt)
+	}
+
+	return h[i].seq < h[j].seq
+}
+
+func (h taskHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *taskHeap) Push(x interface{}) {
+	t := x.(*queuedTask)
+	t.index = len(*h)
+	*h = append(*h, t)
+}
+
+func (h *taskHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	t := old[n-1]
+	old[n-1] = nil
+	t.index = -1
+	*h = old[:n-1]
+
+	return t
+}
+
+// priorities lists task priorities in order of execution.
+var priorities = []Priority{PriorityHigh, PriorityNormal}
+
+// taskQueue is a queue of the replication tasks. Tasks are kept in memory
+// and, if the queue is persistent, are written through to the disk, so
+// the pending tasks survive the restart.
+type taskQueue struct {
+	mtx sync.Mutex
+
+	capacity int
+
+	// nil if the queue is not persistent
+	db *bbolt.DB
+
+	seq uint64
+
+	// tasks waiting for execution by priority
+	pending map[Priority]*taskHeap
+
+	// all queued tasks including the executed ones
+	tasks map[string]*queuedTask
+
+	// number of the queued tasks by priority
+	depth map[Priority]uint64
+
+	// notified on each task addition
+	notify chan struct{}
+
+	metrics MetricRegister
+}
+
+func newTaskQueue(capacity uint32, m MetricRegister) *taskQueue {
+	q := &taskQueue{
+		capacity: int(capacity),
+		pending:  make(map[Priority]*taskHeap, len(priorities)),
+		tasks:    make(map[string]*queuedTask),
+		depth:    make(map[Priority]uint64, len(priorities)),
+		notify:   make(chan struct{}, 1),
+		metrics:  m,
+	}
+
+	for _, p := range priorities {
+		q.pending[p] = new(taskHeap)
+	}
+
+	return q
+}
+
+// open opens the persistent storage of the queue and loads saved tasks.
+func (q *taskQueue) open(path string) error {
+	db, err := bbolt.Open(path, 0600, nil)
+	if err != nil {
+		return fmt.Errorf("can't open bbolt at %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(queueBucket)
+		if err != nil {
+			return err
+		}
+
+		var corrupted [][]byte
+
+		err = b.ForEach(func(k, v []byte) error {
+			t, err := decodeTask(k, v)
+			if err != nil {
+				corrupted = append(corrupted, k)
+				return nil
+			}
+
+			q.add(string(k), t)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// the policer will detect the shortage again
+		for i := range corrupted {
+			if err := b.Delete(corrupted[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		_ = db.Close()
+		return fmt.Errorf("could not load replication tasks: %w", err)
+	}
+
+	q.db = db
+
+	q.reportDepth()
+
+	return nil
+}
+
+// close closes the persistent storage of the queue.
+func (q *taskQueue) close() error {
+	if q.db == nil {
+		return nil
+	}
+
+	return q.db.Close()
+}
+
+// push adds the task to the queue. If the queue contains a task for
+// the same object, it is replaced by t keeping the higher priority.
+func (q *taskQueue) push(t *Task) error {
+	key := taskKey(t)
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	old, ok := q.tasks[key]
+	if !ok && q.capacity > 0 && len(q.tasks) >= q.capacity {
+		return errQueueFull
+	}
+
+	if ok && old.priority > t.priority {
+		t.priority = old.priority
+	}
+
+	if err := q.save(key, t); err != nil {
+		return err
+	}
+
+	if ok {
+		q.remove(old)
+	}
+
+	q.add(key, t)
+
+	q.reportDepth()
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// pop returns the next task ready to be executed. If there are no such
+// tasks, returns nil and the duration after which the next task becomes
+// ready, zero if the queue is empty.
+//
+// Returned task stays in the queue until done is called.
+func (q *taskQueue) pop(now time.Time) (*queuedTask, time.Duration) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	var wait time.Duration
+
+	for _, p := range priorities {
+		h := q.pending[p]
+		if h.Len() == 0 {
+			continue
+		}
+
+		if t := (*h)[0]; t.readyAt.After(now) {
+			if d := t.readyAt.Sub(now); wait == 0 || d < wait {
+				wait = d
+			}
+
+			continue
+		}
+
+		return heap.Pop(h).(*queuedTask), 0
+	}
+
+	return nil, wait
+}
+
+// done completes execution of the task. The task is removed from the
+// queue if there is nothing more to do, otherwise it is returned to the
+// queue to be executed not earlier than retryAt.
+func (q *taskQueue) done(t *queuedTask, retryAt time.Time) error {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	if t.stale {
+		return nil
+	}
+
+	var err error
+
+	if retryAt.IsZero() {
+		q.remove(t)
+		err = q.delete(t.key)
+	} else {
+		// successful nodes are removed from the task
+		err = q.save(t.key, t.Task)
+
+		t.readyAt = retryAt
+		heap.Push(q.pending[t.priority], t)
+	}
+
+	q.reportDepth()
+
+	return err
+}
+
+// add places the task in memory.
+func (q *taskQueue) add(key string, t *Task) {
+	if _, ok := q.pending[t.priority]; !ok {
+		t.priority = PriorityNormal
+	}
+
+	q.seq++
+
+	qt := &queuedTask{
+		Task: t,
+		key:  key,
+		seq:  q.seq,
+	}
+
+	q.tasks[key] = qt
+	q.depth[t.priority]++
+	heap.Push(q.pending[t.priority], qt)
+}
+
+// remove removes the task from memory.
+func (q *taskQueue) remove(t *queuedTask) {
+	if t.index >= 0 {
+		heap.Remove(q.pending[t.priority], t.index)
+	} else {
+		t.stale = true // being executed
+	}
+
+	delete(q.tasks, t.key)
+	q.depth[t.priority]--
+}
+
+func (q *taskQueue) save(key string, t *Task) error {
+	if q.db == nil {
+		return nil
+	}
+
+	data, err := encodeTask(t)
+	if err != nil {
+		return fmt.Errorf("could not encode replication task: %w", err)
+	}
+
+	return q.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(queueBucket).Put([]byte(key), data)
+	})
+}
+
+func (q *taskQueue) delete(key string) error {
+	if q.db == nil {
+		return nil
+	}
+
+	return q.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(queueBucket).Delete([]byte(key))
+	})
+}
+
+func (q *taskQueue) reportDepth() {
+	if q.metrics == nil {
+		return
+	}
+
+	for p, n := range q.depth {
+		q.metrics.SetReplicatorQueueDepth(p.String(), n)
+	}
+}
+
+// taskKey returns the key of the task in the queue.
+func taskKey(t *Task) string {
+	return t.addr.String() + "#" + strconv.FormatUint(uint64(t.vector), 10)
+}
+
+// encodeTask returns binary representation of the task which is
+// stored on disk. Object itself is not stored.
+func encodeTask(t *Task) ([]byte, error) {
+	buf := make([]byte, 1+4+binary.MaxVarintLen64)
+
+	buf[0] = byte(t.priority)
+	binary.BigEndian.PutUint32(buf[1:], t.quantity)
+	n := binary.PutUvarint(buf[5:], uint64(len(t.nodes)))
+	buf = buf[:5+n]
+
+	for i := range t.nodes {
+		data, err := t.nodes[i].NodeInfo.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		var lenBuf [binary.MaxVarintLen64]byte
+
+		n := binary.PutUvarint(lenBuf[:], uint64(len(data)))
+		buf = append(buf, lenBuf[:n]...)
+		buf = append(buf, data...)
+	}
+
+	return buf, nil
+}
+
+// decodeTask restores the task with the key from its binary representation.
+func decodeTask(key, data []byte) (*Task, error) {
+	ind := bytes.LastIndexByte(key, '#')
+	if ind < 0 {
+		return nil, errors.New("missing placement vector")
+	}
+
+	vector, err := strconv.ParseUint(string(key[ind+1:]), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid placement vector: %w", err)
+	}
+
+	addr := addressSDK.NewAddress()
+	if err := addr.Parse(string(key[:ind])); err != nil {
+		return nil, fmt.Errorf("invalid object address: %w", err)
+	}
+
+	if len(data) < 5 {
+		return nil, errors.New("task is too short")
+	}
+
+	t := &Task{
+		priority: Priority(data[0]),
+		quantity: binary.BigEndian.Uint32(data[1:]),
+		vector:   uint32(vector),
+		addr:     addr,
+	}
+
+	count, n := binary.Uvarint(data[5:])
+	if n <= 0 {
+		return nil, errors.New("invalid number of nodes")
+	}
+
+	data = data[5+n:]
+
+	infos := make([]netmap.NodeInfo, 0, count)
+
+	for i := uint64(0); i < count; i++ {
+		l, n := binary.Uvarint(data)
+		if n <= 0 || uint64(len(data)-n) < l {
+			return nil, fmt.Errorf("invalid node #%d", i)
+		}
+
+		ni := netmap.NewNodeInfo()
+		if err := ni.Unmarshal(data[n : n+int(l)]); err != nil {
+			return nil, fmt.Errorf("invalid node #%d: %w", i, err)
+		}
+
+		infos = append(infos, *ni)
+		data = data[n+int(l):]
+	}
+
+	t.nodes = netmap.NodesFromInfo(infos)
+
+	return t, nil
+}
@@ -0,0 +1,183 @@
+package replicator
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/address/test"
+	"github.com/stretchr/testify/require"
+)
+
+func testNodes(keys ...byte) netmap.Nodes {
+	infos := make([]netmap.NodeInfo, len(keys))
+
+	for i := range keys {
+		ni := netmap.NewNodeInfo()
+		ni.SetPublicKey([]byte{keys[i]})
+		ni.SetAddresses("/ip4/127.0.0.1/tcp/8080")
+
+		infos[i] = *ni
+	}
+
+	return netmap.NodesFromInfo(infos)
+}
+
+func testTask(p Priority) *Task {
+	return new(Task).
+		WithObjectAddress(objecttest.Address()).
+		WithNodes(testNodes(1, 2)).
+		WithCopiesNumber(1).
+		WithPriority(p)
+}
+
+func TestTaskQueue_Priority(t *testing.T) {
+	q := newTaskQueue(0, nil)
+	now := time.Now()
+
+	normal := testTask(PriorityNormal)
+	high := testTask(PriorityHigh)
+
+	require.NoError(t, q.push(normal))
+	require.NoError(t, q.push(high))
+
+	qt, _ := q.pop(now)
+	require.Equal(t, high, qt.Task)
+
+	// postponed high priority task does not block normal ones
+	require.NoError(t, q.done(qt, now.Add(time.Minute)))
+
+	qt, _ = q.pop(now)
+	require.Equal(t, normal, qt.Task)
+	require.NoError(t, q.done(qt, time.Time{}))
+
+	qt, wait := q.pop(now)
+	require.Nil(t, qt)
+	require.Equal(t, time.Minute, wait)
+
+	qt, _ = q.pop(now.Add(time.Minute))
+	require.Equal(t, high, qt.Task)
+	require.NoError(t, q.done(qt, time.Time{}))
+
+	qt, wait = q.pop(now)
+	require.Nil(t, qt)
+	require.Zero(t, wait)
+}
+
+func TestTaskQueue_Push(t *testing.T) {
+	t.Run("replace", func(t *testing.T) {
+		q := newTaskQueue(0, nil)
+
+		high := testTask(PriorityHigh)
+		require.NoError(t, q.push(high))
+
+		normal := testTask(PriorityNormal).WithObjectAddress(high.addr)
+		require.NoError(t, q.push(normal))
+		require.Len(t, q.tasks, 1)
+
+		qt, _ := q.pop(time.Now())
+		require.Equal(t, normal, qt.Task)
+		require.Equal(t, PriorityHigh, qt.priority)
+
+		// another placement vector of the same object
+		require.NoError(t, q.push(testTask(PriorityNormal).
+			WithObjectAddress(high.addr).
+			WithPlacementVector(1)))
+		require.Len(t, q.tasks, 2)
+
+		// task is replaced while being executed
+		require.NoError(t, q.push(testTask(PriorityNormal).WithObjectAddress(high.addr)))
+		require.NoError(t, q.done(qt, time.Now()))
+		require.Len(t, q.tasks, 2)
+		require.EqualValues(t, 1, q.depth[PriorityNormal])
+		require.EqualValues(t, 1, q.depth[PriorityHigh])
+	})
+
+	t.Run("capacity", func(t *testing.T) {
+		q := newTaskQueue(1, nil)
+
+		task := testTask(PriorityNormal)
+		require.NoError(t, q.push(task))
+		require.NoError(t, q.push(testTask(PriorityHigh).WithObjectAddress(task.addr)))
+		require.ErrorIs(t, q.push(testTask(PriorityNormal)), errQueueFull)
+	})
+}
+
+func TestTaskQueue_Persistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queue")
+
+	q := newTaskQueue(0, nil)
+	require.NoError(t, q.open(path))
+
+	normal := testTask(PriorityNormal).WithPlacementVector(2)
+	high := testTask(PriorityHigh).WithCopiesNumber(2)
+	finished := testTask(PriorityNormal)
+
+	require.NoError(t, q.push(normal))
+	require.NoError(t, q.push(high))
+	require.NoError(t, q.push(finished))
+
+	for {
+		qt, _ := q.pop(time.Now())
+		if qt.Task == finished {
+			require.NoError(t, q.done(qt, time.Time{}))
+			break
+		}
+
+		require.NoError(t, q.done(qt, time.Now().Add(time.Hour)))
+	}
+
+	require.NoError(t, q.close())
+
+	q = newTaskQueue(0, nil)
+	require.NoError(t, q.open(path))
+
+	defer q.close()
+
+	require.Len(t, q.tasks, 2)
+
+	for _, exp := range []*Task{high, normal} {
+		qt, _ := q.pop(time.Now())
+		require.NotNil(t, qt)
+		require.Equal(t, exp.addr.String(), qt.addr.String())
+		require.Equal(t, exp.priority, qt.priority)
+		require.Equal(t, exp.quantity, qt.quantity)
+		require.Equal(t, exp.vector, qt.vector)
+		require.Len(t, qt.nodes, len(exp.nodes))
+
+		for i := range exp.nodes {
+			require.Equal(t, exp.nodes[i].PublicKey(), qt.nodes[i].PublicKey())
+		}
+	}
+}
+
+func TestNodeBackoff(t *testing.T) {
+	b := newNodeBackoff(time.Second, 3*time.Second)
+	now := time.Now()
+
+	nodes := testNodes(1, 2)
+	key := nodeKey(nodes[0])
+
+	require.True(t, b.ready(key, now))
+
+	require.Equal(t, now.Add(time.Second), b.failed(key, now))
+	require.False(t, b.ready(key, now))
+	require.True(t, b.ready(key, now.Add(time.Second)))
+
+	require.Equal(t, now.Add(2*time.Second), b.failed(key, now))
+	require.Equal(t, now.Add(3*time.Second), b.failed(key, now))
+	require.Equal(t, now.Add(3*time.Second), b.failed(key, now))
+
+	// second node is available
+	require.Equal(t, now.Add(time.Second), b.nextAttempt(nodes, now))
+
+	b.failed(nodeKey(nodes[1]), now)
+	require.Equal(t, now.Add(time.Second), b.nextAttempt(nodes, now))
+
+	b.failed(nodeKey(nodes[1]), now)
+	require.Equal(t, now.Add(2*time.Second), b.nextAttempt(nodes, now))
+
+	b.succeeded(key)
+	require.True(t, b.ready(key, now))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 	"go.uber.org/zap"
 )
@@ -14,7 +15,9 @@ import (
 type Replicator struct {
 	*cfg
 
-	ch chan *Task
+	queue *taskQueue
+
+	backoff *nodeBackoff
 }
 
 // Option is an option for Policer constructor.
@@ -23,17 +26,36 @@ type Option func(*cfg)
 type cfg struct {
 	taskCap uint32
 
+	queuePath string
+
 	putTimeout time.Duration
 
+	retryMin, retryMax time.Duration
+
 	log *logger.Logger
 
 	remoteSender *putsvc.RemoteSender
 
 	localStorage *engine.StorageEngine
+
+	pool util.WorkerPool
+
+	metrics MetricRegister
 }
 
+const (
+	defaultTaskCap  = 10000
+	defaultRetryMin = 5 * time.Second
+	defaultRetryMax = 10 * time.Minute
+)
+
 func defaultCfg() *cfg {
-	return &cfg{}
+	return &cfg{
+		taskCap:  defaultTaskCap,
+		retryMin: defaultRetryMin,
+		retryMax: defaultRetryMax,
+		pool:     util.NewPseudoWorkerPool(),
+	}
 }
 
 // New creates, initializes and returns Replicator instance.
@@ -47,8 +69,26 @@ func New(opts ...Option) *Replicator {
 	c.log = c.log.With(zap.String("component", "Object Replicator"))
 
 	return &Replicator{
-		cfg: c,
+		cfg:     c,
+		queue:   newTaskQueue(c.taskCap, c.metrics),
+		backoff: newNodeBackoff(c.retryMin, c.retryMax),
+	}
+}
+
+// Open opens the persistent task queue and loads the tasks
+// saved before the restart. Does nothing if the queue
+// is not persistent.
+func (p *Replicator) Open() error {
+	if p.queuePath == "" {
+		return nil
 	}
+
+	return p.queue.open(p.queuePath)
+}
+
+// Close closes the persistent task queue.
+func (p *Replicator) Close() error {
+	return p.queue.close()
 }
 
 // WithPutTimeout returns option to set Put timeout of Replicator.
@@ -78,3 +118,53 @@ func WithLocalStorage(v *engine.StorageEngine) Option {
 		c.localStorage = v
 	}
 }
+
+// WithQueueCapacity returns option to set the maximum number
+// of the tasks in the Replicator queue.
+//
+// Zero value means no limit.
+func WithQueueCapacity(v uint32) Option {
+	return func(c *cfg) {
+		c.taskCap = v
+	}
+}
+
+// WithQueuePath returns option to set path to the file of the persistent
+// Replicator queue. If not set, the queue is kept in memory only.
+func WithQueuePath(v string) Option {
+	return func(c *cfg) {
+		c.queuePath = v
+	}
+}
+
+// WithRetryInterval returns option to set the minimal and the maximal
+// intervals between replication attempts to the node which failed.
+// The interval is doubled on each failure in a row.
+//
+// Non-positive values are ignored.
+func WithRetryInterval(min, max time.Duration) Option {
+	return func(c *cfg) {
+		if min > 0 {
+			c.retryMin = min
+		}
+
+		if max > 0 {
+			c.retryMax = max
+		}
+	}
+}
+
+// WithPool returns option to set pool for replication tasks.
+// If not set, tasks are executed one by one.
+func WithPool(v util.WorkerPool) Option {
+	return func(c *cfg) {
+		c.pool = v
+	}
+}
+
+// WithMetrics returns option to set Replicator's metric register.
+func WithMetrics(v MetricRegister) Option {
+	return func(c *cfg) {
+		c.metrics = v
+	}
+}
@@ -2,27 +2,85 @@ package replicator
 
 import (
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.uber.org/zap"
 )
 
+// Priority represents priority of the replication task.
+type Priority uint8
+
+const (
+	// PriorityNormal is a priority of the tasks replicating
+	// objects which already have remote copies.
+	PriorityNormal Priority = iota
+
+	// PriorityHigh is a priority of the tasks replicating objects
+	// without remote copies. Such tasks are executed first.
+	PriorityHigh
+)
+
+// String returns string representation of the priority.
+func (p Priority) String() string {
+	switch p {
+	case PriorityNormal:
+		return "normal"
+	case PriorityHigh:
+		return "high"
+	default:
+		return "unknown"
+	}
+}
+
 // Task represents group of Replicator task parameters.
 type Task struct {
+	priority Priority
+
 	quantity uint32
 
+	vector uint32
+
 	addr *addressSDK.Address
 
+	obj *objectSDK.Object
+
 	nodes netmap.Nodes
+
+	ignoreBackoff bool
 }
 
 // AddTask pushes replication task to Replicator queue.
+// If the queue already contains a task for the same object,
+// the task is replaced.
 //
 // If task queue is full, log message is written.
 func (p *Replicator) AddTask(t *Task) {
-	select {
-	case p.ch <- t:
-	default:
-		p.log.Warn("task queue is full")
+	if err := p.queue.push(t); err != nil {
+		p.log.Warn("could not add replication task to the queue",
+			zap.Stringer("object", t.addr),
+			zap.String("error", err.Error()),
+		)
+	}
+}
+
+// WithPlacementVector sets index of the placement vector which the nodes
+// of the task belong to. Queued tasks for the same object and placement
+// vector replace each other.
+func (t *Task) WithPlacementVector(v uint32) *Task {
+	if t != nil {
+		t.vector = v
 	}
+
+	return t
+}
+
+// WithPriority sets priority of the task.
+func (t *Task) WithPriority(v Priority) *Task {
+	if t != nil {
+		t.priority = v
+	}
+
+	return t
 }
 
 // WithCopiesNumber sets number of copies to replicate.
@@ -43,6 +101,22 @@ func (t *Task) WithObjectAddress(v *addressSDK.Address) *Task {
 	return t
 }
 
+// WithObject sets object to replicate. If not set,
+// the object is read from the local storage.
+func (t *Task) WithObject(obj *objectSDK.Object) *Task {
+	if t != nil {
+		t.obj = obj
+	}
+
+	return t
+}
+
+// CopiesNumber returns number of copies which have not been
+// replicated yet.
+func (t *Task) CopiesNumber() uint32 {
+	return t.quantity
+}
+
 // WithNodes sets list of potential object holders.
 func (t *Task) WithNodes(v netmap.Nodes) *Task {
 	if t != nil {
@@ -51,3 +125,14 @@ func (t *Task) WithNodes(v netmap.Nodes) *Task {
 
 	return t
 }
+
+// WithIgnoreBackoff sets the flag to try the nodes which are in the
+// back-off state too. It is used for the explicitly requested
+// replication which must not be postponed.
+func (t *Task) WithIgnoreBackoff(v bool) *Task {
+	if t != nil {
+		t.ignoreBackoff = v
+	}
+
+	return t
+}